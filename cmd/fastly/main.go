@@ -14,6 +14,8 @@ import (
 	"github.com/fastly/cli/pkg/commands/update"
 	"github.com/fastly/cli/pkg/config"
 	fsterrors "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/session"
 	"github.com/fastly/cli/pkg/sync"
 	"github.com/fastly/cli/pkg/text"
 )
@@ -133,15 +135,18 @@ Compatibility and versioning information for the Fastly CLI is being updated in
 
 	// Main is basically just a shim to call Run, so we do that here.
 	opts := app.RunOpts{
-		APIClient:  clientFactory,
-		Args:       args,
-		ConfigFile: file,
-		ConfigPath: config.FilePath,
-		Env:        env,
-		ErrLog:     fsterrors.Log,
-		HTTPClient: httpClient,
-		Stdin:      in,
-		Stdout:     out,
+		APIClient:   clientFactory,
+		Args:        args,
+		ConfigFile:  file,
+		ConfigPath:  config.FilePath,
+		Env:         env,
+		ErrLog:      fsterrors.Log,
+		HTTPClient:  httpClient,
+		JournalPath: journal.FilePath,
+		RTSClient:   app.FastlyRTSClient,
+		SessionPath: session.FilePath,
+		Stdin:       in,
+		Stdout:      out,
 		Versioners: app.Versioners{
 			CLI:     versionerCLI,
 			Viceroy: versionerViceroy,
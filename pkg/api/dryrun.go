@@ -0,0 +1,1265 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DryRun decorates an Interface so that read calls pass through to the
+// underlying client, while calls that would modify an account are printed
+// instead of being sent.
+//
+// Intercepted calls succeed with a placeholder result, populated from the
+// fields of the input that the result has in common (e.g. ServiceID and
+// Name), so that commands can still report what they would have done.
+type DryRun struct {
+	Interface
+	out  io.Writer
+	json bool
+}
+
+// NewDryRun returns a DryRun decorating client, printing each intercepted call
+// to out, either in a readable form or, when json is set, as one JSON object
+// per line.
+func NewDryRun(client Interface, out io.Writer, json bool) *DryRun {
+	return &DryRun{Interface: client, out: out, json: json}
+}
+
+// DryRunOperation is the JSON representation of an intercepted call.
+type DryRunOperation struct {
+	DryRun    bool        `json:"dry_run"`
+	Operation string      `json:"operation"`
+	Input     interface{} `json:"input"`
+}
+
+// intercept prints the operation with its input, secrets redacted, and
+// populates result (if not nil) from the input.
+func (d *DryRun) intercept(operation string, input interface{}, result interface{}) {
+	redacted := Redact(input)
+	if d.json {
+		// Encoding the input can't fail, as input structs are plain data.
+		_ = json.NewEncoder(d.out).Encode(DryRunOperation{DryRun: true, Operation: operation, Input: redacted})
+	} else {
+		fmt.Fprintf(d.out, "DRY RUN: %s\n", operation)
+		renderFields(d.out, reflect.ValueOf(redacted), "\t")
+	}
+	if result != nil {
+		fill(reflect.ValueOf(result), reflect.ValueOf(input))
+	}
+}
+
+// renderFields writes each field of a struct (or pointer to one) that has a
+// value on its own line. Unset fields aren't sent to the API, so are omitted.
+func renderFields(w io.Writer, v reflect.Value, indent string) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if v.Type().Field(i).PkgPath != "" || f.IsZero() {
+			continue
+		}
+		fmt.Fprintf(w, "%s%s: %s\n", indent, v.Type().Field(i).Name, renderValue(f))
+	}
+}
+
+// renderValue formats a single field value, dereferencing pointers so that
+// addresses are never printed.
+func renderValue(v reflect.Value) string {
+	if v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+			return s.String()
+		}
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return renderValue(v.Elem())
+	case reflect.Slice, reflect.Array:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = renderValue(v.Index(i))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Map:
+		items := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			items = append(items, fmt.Sprintf("%s: %s", renderValue(iter.Key()), renderValue(iter.Value())))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case reflect.Struct:
+		var fields []string
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if v.Type().Field(i).PkgPath != "" || f.IsZero() {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s", v.Type().Field(i).Name, renderValue(f)))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// resultAliases maps result fields to the input field they're populated
+// from, where the names differ.
+//
+// NOTE: this means a cloned version keeps the number of its source. As the
+// clone would be identical, reads of the source stand in for reads of the
+// clone, which doesn't exist.
+var resultAliases = map[string]string{
+	"Number": "ServiceVersion",
+}
+
+// fill sets each field of the struct result points to from the input field of
+// the same name, where the types are compatible. Optional input fields are
+// dereferenced, so e.g. a *string input field populates a string result
+// field.
+func fill(result, input reflect.Value) {
+	result, input = reflect.Indirect(result), reflect.Indirect(input)
+	if result.Kind() != reflect.Struct || input.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < result.NumField(); i++ {
+		name := result.Type().Field(i).Name
+		src := input.FieldByName(name)
+		if !src.IsValid() {
+			if alias, ok := resultAliases[name]; ok {
+				src = input.FieldByName(alias)
+			}
+		}
+		if !src.IsValid() {
+			continue
+		}
+		if src.Kind() == reflect.Ptr && !src.IsNil() && src.Type().Elem().ConvertibleTo(result.Field(i).Type()) {
+			src = src.Elem()
+		}
+		dst := result.Field(i)
+		if dst.CanSet() && src.Type().ConvertibleTo(dst.Type()) && src.Kind() == dst.Kind() {
+			dst.Set(src.Convert(dst.Type()))
+		}
+	}
+}
+
+// PurgeKeys implements Interface.
+func (d *DryRun) PurgeKeys(i *fastly.PurgeKeysInput) (map[string]string, error) {
+	d.intercept("PurgeKeys", i, nil)
+	return make(map[string]string), nil
+}
+
+// DeleteTokenSelf implements Interface.
+func (d *DryRun) DeleteTokenSelf() error {
+	d.intercept("DeleteTokenSelf", nil, nil)
+	return nil
+}
+
+// CreateService implements Interface.
+func (d *DryRun) CreateService(i *fastly.CreateServiceInput) (*fastly.Service, error) {
+	var r fastly.Service
+	d.intercept("CreateService", i, &r)
+	return &r, nil
+}
+
+// UpdateService implements Interface.
+func (d *DryRun) UpdateService(i *fastly.UpdateServiceInput) (*fastly.Service, error) {
+	var r fastly.Service
+	d.intercept("UpdateService", i, &r)
+	return &r, nil
+}
+
+// DeleteService implements Interface.
+func (d *DryRun) DeleteService(i *fastly.DeleteServiceInput) error {
+	d.intercept("DeleteService", i, nil)
+	return nil
+}
+
+// CloneVersion implements Interface.
+func (d *DryRun) CloneVersion(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+	var r fastly.Version
+	d.intercept("CloneVersion", i, &r)
+	return &r, nil
+}
+
+// UpdateVersion implements Interface.
+func (d *DryRun) UpdateVersion(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+	var r fastly.Version
+	d.intercept("UpdateVersion", i, &r)
+	return &r, nil
+}
+
+// ActivateVersion implements Interface.
+func (d *DryRun) ActivateVersion(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	var r fastly.Version
+	d.intercept("ActivateVersion", i, &r)
+	return &r, nil
+}
+
+// DeactivateVersion implements Interface.
+func (d *DryRun) DeactivateVersion(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+	var r fastly.Version
+	d.intercept("DeactivateVersion", i, &r)
+	return &r, nil
+}
+
+// LockVersion implements Interface.
+func (d *DryRun) LockVersion(i *fastly.LockVersionInput) (*fastly.Version, error) {
+	var r fastly.Version
+	d.intercept("LockVersion", i, &r)
+	return &r, nil
+}
+
+// CreateDomain implements Interface.
+func (d *DryRun) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	var r fastly.Domain
+	d.intercept("CreateDomain", i, &r)
+	return &r, nil
+}
+
+// UpdateDomain implements Interface.
+func (d *DryRun) UpdateDomain(i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+	var r fastly.Domain
+	d.intercept("UpdateDomain", i, &r)
+	return &r, nil
+}
+
+// DeleteDomain implements Interface.
+func (d *DryRun) DeleteDomain(i *fastly.DeleteDomainInput) error {
+	d.intercept("DeleteDomain", i, nil)
+	return nil
+}
+
+// CreateBackend implements Interface.
+func (d *DryRun) CreateBackend(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	var r fastly.Backend
+	d.intercept("CreateBackend", i, &r)
+	return &r, nil
+}
+
+// UpdateBackend implements Interface.
+func (d *DryRun) UpdateBackend(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	var r fastly.Backend
+	d.intercept("UpdateBackend", i, &r)
+	return &r, nil
+}
+
+// DeleteBackend implements Interface.
+func (d *DryRun) DeleteBackend(i *fastly.DeleteBackendInput) error {
+	d.intercept("DeleteBackend", i, nil)
+	return nil
+}
+
+// CreateHealthCheck implements Interface.
+func (d *DryRun) CreateHealthCheck(i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	var r fastly.HealthCheck
+	d.intercept("CreateHealthCheck", i, &r)
+	return &r, nil
+}
+
+// UpdateHealthCheck implements Interface.
+func (d *DryRun) UpdateHealthCheck(i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error) {
+	var r fastly.HealthCheck
+	d.intercept("UpdateHealthCheck", i, &r)
+	return &r, nil
+}
+
+// DeleteHealthCheck implements Interface.
+func (d *DryRun) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
+	d.intercept("DeleteHealthCheck", i, nil)
+	return nil
+}
+
+// CreateCondition implements Interface.
+func (d *DryRun) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	var r fastly.Condition
+	d.intercept("CreateCondition", i, &r)
+	return &r, nil
+}
+
+// UpdateCondition implements Interface.
+func (d *DryRun) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	var r fastly.Condition
+	d.intercept("UpdateCondition", i, &r)
+	return &r, nil
+}
+
+// DeleteCondition implements Interface.
+func (d *DryRun) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	d.intercept("DeleteCondition", i, nil)
+	return nil
+}
+
+// CreateHeader implements Interface.
+func (d *DryRun) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	var r fastly.Header
+	d.intercept("CreateHeader", i, &r)
+	return &r, nil
+}
+
+// UpdateHeader implements Interface.
+func (d *DryRun) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	var r fastly.Header
+	d.intercept("UpdateHeader", i, &r)
+	return &r, nil
+}
+
+// DeleteHeader implements Interface.
+func (d *DryRun) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	d.intercept("DeleteHeader", i, nil)
+	return nil
+}
+
+// CreateCacheSetting implements Interface.
+func (d *DryRun) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	var r fastly.CacheSetting
+	d.intercept("CreateCacheSetting", i, &r)
+	return &r, nil
+}
+
+// UpdateCacheSetting implements Interface.
+func (d *DryRun) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	var r fastly.CacheSetting
+	d.intercept("UpdateCacheSetting", i, &r)
+	return &r, nil
+}
+
+// DeleteCacheSetting implements Interface.
+func (d *DryRun) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	d.intercept("DeleteCacheSetting", i, nil)
+	return nil
+}
+
+// CreateRequestSetting implements Interface.
+func (d *DryRun) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	var r fastly.RequestSetting
+	d.intercept("CreateRequestSetting", i, &r)
+	return &r, nil
+}
+
+// UpdateRequestSetting implements Interface.
+func (d *DryRun) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	var r fastly.RequestSetting
+	d.intercept("UpdateRequestSetting", i, &r)
+	return &r, nil
+}
+
+// DeleteRequestSetting implements Interface.
+func (d *DryRun) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	d.intercept("DeleteRequestSetting", i, nil)
+	return nil
+}
+
+// CreateResponseObject implements Interface.
+func (d *DryRun) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	var r fastly.ResponseObject
+	d.intercept("CreateResponseObject", i, &r)
+	return &r, nil
+}
+
+// UpdateResponseObject implements Interface.
+func (d *DryRun) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	var r fastly.ResponseObject
+	d.intercept("UpdateResponseObject", i, &r)
+	return &r, nil
+}
+
+// DeleteResponseObject implements Interface.
+func (d *DryRun) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	d.intercept("DeleteResponseObject", i, nil)
+	return nil
+}
+
+// CreateGzip implements Interface.
+func (d *DryRun) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	var r fastly.Gzip
+	d.intercept("CreateGzip", i, &r)
+	return &r, nil
+}
+
+// UpdateGzip implements Interface.
+func (d *DryRun) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	var r fastly.Gzip
+	d.intercept("UpdateGzip", i, &r)
+	return &r, nil
+}
+
+// DeleteGzip implements Interface.
+func (d *DryRun) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	d.intercept("DeleteGzip", i, nil)
+	return nil
+}
+
+// CreateDirector implements Interface.
+func (d *DryRun) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	var r fastly.Director
+	d.intercept("CreateDirector", i, &r)
+	return &r, nil
+}
+
+// UpdateDirector implements Interface.
+func (d *DryRun) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	var r fastly.Director
+	d.intercept("UpdateDirector", i, &r)
+	return &r, nil
+}
+
+// DeleteDirector implements Interface.
+func (d *DryRun) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	d.intercept("DeleteDirector", i, nil)
+	return nil
+}
+
+// CreateDirectorBackend implements Interface.
+func (d *DryRun) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	var r fastly.DirectorBackend
+	d.intercept("CreateDirectorBackend", i, &r)
+	return &r, nil
+}
+
+// DeleteDirectorBackend implements Interface.
+func (d *DryRun) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	d.intercept("DeleteDirectorBackend", i, nil)
+	return nil
+}
+
+// CreatePool implements Interface.
+func (d *DryRun) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	var r fastly.Pool
+	d.intercept("CreatePool", i, &r)
+	return &r, nil
+}
+
+// UpdatePool implements Interface.
+func (d *DryRun) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	var r fastly.Pool
+	d.intercept("UpdatePool", i, &r)
+	return &r, nil
+}
+
+// DeletePool implements Interface.
+func (d *DryRun) DeletePool(i *fastly.DeletePoolInput) error {
+	d.intercept("DeletePool", i, nil)
+	return nil
+}
+
+// CreateServer implements Interface.
+func (d *DryRun) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	var r fastly.Server
+	d.intercept("CreateServer", i, &r)
+	return &r, nil
+}
+
+// UpdateServer implements Interface.
+func (d *DryRun) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	var r fastly.Server
+	d.intercept("UpdateServer", i, &r)
+	return &r, nil
+}
+
+// DeleteServer implements Interface.
+func (d *DryRun) DeleteServer(i *fastly.DeleteServerInput) error {
+	d.intercept("DeleteServer", i, nil)
+	return nil
+}
+
+// CreateCustomTLSCertificate implements Interface.
+func (d *DryRun) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	var r fastly.CustomTLSCertificate
+	d.intercept("CreateCustomTLSCertificate", i, &r)
+	return &r, nil
+}
+
+// UpdateCustomTLSCertificate implements Interface.
+func (d *DryRun) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	var r fastly.CustomTLSCertificate
+	d.intercept("UpdateCustomTLSCertificate", i, &r)
+	return &r, nil
+}
+
+// DeleteCustomTLSCertificate implements Interface.
+func (d *DryRun) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	d.intercept("DeleteCustomTLSCertificate", i, nil)
+	return nil
+}
+
+// CreatePrivateKey implements Interface.
+func (d *DryRun) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	var r fastly.PrivateKey
+	d.intercept("CreatePrivateKey", i, &r)
+	return &r, nil
+}
+
+// DeletePrivateKey implements Interface.
+func (d *DryRun) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	d.intercept("DeletePrivateKey", i, nil)
+	return nil
+}
+
+// CreateTLSActivation implements Interface.
+func (d *DryRun) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	var r fastly.TLSActivation
+	d.intercept("CreateTLSActivation", i, &r)
+	return &r, nil
+}
+
+// UpdateTLSActivation implements Interface.
+func (d *DryRun) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	var r fastly.TLSActivation
+	d.intercept("UpdateTLSActivation", i, &r)
+	return &r, nil
+}
+
+// DeleteTLSActivation implements Interface.
+func (d *DryRun) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	d.intercept("DeleteTLSActivation", i, nil)
+	return nil
+}
+
+// CreateTLSSubscription implements Interface.
+func (d *DryRun) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	var r fastly.TLSSubscription
+	d.intercept("CreateTLSSubscription", i, &r)
+	return &r, nil
+}
+
+// DeleteTLSSubscription implements Interface.
+func (d *DryRun) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	d.intercept("DeleteTLSSubscription", i, nil)
+	return nil
+}
+
+// UpdatePackage implements Interface.
+func (d *DryRun) UpdatePackage(i *fastly.UpdatePackageInput) (*fastly.Package, error) {
+	var r fastly.Package
+	d.intercept("UpdatePackage", i, &r)
+	return &r, nil
+}
+
+// CreateDictionary implements Interface.
+func (d *DryRun) CreateDictionary(i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+	var r fastly.Dictionary
+	d.intercept("CreateDictionary", i, &r)
+	return &r, nil
+}
+
+// DeleteDictionary implements Interface.
+func (d *DryRun) DeleteDictionary(i *fastly.DeleteDictionaryInput) error {
+	d.intercept("DeleteDictionary", i, nil)
+	return nil
+}
+
+// UpdateDictionary implements Interface.
+func (d *DryRun) UpdateDictionary(i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error) {
+	var r fastly.Dictionary
+	d.intercept("UpdateDictionary", i, &r)
+	return &r, nil
+}
+
+// CreateDictionaryItem implements Interface.
+func (d *DryRun) CreateDictionaryItem(i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	var r fastly.DictionaryItem
+	d.intercept("CreateDictionaryItem", i, &r)
+	return &r, nil
+}
+
+// UpdateDictionaryItem implements Interface.
+func (d *DryRun) UpdateDictionaryItem(i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	var r fastly.DictionaryItem
+	d.intercept("UpdateDictionaryItem", i, &r)
+	return &r, nil
+}
+
+// DeleteDictionaryItem implements Interface.
+func (d *DryRun) DeleteDictionaryItem(i *fastly.DeleteDictionaryItemInput) error {
+	d.intercept("DeleteDictionaryItem", i, nil)
+	return nil
+}
+
+// BatchModifyDictionaryItems implements Interface.
+func (d *DryRun) BatchModifyDictionaryItems(i *fastly.BatchModifyDictionaryItemsInput) error {
+	d.intercept("BatchModifyDictionaryItems", i, nil)
+	return nil
+}
+
+// CreateBigQuery implements Interface.
+func (d *DryRun) CreateBigQuery(i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
+	var r fastly.BigQuery
+	d.intercept("CreateBigQuery", i, &r)
+	return &r, nil
+}
+
+// UpdateBigQuery implements Interface.
+func (d *DryRun) UpdateBigQuery(i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error) {
+	var r fastly.BigQuery
+	d.intercept("UpdateBigQuery", i, &r)
+	return &r, nil
+}
+
+// DeleteBigQuery implements Interface.
+func (d *DryRun) DeleteBigQuery(i *fastly.DeleteBigQueryInput) error {
+	d.intercept("DeleteBigQuery", i, nil)
+	return nil
+}
+
+// CreateS3 implements Interface.
+func (d *DryRun) CreateS3(i *fastly.CreateS3Input) (*fastly.S3, error) {
+	var r fastly.S3
+	d.intercept("CreateS3", i, &r)
+	return &r, nil
+}
+
+// UpdateS3 implements Interface.
+func (d *DryRun) UpdateS3(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	var r fastly.S3
+	d.intercept("UpdateS3", i, &r)
+	return &r, nil
+}
+
+// DeleteS3 implements Interface.
+func (d *DryRun) DeleteS3(i *fastly.DeleteS3Input) error {
+	d.intercept("DeleteS3", i, nil)
+	return nil
+}
+
+// CreateKinesis implements Interface.
+func (d *DryRun) CreateKinesis(i *fastly.CreateKinesisInput) (*fastly.Kinesis, error) {
+	var r fastly.Kinesis
+	d.intercept("CreateKinesis", i, &r)
+	return &r, nil
+}
+
+// UpdateKinesis implements Interface.
+func (d *DryRun) UpdateKinesis(i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error) {
+	var r fastly.Kinesis
+	d.intercept("UpdateKinesis", i, &r)
+	return &r, nil
+}
+
+// DeleteKinesis implements Interface.
+func (d *DryRun) DeleteKinesis(i *fastly.DeleteKinesisInput) error {
+	d.intercept("DeleteKinesis", i, nil)
+	return nil
+}
+
+// CreateSyslog implements Interface.
+func (d *DryRun) CreateSyslog(i *fastly.CreateSyslogInput) (*fastly.Syslog, error) {
+	var r fastly.Syslog
+	d.intercept("CreateSyslog", i, &r)
+	return &r, nil
+}
+
+// UpdateSyslog implements Interface.
+func (d *DryRun) UpdateSyslog(i *fastly.UpdateSyslogInput) (*fastly.Syslog, error) {
+	var r fastly.Syslog
+	d.intercept("UpdateSyslog", i, &r)
+	return &r, nil
+}
+
+// DeleteSyslog implements Interface.
+func (d *DryRun) DeleteSyslog(i *fastly.DeleteSyslogInput) error {
+	d.intercept("DeleteSyslog", i, nil)
+	return nil
+}
+
+// CreateLogentries implements Interface.
+func (d *DryRun) CreateLogentries(i *fastly.CreateLogentriesInput) (*fastly.Logentries, error) {
+	var r fastly.Logentries
+	d.intercept("CreateLogentries", i, &r)
+	return &r, nil
+}
+
+// UpdateLogentries implements Interface.
+func (d *DryRun) UpdateLogentries(i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error) {
+	var r fastly.Logentries
+	d.intercept("UpdateLogentries", i, &r)
+	return &r, nil
+}
+
+// DeleteLogentries implements Interface.
+func (d *DryRun) DeleteLogentries(i *fastly.DeleteLogentriesInput) error {
+	d.intercept("DeleteLogentries", i, nil)
+	return nil
+}
+
+// CreatePapertrail implements Interface.
+func (d *DryRun) CreatePapertrail(i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error) {
+	var r fastly.Papertrail
+	d.intercept("CreatePapertrail", i, &r)
+	return &r, nil
+}
+
+// UpdatePapertrail implements Interface.
+func (d *DryRun) UpdatePapertrail(i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error) {
+	var r fastly.Papertrail
+	d.intercept("UpdatePapertrail", i, &r)
+	return &r, nil
+}
+
+// DeletePapertrail implements Interface.
+func (d *DryRun) DeletePapertrail(i *fastly.DeletePapertrailInput) error {
+	d.intercept("DeletePapertrail", i, nil)
+	return nil
+}
+
+// CreateSumologic implements Interface.
+func (d *DryRun) CreateSumologic(i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+	var r fastly.Sumologic
+	d.intercept("CreateSumologic", i, &r)
+	return &r, nil
+}
+
+// UpdateSumologic implements Interface.
+func (d *DryRun) UpdateSumologic(i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error) {
+	var r fastly.Sumologic
+	d.intercept("UpdateSumologic", i, &r)
+	return &r, nil
+}
+
+// DeleteSumologic implements Interface.
+func (d *DryRun) DeleteSumologic(i *fastly.DeleteSumologicInput) error {
+	d.intercept("DeleteSumologic", i, nil)
+	return nil
+}
+
+// CreateGCS implements Interface.
+func (d *DryRun) CreateGCS(i *fastly.CreateGCSInput) (*fastly.GCS, error) {
+	var r fastly.GCS
+	d.intercept("CreateGCS", i, &r)
+	return &r, nil
+}
+
+// UpdateGCS implements Interface.
+func (d *DryRun) UpdateGCS(i *fastly.UpdateGCSInput) (*fastly.GCS, error) {
+	var r fastly.GCS
+	d.intercept("UpdateGCS", i, &r)
+	return &r, nil
+}
+
+// DeleteGCS implements Interface.
+func (d *DryRun) DeleteGCS(i *fastly.DeleteGCSInput) error {
+	d.intercept("DeleteGCS", i, nil)
+	return nil
+}
+
+// CreateFTP implements Interface.
+func (d *DryRun) CreateFTP(i *fastly.CreateFTPInput) (*fastly.FTP, error) {
+	var r fastly.FTP
+	d.intercept("CreateFTP", i, &r)
+	return &r, nil
+}
+
+// UpdateFTP implements Interface.
+func (d *DryRun) UpdateFTP(i *fastly.UpdateFTPInput) (*fastly.FTP, error) {
+	var r fastly.FTP
+	d.intercept("UpdateFTP", i, &r)
+	return &r, nil
+}
+
+// DeleteFTP implements Interface.
+func (d *DryRun) DeleteFTP(i *fastly.DeleteFTPInput) error {
+	d.intercept("DeleteFTP", i, nil)
+	return nil
+}
+
+// CreateSplunk implements Interface.
+func (d *DryRun) CreateSplunk(i *fastly.CreateSplunkInput) (*fastly.Splunk, error) {
+	var r fastly.Splunk
+	d.intercept("CreateSplunk", i, &r)
+	return &r, nil
+}
+
+// UpdateSplunk implements Interface.
+func (d *DryRun) UpdateSplunk(i *fastly.UpdateSplunkInput) (*fastly.Splunk, error) {
+	var r fastly.Splunk
+	d.intercept("UpdateSplunk", i, &r)
+	return &r, nil
+}
+
+// DeleteSplunk implements Interface.
+func (d *DryRun) DeleteSplunk(i *fastly.DeleteSplunkInput) error {
+	d.intercept("DeleteSplunk", i, nil)
+	return nil
+}
+
+// CreateScalyr implements Interface.
+func (d *DryRun) CreateScalyr(i *fastly.CreateScalyrInput) (*fastly.Scalyr, error) {
+	var r fastly.Scalyr
+	d.intercept("CreateScalyr", i, &r)
+	return &r, nil
+}
+
+// UpdateScalyr implements Interface.
+func (d *DryRun) UpdateScalyr(i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error) {
+	var r fastly.Scalyr
+	d.intercept("UpdateScalyr", i, &r)
+	return &r, nil
+}
+
+// DeleteScalyr implements Interface.
+func (d *DryRun) DeleteScalyr(i *fastly.DeleteScalyrInput) error {
+	d.intercept("DeleteScalyr", i, nil)
+	return nil
+}
+
+// CreateLoggly implements Interface.
+func (d *DryRun) CreateLoggly(i *fastly.CreateLogglyInput) (*fastly.Loggly, error) {
+	var r fastly.Loggly
+	d.intercept("CreateLoggly", i, &r)
+	return &r, nil
+}
+
+// UpdateLoggly implements Interface.
+func (d *DryRun) UpdateLoggly(i *fastly.UpdateLogglyInput) (*fastly.Loggly, error) {
+	var r fastly.Loggly
+	d.intercept("UpdateLoggly", i, &r)
+	return &r, nil
+}
+
+// DeleteLoggly implements Interface.
+func (d *DryRun) DeleteLoggly(i *fastly.DeleteLogglyInput) error {
+	d.intercept("DeleteLoggly", i, nil)
+	return nil
+}
+
+// CreateHoneycomb implements Interface.
+func (d *DryRun) CreateHoneycomb(i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error) {
+	var r fastly.Honeycomb
+	d.intercept("CreateHoneycomb", i, &r)
+	return &r, nil
+}
+
+// UpdateHoneycomb implements Interface.
+func (d *DryRun) UpdateHoneycomb(i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error) {
+	var r fastly.Honeycomb
+	d.intercept("UpdateHoneycomb", i, &r)
+	return &r, nil
+}
+
+// DeleteHoneycomb implements Interface.
+func (d *DryRun) DeleteHoneycomb(i *fastly.DeleteHoneycombInput) error {
+	d.intercept("DeleteHoneycomb", i, nil)
+	return nil
+}
+
+// CreateHeroku implements Interface.
+func (d *DryRun) CreateHeroku(i *fastly.CreateHerokuInput) (*fastly.Heroku, error) {
+	var r fastly.Heroku
+	d.intercept("CreateHeroku", i, &r)
+	return &r, nil
+}
+
+// UpdateHeroku implements Interface.
+func (d *DryRun) UpdateHeroku(i *fastly.UpdateHerokuInput) (*fastly.Heroku, error) {
+	var r fastly.Heroku
+	d.intercept("UpdateHeroku", i, &r)
+	return &r, nil
+}
+
+// DeleteHeroku implements Interface.
+func (d *DryRun) DeleteHeroku(i *fastly.DeleteHerokuInput) error {
+	d.intercept("DeleteHeroku", i, nil)
+	return nil
+}
+
+// CreateSFTP implements Interface.
+func (d *DryRun) CreateSFTP(i *fastly.CreateSFTPInput) (*fastly.SFTP, error) {
+	var r fastly.SFTP
+	d.intercept("CreateSFTP", i, &r)
+	return &r, nil
+}
+
+// UpdateSFTP implements Interface.
+func (d *DryRun) UpdateSFTP(i *fastly.UpdateSFTPInput) (*fastly.SFTP, error) {
+	var r fastly.SFTP
+	d.intercept("UpdateSFTP", i, &r)
+	return &r, nil
+}
+
+// DeleteSFTP implements Interface.
+func (d *DryRun) DeleteSFTP(i *fastly.DeleteSFTPInput) error {
+	d.intercept("DeleteSFTP", i, nil)
+	return nil
+}
+
+// CreateLogshuttle implements Interface.
+func (d *DryRun) CreateLogshuttle(i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error) {
+	var r fastly.Logshuttle
+	d.intercept("CreateLogshuttle", i, &r)
+	return &r, nil
+}
+
+// UpdateLogshuttle implements Interface.
+func (d *DryRun) UpdateLogshuttle(i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error) {
+	var r fastly.Logshuttle
+	d.intercept("UpdateLogshuttle", i, &r)
+	return &r, nil
+}
+
+// DeleteLogshuttle implements Interface.
+func (d *DryRun) DeleteLogshuttle(i *fastly.DeleteLogshuttleInput) error {
+	d.intercept("DeleteLogshuttle", i, nil)
+	return nil
+}
+
+// CreateCloudfiles implements Interface.
+func (d *DryRun) CreateCloudfiles(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	var r fastly.Cloudfiles
+	d.intercept("CreateCloudfiles", i, &r)
+	return &r, nil
+}
+
+// UpdateCloudfiles implements Interface.
+func (d *DryRun) UpdateCloudfiles(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	var r fastly.Cloudfiles
+	d.intercept("UpdateCloudfiles", i, &r)
+	return &r, nil
+}
+
+// DeleteCloudfiles implements Interface.
+func (d *DryRun) DeleteCloudfiles(i *fastly.DeleteCloudfilesInput) error {
+	d.intercept("DeleteCloudfiles", i, nil)
+	return nil
+}
+
+// CreateDigitalOcean implements Interface.
+func (d *DryRun) CreateDigitalOcean(i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	var r fastly.DigitalOcean
+	d.intercept("CreateDigitalOcean", i, &r)
+	return &r, nil
+}
+
+// UpdateDigitalOcean implements Interface.
+func (d *DryRun) UpdateDigitalOcean(i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	var r fastly.DigitalOcean
+	d.intercept("UpdateDigitalOcean", i, &r)
+	return &r, nil
+}
+
+// DeleteDigitalOcean implements Interface.
+func (d *DryRun) DeleteDigitalOcean(i *fastly.DeleteDigitalOceanInput) error {
+	d.intercept("DeleteDigitalOcean", i, nil)
+	return nil
+}
+
+// CreateElasticsearch implements Interface.
+func (d *DryRun) CreateElasticsearch(i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	var r fastly.Elasticsearch
+	d.intercept("CreateElasticsearch", i, &r)
+	return &r, nil
+}
+
+// UpdateElasticsearch implements Interface.
+func (d *DryRun) UpdateElasticsearch(i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	var r fastly.Elasticsearch
+	d.intercept("UpdateElasticsearch", i, &r)
+	return &r, nil
+}
+
+// DeleteElasticsearch implements Interface.
+func (d *DryRun) DeleteElasticsearch(i *fastly.DeleteElasticsearchInput) error {
+	d.intercept("DeleteElasticsearch", i, nil)
+	return nil
+}
+
+// CreateBlobStorage implements Interface.
+func (d *DryRun) CreateBlobStorage(i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error) {
+	var r fastly.BlobStorage
+	d.intercept("CreateBlobStorage", i, &r)
+	return &r, nil
+}
+
+// UpdateBlobStorage implements Interface.
+func (d *DryRun) UpdateBlobStorage(i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error) {
+	var r fastly.BlobStorage
+	d.intercept("UpdateBlobStorage", i, &r)
+	return &r, nil
+}
+
+// DeleteBlobStorage implements Interface.
+func (d *DryRun) DeleteBlobStorage(i *fastly.DeleteBlobStorageInput) error {
+	d.intercept("DeleteBlobStorage", i, nil)
+	return nil
+}
+
+// CreateDatadog implements Interface.
+func (d *DryRun) CreateDatadog(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+	var r fastly.Datadog
+	d.intercept("CreateDatadog", i, &r)
+	return &r, nil
+}
+
+// UpdateDatadog implements Interface.
+func (d *DryRun) UpdateDatadog(i *fastly.UpdateDatadogInput) (*fastly.Datadog, error) {
+	var r fastly.Datadog
+	d.intercept("UpdateDatadog", i, &r)
+	return &r, nil
+}
+
+// DeleteDatadog implements Interface.
+func (d *DryRun) DeleteDatadog(i *fastly.DeleteDatadogInput) error {
+	d.intercept("DeleteDatadog", i, nil)
+	return nil
+}
+
+// CreateHTTPS implements Interface.
+func (d *DryRun) CreateHTTPS(i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+	var r fastly.HTTPS
+	d.intercept("CreateHTTPS", i, &r)
+	return &r, nil
+}
+
+// UpdateHTTPS implements Interface.
+func (d *DryRun) UpdateHTTPS(i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error) {
+	var r fastly.HTTPS
+	d.intercept("UpdateHTTPS", i, &r)
+	return &r, nil
+}
+
+// DeleteHTTPS implements Interface.
+func (d *DryRun) DeleteHTTPS(i *fastly.DeleteHTTPSInput) error {
+	d.intercept("DeleteHTTPS", i, nil)
+	return nil
+}
+
+// CreateKafka implements Interface.
+func (d *DryRun) CreateKafka(i *fastly.CreateKafkaInput) (*fastly.Kafka, error) {
+	var r fastly.Kafka
+	d.intercept("CreateKafka", i, &r)
+	return &r, nil
+}
+
+// UpdateKafka implements Interface.
+func (d *DryRun) UpdateKafka(i *fastly.UpdateKafkaInput) (*fastly.Kafka, error) {
+	var r fastly.Kafka
+	d.intercept("UpdateKafka", i, &r)
+	return &r, nil
+}
+
+// DeleteKafka implements Interface.
+func (d *DryRun) DeleteKafka(i *fastly.DeleteKafkaInput) error {
+	d.intercept("DeleteKafka", i, nil)
+	return nil
+}
+
+// CreatePubsub implements Interface.
+func (d *DryRun) CreatePubsub(i *fastly.CreatePubsubInput) (*fastly.Pubsub, error) {
+	var r fastly.Pubsub
+	d.intercept("CreatePubsub", i, &r)
+	return &r, nil
+}
+
+// UpdatePubsub implements Interface.
+func (d *DryRun) UpdatePubsub(i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error) {
+	var r fastly.Pubsub
+	d.intercept("UpdatePubsub", i, &r)
+	return &r, nil
+}
+
+// DeletePubsub implements Interface.
+func (d *DryRun) DeletePubsub(i *fastly.DeletePubsubInput) error {
+	d.intercept("DeletePubsub", i, nil)
+	return nil
+}
+
+// CreateOpenstack implements Interface.
+func (d *DryRun) CreateOpenstack(i *fastly.CreateOpenstackInput) (*fastly.Openstack, error) {
+	var r fastly.Openstack
+	d.intercept("CreateOpenstack", i, &r)
+	return &r, nil
+}
+
+// UpdateOpenstack implements Interface.
+func (d *DryRun) UpdateOpenstack(i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error) {
+	var r fastly.Openstack
+	d.intercept("UpdateOpenstack", i, &r)
+	return &r, nil
+}
+
+// DeleteOpenstack implements Interface.
+func (d *DryRun) DeleteOpenstack(i *fastly.DeleteOpenstackInput) error {
+	d.intercept("DeleteOpenstack", i, nil)
+	return nil
+}
+
+// CreateUser implements Interface.
+func (d *DryRun) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	var r fastly.User
+	d.intercept("CreateUser", i, &r)
+	return &r, nil
+}
+
+// UpdateUser implements Interface.
+func (d *DryRun) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	var r fastly.User
+	d.intercept("UpdateUser", i, &r)
+	return &r, nil
+}
+
+// DeleteUser implements Interface.
+func (d *DryRun) DeleteUser(i *fastly.DeleteUserInput) error {
+	d.intercept("DeleteUser", i, nil)
+	return nil
+}
+
+// CreateToken implements Interface.
+func (d *DryRun) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	var r fastly.Token
+	d.intercept("CreateToken", i, &r)
+	return &r, nil
+}
+
+// DeleteToken implements Interface.
+func (d *DryRun) DeleteToken(i *fastly.DeleteTokenInput) error {
+	d.intercept("DeleteToken", i, nil)
+	return nil
+}
+
+// CreateManagedLogging implements Interface.
+func (d *DryRun) CreateManagedLogging(i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error) {
+	var r fastly.ManagedLogging
+	d.intercept("CreateManagedLogging", i, &r)
+	return &r, nil
+}
+
+// CreateVCL implements Interface.
+func (d *DryRun) CreateVCL(i *fastly.CreateVCLInput) (*fastly.VCL, error) {
+	var r fastly.VCL
+	d.intercept("CreateVCL", i, &r)
+	return &r, nil
+}
+
+// UpdateVCL implements Interface.
+func (d *DryRun) UpdateVCL(i *fastly.UpdateVCLInput) (*fastly.VCL, error) {
+	var r fastly.VCL
+	d.intercept("UpdateVCL", i, &r)
+	return &r, nil
+}
+
+// DeleteVCL implements Interface.
+func (d *DryRun) DeleteVCL(i *fastly.DeleteVCLInput) error {
+	d.intercept("DeleteVCL", i, nil)
+	return nil
+}
+
+// CreateSnippet implements Interface.
+func (d *DryRun) CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+	var r fastly.Snippet
+	d.intercept("CreateSnippet", i, &r)
+	return &r, nil
+}
+
+// UpdateSnippet implements Interface.
+func (d *DryRun) UpdateSnippet(i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+	var r fastly.Snippet
+	d.intercept("UpdateSnippet", i, &r)
+	return &r, nil
+}
+
+// UpdateDynamicSnippet implements Interface.
+func (d *DryRun) UpdateDynamicSnippet(i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	var r fastly.DynamicSnippet
+	d.intercept("UpdateDynamicSnippet", i, &r)
+	return &r, nil
+}
+
+// DeleteSnippet implements Interface.
+func (d *DryRun) DeleteSnippet(i *fastly.DeleteSnippetInput) error {
+	d.intercept("DeleteSnippet", i, nil)
+	return nil
+}
+
+// Purge implements Interface.
+func (d *DryRun) Purge(i *fastly.PurgeInput) (*fastly.Purge, error) {
+	var r fastly.Purge
+	d.intercept("Purge", i, &r)
+	return &r, nil
+}
+
+// PurgeKey implements Interface.
+func (d *DryRun) PurgeKey(i *fastly.PurgeKeyInput) (*fastly.Purge, error) {
+	var r fastly.Purge
+	d.intercept("PurgeKey", i, &r)
+	return &r, nil
+}
+
+// PurgeAll implements Interface.
+func (d *DryRun) PurgeAll(i *fastly.PurgeAllInput) (*fastly.Purge, error) {
+	var r fastly.Purge
+	d.intercept("PurgeAll", i, &r)
+	return &r, nil
+}
+
+// CreateACL implements Interface.
+func (d *DryRun) CreateACL(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	var r fastly.ACL
+	d.intercept("CreateACL", i, &r)
+	return &r, nil
+}
+
+// DeleteACL implements Interface.
+func (d *DryRun) DeleteACL(i *fastly.DeleteACLInput) error {
+	d.intercept("DeleteACL", i, nil)
+	return nil
+}
+
+// UpdateACL implements Interface.
+func (d *DryRun) UpdateACL(i *fastly.UpdateACLInput) (*fastly.ACL, error) {
+	var r fastly.ACL
+	d.intercept("UpdateACL", i, &r)
+	return &r, nil
+}
+
+// CreateACLEntry implements Interface.
+func (d *DryRun) CreateACLEntry(i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	var r fastly.ACLEntry
+	d.intercept("CreateACLEntry", i, &r)
+	return &r, nil
+}
+
+// DeleteACLEntry implements Interface.
+func (d *DryRun) DeleteACLEntry(i *fastly.DeleteACLEntryInput) error {
+	d.intercept("DeleteACLEntry", i, nil)
+	return nil
+}
+
+// UpdateACLEntry implements Interface.
+func (d *DryRun) UpdateACLEntry(i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error) {
+	var r fastly.ACLEntry
+	d.intercept("UpdateACLEntry", i, &r)
+	return &r, nil
+}
+
+// BatchModifyACLEntries implements Interface.
+func (d *DryRun) BatchModifyACLEntries(i *fastly.BatchModifyACLEntriesInput) error {
+	d.intercept("BatchModifyACLEntries", i, nil)
+	return nil
+}
+
+// CreateNewRelic implements Interface.
+func (d *DryRun) CreateNewRelic(i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error) {
+	var r fastly.NewRelic
+	d.intercept("CreateNewRelic", i, &r)
+	return &r, nil
+}
+
+// DeleteNewRelic implements Interface.
+func (d *DryRun) DeleteNewRelic(i *fastly.DeleteNewRelicInput) error {
+	d.intercept("DeleteNewRelic", i, nil)
+	return nil
+}
+
+// UpdateNewRelic implements Interface.
+func (d *DryRun) UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
+	var r fastly.NewRelic
+	d.intercept("UpdateNewRelic", i, &r)
+	return &r, nil
+}
@@ -0,0 +1,230 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// mutatingPrefixes are the method name prefixes of calls that modify an
+// account, and so must be intercepted by the dry-run client.
+var mutatingPrefixes = []string{"Activate", "Batch", "Clone", "Create", "Deactivate", "Delete", "Lock", "Purge", "Update"}
+
+// TestDryRunInterceptsMutations guards against new mutating methods being
+// added to api.Interface without being intercepted. The zero value mock.API
+// panics on every call, so any call that reaches it fails the test.
+func TestDryRunInterceptsMutations(t *testing.T) {
+	var buf bytes.Buffer
+	client := reflect.ValueOf(api.NewDryRun(mock.API{}, &buf, false))
+	iface := reflect.TypeOf((*api.Interface)(nil)).Elem()
+
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := iface.Method(i).Name
+		if !isMutating(name) {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("%s was passed through to the API: %v", name, r)
+				}
+			}()
+
+			m := client.MethodByName(name)
+			args := make([]reflect.Value, m.Type().NumIn())
+			for j := range args {
+				args[j] = reflect.New(m.Type().In(j).Elem())
+			}
+			results := m.Call(args)
+
+			if err := results[len(results)-1]; !err.IsNil() {
+				t.Fatalf("want no error, have %v", err.Interface())
+			}
+			if len(results) > 1 && results[0].IsNil() {
+				t.Fatal("want placeholder result, have nil")
+			}
+			testutil.AssertStringContains(t, buf.String(), "DRY RUN: "+name+"\n")
+		})
+	}
+}
+
+func TestDryRunPassesReadsThrough(t *testing.T) {
+	var buf bytes.Buffer
+	client := api.NewDryRun(mock.API{
+		GetServiceFn: func(i *fastly.GetServiceInput) (*fastly.Service, error) {
+			return &fastly.Service{ID: i.ID, Name: "test"}, nil
+		},
+	}, &buf, false)
+
+	s, err := client.GetService(&fastly.GetServiceInput{ID: "123"})
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "test", s.Name)
+	testutil.AssertString(t, "", buf.String())
+}
+
+func TestDryRunOutput(t *testing.T) {
+	input := &fastly.CreateBackendInput{
+		ServiceID:      "123",
+		ServiceVersion: 2,
+		Name:           "www.test.com",
+		Address:        "127.0.0.1",
+		Port:           8080,
+		Comment:        "a comment",
+	}
+
+	var buf bytes.Buffer
+	b, err := api.NewDryRun(mock.API{}, &buf, false).CreateBackend(input)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, strings.Join([]string{
+		"DRY RUN: CreateBackend",
+		"\tServiceID: 123",
+		"\tServiceVersion: 2",
+		"\tName: www.test.com",
+		"\tComment: a comment",
+		"\tAddress: 127.0.0.1",
+		"\tPort: 8080",
+	}, "\n")+"\n", buf.String())
+
+	// The placeholder result is populated from the input.
+	testutil.AssertString(t, "123", b.ServiceID)
+	testutil.AssertString(t, "www.test.com", b.Name)
+	if b.ServiceVersion != 2 || b.Port != 8080 {
+		t.Fatalf("want version 2 port 8080, have version %d port %d", b.ServiceVersion, b.Port)
+	}
+
+	buf.Reset()
+	_, err = api.NewDryRun(mock.API{}, &buf, true).CreateBackend(input)
+	testutil.AssertNoError(t, err)
+	var op struct {
+		DryRun    bool                      `json:"dry_run"`
+		Operation string                    `json:"operation"`
+		Input     fastly.CreateBackendInput `json:"input"`
+	}
+	testutil.AssertNoError(t, json.Unmarshal(buf.Bytes(), &op))
+	if !op.DryRun {
+		t.Fatal("want dry_run true, have false")
+	}
+	testutil.AssertString(t, "CreateBackend", op.Operation)
+	testutil.AssertString(t, "www.test.com", op.Input.Name)
+}
+
+func TestDryRunCloneVersion(t *testing.T) {
+	var buf bytes.Buffer
+	v, err := api.NewDryRun(mock.API{}, &buf, false).CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      "123",
+		ServiceVersion: 4,
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "123", v.ServiceID)
+	if v.Number != 4 {
+		t.Fatalf("want version 4, have %d", v.Number)
+	}
+}
+
+func TestDryRunRedactsSecrets(t *testing.T) {
+	secret := "hunter2"
+	for _, json := range []bool{false, true} {
+		var buf bytes.Buffer
+		client := api.NewDryRun(mock.API{}, &buf, json)
+		_, err := client.CreateToken(&fastly.CreateTokenInput{Username: "test@example.com", Password: secret})
+		testutil.AssertNoError(t, err)
+		_, err = client.UpdateS3(&fastly.UpdateS3Input{ServiceID: "123", Name: "logs", AccessKey: &secret, SecretKey: &secret})
+		testutil.AssertNoError(t, err)
+
+		if strings.Contains(buf.String(), secret) {
+			t.Fatalf("want secrets redacted, have:\n%s", buf.String())
+		}
+		testutil.AssertStringContains(t, buf.String(), "test@example.com")
+		testutil.AssertStringContains(t, buf.String(), "redacted")
+	}
+	// The input itself isn't modified.
+	testutil.AssertString(t, "hunter2", secret)
+}
+
+func TestDryRunRedactsLoggingSecrets(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		call   func(api.Interface) error
+		secret string
+		want   string
+	}{
+		{
+			name: "https header value",
+			call: func(c api.Interface) error {
+				_, err := c.CreateHTTPS(&fastly.CreateHTTPSInput{ServiceID: "123", Name: "logs", URL: "https://example.com/logs", HeaderName: "Authorization", HeaderValue: "Bearer hunter2"})
+				return err
+			},
+			secret: "hunter2",
+			want:   "https://example.com/logs",
+		},
+		{
+			name: "sumologic url",
+			call: func(c api.Interface) error {
+				_, err := c.CreateSumologic(&fastly.CreateSumologicInput{ServiceID: "123", Name: "logs", URL: "https://collectors.sumologic.com/receiver/v1/http/hunter2"})
+				return err
+			},
+			secret: "hunter2",
+			want:   "logs",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			for _, json := range []bool{false, true} {
+				var buf bytes.Buffer
+				testutil.AssertNoError(t, testcase.call(api.NewDryRun(mock.API{}, &buf, json)))
+				if strings.Contains(buf.String(), testcase.secret) {
+					t.Fatalf("want secrets redacted, have:\n%s", buf.String())
+				}
+				testutil.AssertStringContains(t, buf.String(), testcase.want)
+				testutil.AssertStringContains(t, buf.String(), "redacted")
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	key := "AKIA"
+	input := &fastly.CreateS3Input{Name: "logs", SecretKey: "s3cr3t", AccessKey: key}
+	redacted := api.Redact(input).(*fastly.CreateS3Input)
+	testutil.AssertString(t, "logs", redacted.Name)
+	testutil.AssertString(t, api.Redacted, redacted.SecretKey)
+	testutil.AssertString(t, api.Redacted, redacted.AccessKey)
+	testutil.AssertString(t, "s3cr3t", input.SecretKey)
+
+	token := api.Redact(fastly.Token{Name: "ci", AccessToken: "abc"}).(fastly.Token)
+	testutil.AssertString(t, "ci", token.Name)
+	testutil.AssertString(t, api.Redacted, token.AccessToken)
+
+	update := api.Redact(&fastly.UpdateS3Input{Name: "logs", AccessKey: &key}).(*fastly.UpdateS3Input)
+	testutil.AssertString(t, api.Redacted, *update.AccessKey)
+	testutil.AssertString(t, "AKIA", key)
+	if update.SecretKey != nil {
+		t.Fatal("want unset secret to stay unset")
+	}
+
+	if api.Redact(nil) != nil {
+		t.Fatal("want nil unchanged")
+	}
+
+	// The URL is only a secret for kinds of endpoint that embed credentials in
+	// it.
+	https := api.Redact(fastly.HTTPS{URL: "https://example.com"}).(fastly.HTTPS)
+	testutil.AssertString(t, "https://example.com", https.URL)
+	sumologic := api.Redact(fastly.Sumologic{URL: "https://example.com"}).(fastly.Sumologic)
+	testutil.AssertString(t, api.Redacted, sumologic.URL)
+}
+
+func isMutating(name string) bool {
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -37,6 +37,7 @@ type Interface interface {
 	DeactivateVersion(*fastly.DeactivateVersionInput) (*fastly.Version, error)
 	LockVersion(*fastly.LockVersionInput) (*fastly.Version, error)
 	LatestVersion(*fastly.LatestVersionInput) (*fastly.Version, error)
+	ValidateVersion(*fastly.ValidateVersionInput) (bool, string, error)
 
 	CreateDomain(*fastly.CreateDomainInput) (*fastly.Domain, error)
 	ListDomains(*fastly.ListDomainsInput) ([]*fastly.Domain, error)
@@ -56,6 +57,88 @@ type Interface interface {
 	UpdateHealthCheck(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheck(*fastly.DeleteHealthCheckInput) error
 
+	CreateCondition(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditions(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetCondition(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateCondition(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteCondition(*fastly.DeleteConditionInput) error
+
+	CreateHeader(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeaders(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeader(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeader(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeader(*fastly.DeleteHeaderInput) error
+
+	CreateCacheSetting(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettings(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSetting(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSetting(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSetting(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSetting(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettings(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSetting(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSetting(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSetting(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObject(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjects(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObject(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObject(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObject(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzip(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzips(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzip(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzip(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzip(*fastly.DeleteGzipInput) error
+
+	CreateDirector(*fastly.CreateDirectorInput) (*fastly.Director, error)
+	ListDirectors(*fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	GetDirector(*fastly.GetDirectorInput) (*fastly.Director, error)
+	UpdateDirector(*fastly.UpdateDirectorInput) (*fastly.Director, error)
+	DeleteDirector(*fastly.DeleteDirectorInput) error
+
+	CreateDirectorBackend(*fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDirectorBackend(*fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	DeleteDirectorBackend(*fastly.DeleteDirectorBackendInput) error
+
+	CreatePool(*fastly.CreatePoolInput) (*fastly.Pool, error)
+	ListPools(*fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	GetPool(*fastly.GetPoolInput) (*fastly.Pool, error)
+	UpdatePool(*fastly.UpdatePoolInput) (*fastly.Pool, error)
+	DeletePool(*fastly.DeletePoolInput) error
+
+	CreateServer(*fastly.CreateServerInput) (*fastly.Server, error)
+	ListServers(*fastly.ListServersInput) ([]*fastly.Server, error)
+	GetServer(*fastly.GetServerInput) (*fastly.Server, error)
+	UpdateServer(*fastly.UpdateServerInput) (*fastly.Server, error)
+	DeleteServer(*fastly.DeleteServerInput) error
+
+	CreateCustomTLSCertificate(*fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	ListCustomTLSCertificates(*fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error)
+	GetCustomTLSCertificate(*fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	UpdateCustomTLSCertificate(*fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	DeleteCustomTLSCertificate(*fastly.DeleteCustomTLSCertificateInput) error
+
+	CreatePrivateKey(*fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error)
+	ListPrivateKeys(*fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error)
+	GetPrivateKey(*fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error)
+	DeletePrivateKey(*fastly.DeletePrivateKeyInput) error
+
+	ListTLSDomains(*fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error)
+
+	CreateTLSActivation(*fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error)
+	ListTLSActivations(*fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error)
+	GetTLSActivation(*fastly.GetTLSActivationInput) (*fastly.TLSActivation, error)
+	UpdateTLSActivation(*fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error)
+	DeleteTLSActivation(*fastly.DeleteTLSActivationInput) error
+
+	CreateTLSSubscription(*fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	ListTLSSubscriptions(*fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error)
+	GetTLSSubscription(*fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	DeleteTLSSubscription(*fastly.DeleteTLSSubscriptionInput) error
+
 	GetPackage(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackage(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -224,7 +307,18 @@ type Interface interface {
 	UpdateOpenstack(*fastly.UpdateOpenstackInput) (*fastly.Openstack, error)
 	DeleteOpenstack(*fastly.DeleteOpenstackInput) error
 
+	CreateUser(*fastly.CreateUserInput) (*fastly.User, error)
+	ListCustomerUsers(*fastly.ListCustomerUsersInput) ([]*fastly.User, error)
 	GetUser(*fastly.GetUserInput) (*fastly.User, error)
+	GetCurrentUser() (*fastly.User, error)
+	UpdateUser(*fastly.UpdateUserInput) (*fastly.User, error)
+	DeleteUser(*fastly.DeleteUserInput) error
+
+	CreateToken(*fastly.CreateTokenInput) (*fastly.Token, error)
+	ListTokens() ([]*fastly.Token, error)
+	ListCustomerTokens(*fastly.ListCustomerTokensInput) ([]*fastly.Token, error)
+	DeleteToken(*fastly.DeleteTokenInput) error
+	DeleteTokenSelf() error
 
 	GetRegions() (*fastly.RegionsResponse, error)
 	GetStatsJSON(*fastly.GetStatsInput, interface{}) error
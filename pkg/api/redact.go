@@ -0,0 +1,113 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces the value of a secret field wherever it would otherwise be
+// displayed or written to disk.
+const Redacted = "<redacted>"
+
+// secretFields are the API fields that hold credentials, keyed by their name
+// in the API.
+var secretFields = map[string]bool{
+	"access_key":     true,
+	"access_token":   true,
+	"header_value":   true,
+	"password":       true,
+	"sas_token":      true,
+	"secret_key":     true,
+	"ssl_client_key": true,
+	"tls_client_key": true,
+	"token":          true,
+}
+
+// kindSecretFields are the API fields that only hold credentials for a
+// particular kind of resource, such as the URL of a Sumo Logic collector,
+// which embeds the collector's secret.
+var kindSecretFields = map[string]map[string]bool{
+	"sumologic": {"url": true},
+}
+
+// IsSecret reports whether the API field called name holds a credential, such
+// as a password or the key of a logging endpoint, for the given kind of
+// resource (see Kind).
+func IsSecret(kind, name string) bool {
+	return secretFields[name] || kindSecretFields[kind][name]
+}
+
+// Kind returns the kind of resource described by v, the input or result of an
+// Interface method, e.g. "sumologic" for a CreateSumologicInput.
+func Kind(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return ""
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := strings.TrimSuffix(t.Name(), "Input")
+	for _, prefix := range []string{"Create", "Update", "Delete", "Get", "List"} {
+		if strings.HasPrefix(name, prefix) {
+			name = strings.TrimPrefix(name, prefix)
+			break
+		}
+	}
+	return strings.ToLower(name)
+}
+
+// Redact returns a copy of v, the input or result of an Interface method, with
+// the value of every secret field that's set replaced by Redacted. Values that
+// aren't a struct, or a pointer to one, are returned unchanged.
+func Redact(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	ptr := rv.Kind() == reflect.Ptr
+	if ptr {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+
+	kind := Kind(v)
+	c := reflect.New(rv.Type()).Elem()
+	c.Set(rv)
+	for i := 0; i < c.NumField(); i++ {
+		f := c.Field(i)
+		if !f.CanSet() || f.IsZero() || !IsSecret(kind, fieldName(c.Type().Field(i))) {
+			continue
+		}
+		switch {
+		case f.Kind() == reflect.String:
+			f.SetString(Redacted)
+		case f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.String:
+			// The pointer is replaced rather than written through, as it's
+			// shared with v.
+			s := reflect.New(f.Type().Elem())
+			s.Elem().SetString(Redacted)
+			f.Set(s)
+		default:
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+
+	if ptr {
+		return c.Addr().Interface()
+	}
+	return c.Interface()
+}
+
+// fieldName returns the API name of a go-fastly struct field, taken from the
+// form tag of inputs or the mapstructure tag of results.
+func fieldName(f reflect.StructField) string {
+	for _, key := range []string{"form", "mapstructure", "url"} {
+		if tag, ok := f.Tag.Lookup(key); ok {
+			return strings.Split(tag, ",")[0]
+		}
+	}
+	return ""
+}
@@ -7,19 +7,30 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/acl"
 	"github.com/fastly/cli/pkg/commands/aclentry"
+	"github.com/fastly/cli/pkg/commands/auth"
+	"github.com/fastly/cli/pkg/commands/authtoken"
 	"github.com/fastly/cli/pkg/commands/backend"
+	"github.com/fastly/cli/pkg/commands/cachesetting"
 	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/condition"
 	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/commands/director"
+	"github.com/fastly/cli/pkg/commands/directorbackend"
 	"github.com/fastly/cli/pkg/commands/domain"
 	"github.com/fastly/cli/pkg/commands/edgedictionary"
 	"github.com/fastly/cli/pkg/commands/edgedictionaryitem"
+	"github.com/fastly/cli/pkg/commands/gzip"
+	"github.com/fastly/cli/pkg/commands/header"
 	"github.com/fastly/cli/pkg/commands/healthcheck"
+	"github.com/fastly/cli/pkg/commands/history"
 	"github.com/fastly/cli/pkg/commands/ip"
 	"github.com/fastly/cli/pkg/commands/logging"
 	"github.com/fastly/cli/pkg/commands/logging/azureblob"
@@ -49,12 +60,26 @@ import (
 	"github.com/fastly/cli/pkg/commands/logging/sumologic"
 	"github.com/fastly/cli/pkg/commands/logging/syslog"
 	"github.com/fastly/cli/pkg/commands/logs"
+	"github.com/fastly/cli/pkg/commands/pool"
+	"github.com/fastly/cli/pkg/commands/poolserver"
 	"github.com/fastly/cli/pkg/commands/pop"
+	"github.com/fastly/cli/pkg/commands/profile"
 	"github.com/fastly/cli/pkg/commands/purge"
+	"github.com/fastly/cli/pkg/commands/requestsetting"
+	"github.com/fastly/cli/pkg/commands/responseobject"
 	"github.com/fastly/cli/pkg/commands/service"
 	"github.com/fastly/cli/pkg/commands/serviceversion"
+	editsession "github.com/fastly/cli/pkg/commands/session"
 	"github.com/fastly/cli/pkg/commands/stats"
+	"github.com/fastly/cli/pkg/commands/tls"
+	"github.com/fastly/cli/pkg/commands/tlscustom"
+	"github.com/fastly/cli/pkg/commands/tlscustom/activation"
+	"github.com/fastly/cli/pkg/commands/tlscustom/certificate"
+	tlsdomain "github.com/fastly/cli/pkg/commands/tlscustom/domain"
+	"github.com/fastly/cli/pkg/commands/tlscustom/privatekey"
+	"github.com/fastly/cli/pkg/commands/tlssubscription"
 	"github.com/fastly/cli/pkg/commands/update"
+	"github.com/fastly/cli/pkg/commands/user"
 	"github.com/fastly/cli/pkg/commands/vcl"
 	"github.com/fastly/cli/pkg/commands/vcl/custom"
 	"github.com/fastly/cli/pkg/commands/vcl/snippet"
@@ -63,7 +88,9 @@ import (
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/env"
 	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/journal"
 	"github.com/fastly/cli/pkg/revision"
+	"github.com/fastly/cli/pkg/session"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v3/fastly"
 	"github.com/fastly/kingpin"
@@ -71,6 +98,17 @@ import (
 
 var (
 	completionRegExp = regexp.MustCompile("completion-(?:script-)?(?:bash|zsh)$")
+
+	// unlockExempt are the commands that never use the stored API token, and
+	// so shouldn't prompt for the passphrase of an encrypted token.
+	unlockExempt = map[string]bool{
+		"auth login":    true,
+		"configure":     true,
+		"history":       true,
+		"session abort": true,
+		"update":        true,
+		"version":       true,
+	}
 )
 
 // Versioners represents all supported versioner types.
@@ -81,16 +119,19 @@ type Versioners struct {
 
 // RunOpts represent arguments to Run()
 type RunOpts struct {
-	APIClient  APIClientFactory
-	Args       []string
-	ConfigFile config.File
-	ConfigPath string
-	Env        config.Environment
-	ErrLog     errors.LogInterface
-	HTTPClient api.HTTPClient
-	Stdin      io.Reader
-	Stdout     io.Writer
-	Versioners Versioners
+	APIClient   APIClientFactory
+	Args        []string
+	ConfigFile  config.File
+	ConfigPath  string
+	Env         config.Environment
+	ErrLog      errors.LogInterface
+	HTTPClient  api.HTTPClient
+	JournalPath string
+	RTSClient   RTSClientFactory
+	SessionPath string
+	Stdin       io.Reader
+	Stdout      io.Writer
+	Versioners  Versioners
 }
 
 // Run constructs the application including all of the subcommands, parses the
@@ -113,6 +154,20 @@ func Run(opts RunOpts) error {
 		ErrLog: opts.ErrLog,
 	}
 
+	// The edit session must be known before the commands are constructed, as
+	// it provides the defaults for the --service-id and --version flags.
+	if opts.SessionPath != "" {
+		s, err := session.Read(opts.SessionPath)
+		if err != nil {
+			globals.ErrLog.Add(err)
+			return errors.RemediationError{
+				Inner:       err,
+				Remediation: fmt.Sprintf("Remove %s to discard the edit session.", opts.SessionPath),
+			}
+		}
+		globals.Session = s
+	}
+
 	// Set up the main application root, including global flags, and then each
 	// of the subcommands. Note that we deliberately don't use some of the more
 	// advanced features of the kingpin.Application flags, like env var
@@ -141,7 +196,11 @@ func Run(opts RunOpts) error {
 	tokenHelp := fmt.Sprintf("Fastly API token (or via %s)", env.Token)
 	app.Flag("token", tokenHelp).Short('t').StringVar(&globals.Flag.Token)
 	app.Flag("verbose", "Verbose logging").Short('v').BoolVar(&globals.Flag.Verbose)
+	app.Flag("json", "Render list and describe output as JSON").BoolVar(&globals.Flag.JSON)
+	app.Flag("dry-run", "Print the API calls that would modify the account instead of making them").BoolVar(&globals.Flag.DryRun)
 	app.Flag("endpoint", "Fastly API endpoint").Hidden().StringVar(&globals.Flag.Endpoint)
+	profileHelp := fmt.Sprintf("Credential profile to use (or via %s)", env.Profile)
+	app.Flag("profile", profileHelp).StringVar(&globals.Flag.Profile)
 
 	aclCmdRoot := acl.NewRootCommand(app, &globals)
 	aclCreate := acl.NewCreateCommand(aclCmdRoot.CmdClause, &globals)
@@ -155,12 +214,26 @@ func Run(opts RunOpts) error {
 	aclEntryDescribe := aclentry.NewDescribeCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryList := aclentry.NewListCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryUpdate := aclentry.NewUpdateCommand(aclEntryCmdRoot.CmdClause, &globals)
+	authCmdRoot := auth.NewRootCommand(app, &globals)
+	authLogin := auth.NewLoginCommand(authCmdRoot.CmdClause, opts.HTTPClient, opts.ConfigPath, auth.APIClientFactory(opts.APIClient), &globals)
+	authLogout := auth.NewLogoutCommand(authCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	authTokenCmdRoot := authtoken.NewRootCommand(app, &globals)
+	authTokenCreate := authtoken.NewCreateCommand(authTokenCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	authTokenDelete := authtoken.NewDeleteCommand(authTokenCmdRoot.CmdClause, &globals)
+	authTokenDeleteCurrent := authtoken.NewDeleteCurrentCommand(authTokenCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	authTokenList := authtoken.NewListCommand(authTokenCmdRoot.CmdClause, &globals)
 	backendCmdRoot := backend.NewRootCommand(app, &globals)
 	backendCreate := backend.NewCreateCommand(backendCmdRoot.CmdClause, &globals)
 	backendDelete := backend.NewDeleteCommand(backendCmdRoot.CmdClause, &globals)
 	backendDescribe := backend.NewDescribeCommand(backendCmdRoot.CmdClause, &globals)
 	backendList := backend.NewListCommand(backendCmdRoot.CmdClause, &globals)
 	backendUpdate := backend.NewUpdateCommand(backendCmdRoot.CmdClause, &globals)
+	cacheSettingCmdRoot := cachesetting.NewRootCommand(app, &globals)
+	cacheSettingCreate := cachesetting.NewCreateCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingDelete := cachesetting.NewDeleteCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingDescribe := cachesetting.NewDescribeCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingList := cachesetting.NewListCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingUpdate := cachesetting.NewUpdateCommand(cacheSettingCmdRoot.CmdClause, &globals)
 	computeCmdRoot := compute.NewRootCommand(app, &globals)
 	computeBuild := compute.NewBuildCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
 	computeDeploy := compute.NewDeployCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
@@ -170,6 +243,12 @@ func Run(opts RunOpts) error {
 	computeServe := compute.NewServeCommand(computeCmdRoot.CmdClause, &globals, computeBuild, opts.Versioners.Viceroy)
 	computeUpdate := compute.NewUpdateCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
 	computeValidate := compute.NewValidateCommand(computeCmdRoot.CmdClause, &globals)
+	conditionCmdRoot := condition.NewRootCommand(app, &globals)
+	conditionCreate := condition.NewCreateCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionDelete := condition.NewDeleteCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionDescribe := condition.NewDescribeCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionList := condition.NewListCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionUpdate := condition.NewUpdateCommand(conditionCmdRoot.CmdClause, &globals)
 	configureCmdRoot := configure.NewRootCommand(app, opts.ConfigPath, configure.APIClientFactory(opts.APIClient), &globals)
 	dictionaryCmdRoot := edgedictionary.NewRootCommand(app, &globals)
 	dictionaryCreate := edgedictionary.NewCreateCommand(dictionaryCmdRoot.CmdClause, &globals)
@@ -184,18 +263,41 @@ func Run(opts RunOpts) error {
 	dictionaryItemUpdate := edgedictionaryitem.NewUpdateCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryList := edgedictionary.NewListCommand(dictionaryCmdRoot.CmdClause, &globals)
 	dictionaryUpdate := edgedictionary.NewUpdateCommand(dictionaryCmdRoot.CmdClause, &globals)
+	directorCmdRoot := director.NewRootCommand(app, &globals)
+	directorBackendCmdRoot := directorbackend.NewRootCommand(directorCmdRoot.CmdClause, &globals)
+	directorBackendAdd := directorbackend.NewAddCommand(directorBackendCmdRoot.CmdClause, &globals)
+	directorBackendList := directorbackend.NewListCommand(directorBackendCmdRoot.CmdClause, &globals)
+	directorBackendRemove := directorbackend.NewRemoveCommand(directorBackendCmdRoot.CmdClause, &globals)
+	directorCreate := director.NewCreateCommand(directorCmdRoot.CmdClause, &globals)
+	directorDelete := director.NewDeleteCommand(directorCmdRoot.CmdClause, &globals)
+	directorDescribe := director.NewDescribeCommand(directorCmdRoot.CmdClause, &globals)
+	directorList := director.NewListCommand(directorCmdRoot.CmdClause, &globals)
+	directorUpdate := director.NewUpdateCommand(directorCmdRoot.CmdClause, &globals)
 	domainCmdRoot := domain.NewRootCommand(app, &globals)
 	domainCreate := domain.NewCreateCommand(domainCmdRoot.CmdClause, &globals)
 	domainDelete := domain.NewDeleteCommand(domainCmdRoot.CmdClause, &globals)
 	domainDescribe := domain.NewDescribeCommand(domainCmdRoot.CmdClause, &globals)
 	domainList := domain.NewListCommand(domainCmdRoot.CmdClause, &globals)
 	domainUpdate := domain.NewUpdateCommand(domainCmdRoot.CmdClause, &globals)
+	gzipCmdRoot := gzip.NewRootCommand(app, &globals)
+	gzipCreate := gzip.NewCreateCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipDelete := gzip.NewDeleteCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipDescribe := gzip.NewDescribeCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipList := gzip.NewListCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipUpdate := gzip.NewUpdateCommand(gzipCmdRoot.CmdClause, &globals)
+	headerCmdRoot := header.NewRootCommand(app, &globals)
+	headerCreate := header.NewCreateCommand(headerCmdRoot.CmdClause, &globals)
+	headerDelete := header.NewDeleteCommand(headerCmdRoot.CmdClause, &globals)
+	headerDescribe := header.NewDescribeCommand(headerCmdRoot.CmdClause, &globals)
+	headerList := header.NewListCommand(headerCmdRoot.CmdClause, &globals)
+	headerUpdate := header.NewUpdateCommand(headerCmdRoot.CmdClause, &globals)
 	healthcheckCmdRoot := healthcheck.NewRootCommand(app, &globals)
 	healthcheckCreate := healthcheck.NewCreateCommand(healthcheckCmdRoot.CmdClause, &globals)
 	healthcheckDelete := healthcheck.NewDeleteCommand(healthcheckCmdRoot.CmdClause, &globals)
 	healthcheckDescribe := healthcheck.NewDescribeCommand(healthcheckCmdRoot.CmdClause, &globals)
 	healthcheckList := healthcheck.NewListCommand(healthcheckCmdRoot.CmdClause, &globals)
 	healthcheckUpdate := healthcheck.NewUpdateCommand(healthcheckCmdRoot.CmdClause, &globals)
+	historyCmdRoot := history.NewRootCommand(app, opts.JournalPath, &globals)
 	ipCmdRoot := ip.NewRootCommand(app, &globals)
 	loggingCmdRoot := logging.NewRootCommand(app, &globals)
 	loggingAzureblobCmdRoot := azureblob.NewRootCommand(loggingCmdRoot.CmdClause, &globals)
@@ -356,27 +458,102 @@ func Run(opts RunOpts) error {
 	loggingSyslogUpdate := syslog.NewUpdateCommand(loggingSyslogCmdRoot.CmdClause, &globals)
 	logsCmdRoot := logs.NewRootCommand(app, &globals)
 	logsTail := logs.NewTailCommand(logsCmdRoot.CmdClause, &globals)
+	poolCmdRoot := pool.NewRootCommand(app, &globals)
+	poolCreate := pool.NewCreateCommand(poolCmdRoot.CmdClause, &globals)
+	poolDelete := pool.NewDeleteCommand(poolCmdRoot.CmdClause, &globals)
+	poolDescribe := pool.NewDescribeCommand(poolCmdRoot.CmdClause, &globals)
+	poolList := pool.NewListCommand(poolCmdRoot.CmdClause, &globals)
+	poolServerCmdRoot := poolserver.NewRootCommand(poolCmdRoot.CmdClause, &globals)
+	poolServerAdd := poolserver.NewAddCommand(poolServerCmdRoot.CmdClause, &globals)
+	poolServerList := poolserver.NewListCommand(poolServerCmdRoot.CmdClause, &globals)
+	poolServerRemove := poolserver.NewRemoveCommand(poolServerCmdRoot.CmdClause, &globals)
+	poolServerUpdate := poolserver.NewUpdateCommand(poolServerCmdRoot.CmdClause, &globals)
+	poolUpdate := pool.NewUpdateCommand(poolCmdRoot.CmdClause, &globals)
 	popCmdRoot := pop.NewRootCommand(app, &globals)
+	profileCmdRoot := profile.NewRootCommand(app, &globals)
+	profileCreate := profile.NewCreateCommand(profileCmdRoot.CmdClause, opts.ConfigPath, profile.APIClientFactory(opts.APIClient), &globals)
+	profileDelete := profile.NewDeleteCommand(profileCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	profileList := profile.NewListCommand(profileCmdRoot.CmdClause, &globals)
+	profileUpdate := profile.NewUpdateCommand(profileCmdRoot.CmdClause, opts.ConfigPath, profile.APIClientFactory(opts.APIClient), &globals)
+	profileUse := profile.NewUseCommand(profileCmdRoot.CmdClause, opts.ConfigPath, &globals)
 	purgeCmdRoot := purge.NewRootCommand(app, &globals)
+	requestSettingCmdRoot := requestsetting.NewRootCommand(app, &globals)
+	requestSettingCreate := requestsetting.NewCreateCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingDelete := requestsetting.NewDeleteCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingDescribe := requestsetting.NewDescribeCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingList := requestsetting.NewListCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingUpdate := requestsetting.NewUpdateCommand(requestSettingCmdRoot.CmdClause, &globals)
+	responseObjectCmdRoot := responseobject.NewRootCommand(app, &globals)
+	responseObjectCreate := responseobject.NewCreateCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectDelete := responseobject.NewDeleteCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectDescribe := responseobject.NewDescribeCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectList := responseobject.NewListCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectUpdate := responseobject.NewUpdateCommand(responseObjectCmdRoot.CmdClause, &globals)
 	serviceCmdRoot := service.NewRootCommand(app, &globals)
+	serviceApply := service.NewApplyCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceCreate := service.NewCreateCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceDelete := service.NewDeleteCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceDescribe := service.NewDescribeCommand(serviceCmdRoot.CmdClause, &globals)
+	serviceExport := service.NewExportCommand(serviceCmdRoot.CmdClause, &globals)
+	serviceLint := service.NewLintCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceList := service.NewListCommand(serviceCmdRoot.CmdClause, &globals)
+	servicePlan := service.NewPlanCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceSearch := service.NewSearchCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceUpdate := service.NewUpdateCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceVersionCmdRoot := serviceversion.NewRootCommand(app, &globals)
 	serviceVersionActivate := serviceversion.NewActivateCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionActivateGroup := serviceversion.NewActivateGroupCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionClone := serviceversion.NewCloneCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionDeactivate := serviceversion.NewDeactivateCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionDiff := serviceversion.NewDiffCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionList := serviceversion.NewListCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionLock := serviceversion.NewLockCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionRollback := serviceversion.NewRollbackCommand(serviceVersionCmdRoot.CmdClause, opts.JournalPath, &globals)
 	serviceVersionUpdate := serviceversion.NewUpdateCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	sessionCmdRoot := editsession.NewRootCommand(app, &globals)
+	sessionAbort := editsession.NewAbortCommand(sessionCmdRoot.CmdClause, opts.SessionPath, &globals)
+	sessionBegin := editsession.NewBeginCommand(sessionCmdRoot.CmdClause, opts.SessionPath, &globals)
+	sessionCommit := editsession.NewCommitCommand(sessionCmdRoot.CmdClause, opts.SessionPath, &globals)
+	sessionStatus := editsession.NewStatusCommand(sessionCmdRoot.CmdClause, &globals)
 	statsCmdRoot := stats.NewRootCommand(app, &globals)
 	statsHistorical := stats.NewHistoricalCommand(statsCmdRoot.CmdClause, &globals)
 	statsRealtime := stats.NewRealtimeCommand(statsCmdRoot.CmdClause, &globals)
 	statsRegions := stats.NewRegionsCommand(statsCmdRoot.CmdClause, &globals)
+	tlsCmdRoot := tls.NewRootCommand(app, &globals)
+	tlsReport := tls.NewReportCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsCustomCmdRoot := tlscustom.NewRootCommand(app, &globals)
+	tlsCustomActivationCmdRoot := activation.NewRootCommand(tlsCustomCmdRoot.CmdClause, &globals)
+	tlsCustomActivationCreate := activation.NewCreateCommand(tlsCustomActivationCmdRoot.CmdClause, &globals)
+	tlsCustomActivationDelete := activation.NewDeleteCommand(tlsCustomActivationCmdRoot.CmdClause, &globals)
+	tlsCustomActivationDescribe := activation.NewDescribeCommand(tlsCustomActivationCmdRoot.CmdClause, &globals)
+	tlsCustomActivationList := activation.NewListCommand(tlsCustomActivationCmdRoot.CmdClause, &globals)
+	tlsCustomActivationUpdate := activation.NewUpdateCommand(tlsCustomActivationCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateCmdRoot := certificate.NewRootCommand(tlsCustomCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateCreate := certificate.NewCreateCommand(tlsCustomCertificateCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateDelete := certificate.NewDeleteCommand(tlsCustomCertificateCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateDescribe := certificate.NewDescribeCommand(tlsCustomCertificateCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateList := certificate.NewListCommand(tlsCustomCertificateCmdRoot.CmdClause, &globals)
+	tlsCustomCertificateUpdate := certificate.NewUpdateCommand(tlsCustomCertificateCmdRoot.CmdClause, &globals)
+	tlsCustomDomainCmdRoot := tlsdomain.NewRootCommand(tlsCustomCmdRoot.CmdClause, &globals)
+	tlsCustomDomainList := tlsdomain.NewListCommand(tlsCustomDomainCmdRoot.CmdClause, &globals)
+	tlsCustomPrivateKeyCmdRoot := privatekey.NewRootCommand(tlsCustomCmdRoot.CmdClause, &globals)
+	tlsCustomPrivateKeyCreate := privatekey.NewCreateCommand(tlsCustomPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsCustomPrivateKeyDelete := privatekey.NewDeleteCommand(tlsCustomPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsCustomPrivateKeyDescribe := privatekey.NewDescribeCommand(tlsCustomPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsCustomPrivateKeyList := privatekey.NewListCommand(tlsCustomPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsSubscriptionCmdRoot := tlssubscription.NewRootCommand(app, &globals)
+	tlsSubscriptionCreate := tlssubscription.NewCreateCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionDelete := tlssubscription.NewDeleteCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionDescribe := tlssubscription.NewDescribeCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionList := tlssubscription.NewListCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	undoCmdRoot := history.NewUndoCommand(app, opts.JournalPath, &globals)
 	updateRoot := update.NewRootCommand(app, opts.ConfigPath, opts.Versioners.CLI, opts.HTTPClient, &globals)
+	userCmdRoot := user.NewRootCommand(app, &globals)
+	userCreate := user.NewCreateCommand(userCmdRoot.CmdClause, &globals)
+	userDelete := user.NewDeleteCommand(userCmdRoot.CmdClause, &globals)
+	userDescribe := user.NewDescribeCommand(userCmdRoot.CmdClause, &globals)
+	userList := user.NewListCommand(userCmdRoot.CmdClause, &globals)
+	userUpdate := user.NewUpdateCommand(userCmdRoot.CmdClause, &globals)
 	vclCmdRoot := vcl.NewRootCommand(app, &globals)
 	vclCustomCmdRoot := custom.NewRootCommand(vclCmdRoot.CmdClause, &globals)
 	vclCustomCreate := custom.NewCreateCommand(vclCustomCmdRoot.CmdClause, &globals)
@@ -406,12 +583,26 @@ func Run(opts RunOpts) error {
 		aclEntryDescribe,
 		aclEntryList,
 		aclEntryUpdate,
+		authCmdRoot,
+		authLogin,
+		authLogout,
+		authTokenCmdRoot,
+		authTokenCreate,
+		authTokenDelete,
+		authTokenDeleteCurrent,
+		authTokenList,
 		backendCmdRoot,
 		backendCreate,
 		backendDelete,
 		backendDescribe,
 		backendList,
 		backendUpdate,
+		cacheSettingCmdRoot,
+		cacheSettingCreate,
+		cacheSettingDelete,
+		cacheSettingDescribe,
+		cacheSettingList,
+		cacheSettingUpdate,
 		computeBuild,
 		computeCmdRoot,
 		computeDeploy,
@@ -421,6 +612,12 @@ func Run(opts RunOpts) error {
 		computeServe,
 		computeUpdate,
 		computeValidate,
+		conditionCmdRoot,
+		conditionCreate,
+		conditionDelete,
+		conditionDescribe,
+		conditionList,
+		conditionUpdate,
 		configureCmdRoot,
 		dictionaryCmdRoot,
 		dictionaryCreate,
@@ -435,18 +632,41 @@ func Run(opts RunOpts) error {
 		dictionaryItemUpdate,
 		dictionaryList,
 		dictionaryUpdate,
+		directorBackendAdd,
+		directorBackendCmdRoot,
+		directorBackendList,
+		directorBackendRemove,
+		directorCmdRoot,
+		directorCreate,
+		directorDelete,
+		directorDescribe,
+		directorList,
+		directorUpdate,
 		domainCmdRoot,
 		domainCreate,
 		domainDelete,
 		domainDescribe,
 		domainList,
 		domainUpdate,
+		gzipCmdRoot,
+		gzipCreate,
+		gzipDelete,
+		gzipDescribe,
+		gzipList,
+		gzipUpdate,
+		headerCmdRoot,
+		headerCreate,
+		headerDelete,
+		headerDescribe,
+		headerList,
+		headerUpdate,
 		healthcheckCmdRoot,
 		healthcheckCreate,
 		healthcheckDelete,
 		healthcheckDescribe,
 		healthcheckList,
 		healthcheckUpdate,
+		historyCmdRoot,
 		ipCmdRoot,
 		loggingAzureblobCmdRoot,
 		loggingAzureblobCreate,
@@ -607,27 +827,102 @@ func Run(opts RunOpts) error {
 		loggingSyslogUpdate,
 		logsCmdRoot,
 		logsTail,
+		poolCmdRoot,
+		poolCreate,
+		poolDelete,
+		poolDescribe,
+		poolList,
+		poolServerAdd,
+		poolServerCmdRoot,
+		poolServerList,
+		poolServerRemove,
+		poolServerUpdate,
+		poolUpdate,
 		popCmdRoot,
+		profileCmdRoot,
+		profileCreate,
+		profileDelete,
+		profileList,
+		profileUpdate,
+		profileUse,
 		purgeCmdRoot,
+		requestSettingCmdRoot,
+		requestSettingCreate,
+		requestSettingDelete,
+		requestSettingDescribe,
+		requestSettingList,
+		requestSettingUpdate,
+		responseObjectCmdRoot,
+		responseObjectCreate,
+		responseObjectDelete,
+		responseObjectDescribe,
+		responseObjectList,
+		responseObjectUpdate,
+		serviceApply,
 		serviceCmdRoot,
 		serviceCreate,
 		serviceDelete,
 		serviceDescribe,
+		serviceExport,
+		serviceLint,
 		serviceList,
+		servicePlan,
 		serviceSearch,
 		serviceUpdate,
 		serviceVersionActivate,
+		serviceVersionActivateGroup,
 		serviceVersionClone,
 		serviceVersionCmdRoot,
 		serviceVersionDeactivate,
+		serviceVersionDiff,
 		serviceVersionList,
 		serviceVersionLock,
+		serviceVersionRollback,
 		serviceVersionUpdate,
+		sessionAbort,
+		sessionBegin,
+		sessionCmdRoot,
+		sessionCommit,
+		sessionStatus,
 		statsCmdRoot,
 		statsHistorical,
 		statsRealtime,
 		statsRegions,
+		tlsCustomActivationCmdRoot,
+		tlsCustomActivationCreate,
+		tlsCustomActivationDelete,
+		tlsCustomActivationDescribe,
+		tlsCustomActivationList,
+		tlsCustomActivationUpdate,
+		tlsCustomCertificateCmdRoot,
+		tlsCustomCertificateCreate,
+		tlsCustomCertificateDelete,
+		tlsCustomCertificateDescribe,
+		tlsCustomCertificateList,
+		tlsCustomCertificateUpdate,
+		tlsCmdRoot,
+		tlsCustomCmdRoot,
+		tlsCustomDomainCmdRoot,
+		tlsCustomDomainList,
+		tlsCustomPrivateKeyCmdRoot,
+		tlsCustomPrivateKeyCreate,
+		tlsCustomPrivateKeyDelete,
+		tlsCustomPrivateKeyDescribe,
+		tlsCustomPrivateKeyList,
+		tlsReport,
+		tlsSubscriptionCmdRoot,
+		tlsSubscriptionCreate,
+		tlsSubscriptionDelete,
+		tlsSubscriptionDescribe,
+		tlsSubscriptionList,
+		undoCmdRoot,
 		updateRoot,
+		userCmdRoot,
+		userCreate,
+		userDelete,
+		userDescribe,
+		userList,
+		userUpdate,
 		vclCmdRoot,
 		vclCustomCmdRoot,
 		vclCustomCreate,
@@ -704,43 +999,97 @@ func Run(opts RunOpts) error {
 		return errors.RemediationError{Prefix: buf.String()}
 	}
 
-	token, source := globals.Token()
+	// Diagnostic notices are suppressed when --json is set so that they don't
+	// corrupt the machine readable output. Verbose mode is switched off too, as
+	// commands write their verbose output, such as the version that --autoclone
+	// created, to the same writer as the JSON.
+	notices := opts.Stdout
+	if globals.JSON() {
+		notices = io.Discard
+		globals.Flag.Verbose = false
+	}
+
+	if err := checkSessionService(globals.Session, app, opts.Args, globals.Verbose(), notices); err != nil {
+		globals.ErrLog.Add(err)
+		return err
+	}
+
+	// An explicitly selected profile must exist, otherwise we would silently
+	// fall back to whatever other credentials happen to be available. The
+	// configure and profile commands are exempt as they create profiles.
+	if profileName, source := globals.Profile(); source == config.SourceFlag || source == config.SourceEnvironment {
+		if _, ok := globals.File.Profiles[profileName]; !ok && name != "configure" && !strings.HasPrefix(name, "profile ") {
+			err := fmt.Errorf("profile '%s' does not exist", profileName)
+			globals.ErrLog.Add(err)
+			return errors.RemediationError{Inner: err, Remediation: errors.ProfileRemediation}
+		}
+	}
+
+	// An encrypted token in the config file is decrypted up front, so that it's
+	// transparently available via globals.Token(). Commands that don't use the
+	// stored token are exempt, so they never prompt for the passphrase.
+	if globals.EncryptedToken() != "" && !unlockExempt[name] {
+		passphrase := globals.Env.TokenPassphrase
+		if passphrase == "" {
+			passphrase, err = text.InputSecure(opts.Stdout, "Passphrase for the encrypted API token: ", opts.Stdin)
+			if err != nil {
+				return err
+			}
+			text.Break(notices)
+		}
+		if err := globals.Unlock(passphrase); err != nil {
+			globals.ErrLog.Add(err)
+			return errors.RemediationError{
+				Inner:       err,
+				Remediation: fmt.Sprintf("Check the passphrase, which can also be provided via %s. To replace the stored token run `fastly configure`.", env.TokenPassphrase),
+			}
+		}
+	}
+
+	// Commands exempt from unlocking the stored token never use it, so it being
+	// locked is only an error for the others.
+	token, source, err := globals.Token()
+	if err != nil && !unlockExempt[name] {
+		globals.ErrLog.Add(err)
+		return err
+	}
 	if globals.Verbose() {
 		switch source {
 		case config.SourceFlag:
-			fmt.Fprintf(opts.Stdout, "Fastly API token provided via --token\n")
+			fmt.Fprintf(notices, "Fastly API token provided via --token\n")
 		case config.SourceEnvironment:
-			fmt.Fprintf(opts.Stdout, "Fastly API token provided via %s\n", env.Token)
+			fmt.Fprintf(notices, "Fastly API token provided via %s\n", env.Token)
 		case config.SourceFile:
-			fmt.Fprintf(opts.Stdout, "Fastly API token provided via config file\n")
+			if profileName, p := globals.SelectedProfile(); p != nil && (p.Token != "" || p.EncryptedToken != "") {
+				fmt.Fprintf(notices, "Fastly API token provided via config file (profile: %s)\n", profileName)
+			} else {
+				fmt.Fprintf(notices, "Fastly API token provided via config file\n")
+			}
 		default:
-			fmt.Fprintf(opts.Stdout, "Fastly API token not provided\n")
+			fmt.Fprintf(notices, "Fastly API token not provided\n")
 		}
 	}
 
 	// If we are using the token from config file, check the files permissions
 	// to assert if they are not too open or have been altered outside of the
 	// application and warn if so.
-	if source == config.SourceFile && name != "configure" {
-		if fi, err := os.Stat(config.FilePath); err == nil {
-			if mode := fi.Mode().Perm(); mode > config.FilePermissions {
-				text.Warning(opts.Stdout, "Unprotected configuration file.")
-				fmt.Fprintf(opts.Stdout, "Permissions %04o for '%s' are too open\n", mode, config.FilePath)
-				fmt.Fprintf(opts.Stdout, "It is recommended that your configuration file is NOT accessible by others.\n")
-				fmt.Fprintln(opts.Stdout)
-			}
-		}
+	if source == config.SourceFile && name != "configure" && name != "auth login" {
+		config.CheckFilePermissions(notices, config.FilePath)
 	}
 
 	endpoint, source := globals.Endpoint()
 	if globals.Verbose() {
 		switch source {
 		case config.SourceEnvironment:
-			fmt.Fprintf(opts.Stdout, "Fastly API endpoint (via %s): %s\n", env.Endpoint, endpoint)
+			fmt.Fprintf(notices, "Fastly API endpoint (via %s): %s\n", env.Endpoint, endpoint)
 		case config.SourceFile:
-			fmt.Fprintf(opts.Stdout, "Fastly API endpoint (via config file): %s\n", endpoint)
+			if profileName, p := globals.SelectedProfile(); p != nil && p.APIEndpoint == endpoint {
+				fmt.Fprintf(notices, "Fastly API endpoint (via config file, profile: %s): %s\n", profileName, endpoint)
+			} else {
+				fmt.Fprintf(notices, "Fastly API endpoint (via config file): %s\n", endpoint)
+			}
 		default:
-			fmt.Fprintf(opts.Stdout, "Fastly API endpoint: %s\n", endpoint)
+			fmt.Fprintf(notices, "Fastly API endpoint: %s\n", endpoint)
 		}
 	}
 
@@ -749,8 +1098,16 @@ func Run(opts RunOpts) error {
 		globals.ErrLog.Add(err)
 		return fmt.Errorf("error constructing Fastly API client: %w", err)
 	}
+	var recorder *journal.Recorder
+	switch {
+	case globals.DryRun():
+		globals.Client = api.NewDryRun(globals.Client, opts.Stdout, globals.JSON())
+	case opts.JournalPath != "":
+		recorder = journal.NewRecorder(globals.Client)
+		globals.Client = recorder
+	}
 
-	globals.RTSClient, err = fastly.NewRealtimeStatsClientForEndpoint(token, fastly.DefaultRealtimeStatsEndpoint)
+	globals.RTSClient, err = opts.RTSClient(token)
 	if err != nil {
 		globals.ErrLog.Add(err)
 		return fmt.Errorf("error constructing Fastly realtime stats client: %w", err)
@@ -762,14 +1119,26 @@ func Run(opts RunOpts) error {
 		return errors.RemediationError{Prefix: usage, Inner: fmt.Errorf("command not found")}
 	}
 
-	if opts.Versioners.CLI != nil && name != "update" && !version.IsPreRelease(revision.AppVersion) {
+	// NOTE: the update check is skipped when --json is set so that the
+	// version notice doesn't corrupt the machine readable output.
+	if opts.Versioners.CLI != nil && name != "update" && !globals.JSON() && !version.IsPreRelease(revision.AppVersion) {
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel() // push cancel on the defer stack first...
 		f := update.CheckAsync(ctx, opts.ConfigFile, opts.ConfigPath, revision.AppVersion, opts.Versioners.CLI, opts.Stdin, opts.Stdout)
 		defer f(opts.Stdout) // ...and the printing function second, so we hit the timeout
 	}
 
-	return command.Exec(opts.Stdin, opts.Stdout)
+	err = command.Exec(opts.Stdin, opts.Stdout)
+
+	// Changes are journaled even if the command failed part way through, so
+	// that the changes it did make can be undone.
+	if recorder != nil && len(recorder.Operations()) > 0 {
+		if _, jerr := journal.Append(opts.JournalPath, recorder.Entry(opts.Args)); jerr != nil {
+			globals.ErrLog.Add(jerr)
+		}
+	}
+
+	return err
 }
 
 // APIClientFactory creates a Fastly API client (modeled as an api.Interface)
@@ -786,6 +1155,17 @@ func FastlyAPIClient(token, endpoint string) (api.Interface, error) {
 	return client, err
 }
 
+// RTSClientFactory creates a Fastly realtime stats client from a user-provided
+// API token. Like APIClientFactory, it allows tests to provide a mock.
+type RTSClientFactory func(token string) (api.RealtimeStatsInterface, error)
+
+// FastlyRTSClient is a RTSClientFactory that returns a real Fastly realtime
+// stats client using the provided token.
+func FastlyRTSClient(token string) (api.RealtimeStatsInterface, error) {
+	client, err := fastly.NewRealtimeStatsClientForEndpoint(token, fastly.DefaultRealtimeStatsEndpoint)
+	return client, err
+}
+
 // contextHasHelpFlag asserts whether a given kingpin.ParseContext contains a
 // `help` flag.
 func contextHasHelpFlag(ctx *kingpin.ParseContext) bool {
@@ -793,6 +1173,45 @@ func contextHasHelpFlag(ctx *kingpin.ParseContext) bool {
 	return ok
 }
 
+// checkSessionService reports which service a command targets during an edit
+// session when verbose is set, and returns an error if it's a service other than the session's
+// without a version specified. The session's service is only used when none
+// of the other sources provide one, and the --version flag defaults to the
+// session's draft version, which is meaningless for any other service.
+func checkSessionService(s *session.State, app *kingpin.Application, args []string, verbose bool, out io.Writer) error {
+	if s == nil {
+		return nil
+	}
+	ctx, err := app.ParseContext(args)
+	if err != nil || ctx.SelectedCommand == nil || ctx.SelectedCommand.GetFlag("service-id") == nil {
+		return nil
+	}
+	flags := ctx.Elements.FlagMap()
+
+	sid, via := s.ServiceID, "the edit session"
+	if f, ok := flags["service-id"]; ok {
+		sid, via = *f.Value, "--service-id"
+	} else if fallback, source := cmd.FallbackServiceID(); source == manifest.SourceEnv {
+		sid, via = fallback, env.ServiceID
+	} else if source == manifest.SourceFile {
+		sid, via = fallback, manifest.Filename
+	}
+	if verbose {
+		fmt.Fprintf(out, "Service ID (via %s): %s\n", via, sid)
+	}
+
+	if sid == s.ServiceID || ctx.SelectedCommand.GetFlag("version") == nil {
+		return nil
+	}
+	if _, ok := flags["version"]; ok {
+		return nil
+	}
+	return errors.RemediationError{
+		Inner:       fmt.Errorf("--version is required for service %s, as the edit session is for service %s", sid, s.ServiceID),
+		Remediation: "Specify the version, or end the edit session with `fastly session commit` or `fastly session abort`.",
+	}
+}
+
 // argsIsHelpJSON determines whether the supplied command arguments are exactly
 // `help --format json`.
 func argsIsHelpJSON(args []string) bool {
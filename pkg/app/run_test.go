@@ -75,33 +75,58 @@ USAGE
 A tool to interact with the Fastly API
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             Render list and describe output as JSON
+      --dry-run          Print the API calls that would modify the account
+                         instead of making them
+      --profile=PROFILE  Credential profile to use (or via FASTLY_PROFILE)
 
 COMMANDS
-  help             Show help.
-  acl              Manipulate Fastly ACLs (Access Control Lists)
-  acl-entry        Manipulate Fastly ACL (Access Control List) entries
-  backend          Manipulate Fastly service version backends
-  compute          Manage Compute@Edge packages
-  configure        Configure the Fastly CLI
-  dictionary       Manipulate Fastly edge dictionaries
-  dictionaryitem   Manipulate Fastly edge dictionary items
-  domain           Manipulate Fastly service version domains
-  healthcheck      Manipulate Fastly service version healthchecks
-  ip-list          List Fastly's public IPs
-  logging          Manipulate Fastly service version logging endpoints
-  logs             Compute@Edge Log Tailing
-  pops             List Fastly datacenters
-  purge            Invalidate objects in the Fastly cache
-  service          Manipulate Fastly services
-  service-version  Manipulate Fastly service versions
-  stats            View historical and realtime statistics for a Fastly service
-  update           Update the CLI to the latest version
-  vcl              Manipulate Fastly service version VCL
-  version          Display version information for the Fastly CLI
-  whoami           Get information about the currently authenticated account
+  help              Show help.
+  acl               Manipulate Fastly ACLs (Access Control Lists)
+  acl-entry         Manipulate Fastly ACL (Access Control List) entries
+  auth              Log in to and out of Fastly
+  auth-token        Manipulate Fastly API tokens
+  backend           Manipulate Fastly service version backends
+  cache-setting     Manipulate Fastly service version cache settings
+  compute           Manage Compute@Edge packages
+  condition         Manipulate Fastly service version conditions
+  configure         Configure the Fastly CLI
+  dictionary        Manipulate Fastly edge dictionaries
+  dictionaryitem    Manipulate Fastly edge dictionary items
+  director          Manipulate Fastly service version directors
+  domain            Manipulate Fastly service version domains
+  gzip              Manipulate Fastly service version gzip configurations
+  header            Manipulate Fastly service version header objects
+  healthcheck       Manipulate Fastly service version healthchecks
+  history           List the changes made to your account with the CLI,
+                    or describe one in detail
+  ip-list           List Fastly's public IPs
+  logging           Manipulate Fastly service version logging endpoints
+  logs              Compute@Edge Log Tailing
+  pool              Manipulate Fastly service version load-balancing pools
+  pops              List Fastly datacenters
+  profile           Manage Fastly CLI credential profiles
+  purge             Invalidate objects in the Fastly cache
+  request-setting   Manipulate Fastly service version request settings
+  response-object   Manipulate Fastly service version synthetic response objects
+  service           Manipulate Fastly services
+  service-version   Manipulate Fastly service versions
+  session           Batch changes to a service into a single draft version
+  stats             View historical and realtime statistics for a Fastly service
+  tls               Report on the TLS certificates used across the account
+  tls-custom        Manipulate custom TLS certificates, private keys, domains
+                    and activations
+  tls-subscription  Manipulate Fastly managed TLS subscriptions
+  undo              Undo the changes made by a previous command, by default the
+                    most recent one
+  update            Update the CLI to the latest version
+  user              Manipulate users of the Fastly account
+  vcl               Manipulate Fastly service version VCL
+  version           Display version information for the Fastly CLI
+  whoami            Get information about the currently authenticated account
 `) + "\n\n"
 
 var helpService = strings.TrimSpace(`
@@ -109,12 +134,32 @@ USAGE
   fastly [<flags>] service
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             Render list and describe output as JSON
+      --dry-run          Print the API calls that would modify the account
+                         instead of making them
+      --profile=PROFILE  Credential profile to use (or via FASTLY_PROFILE)
 
 SUBCOMMANDS
 
+  service apply --file=FILE [<flags>]
+    Clone a service version and apply the changes described by a service
+    definition file
+
+    -f, --file=FILE              Path to a service definition file, as written
+                                 by 'fastly service export'
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --activate               Activate the new service version once the
+                                 changes are applied
+        --force                  With --activate, activate even if another
+                                 version was activated while the command was
+                                 running
+
   service create --name=NAME [<flags>]
     Create a Fastly service
 
@@ -135,11 +180,50 @@ SUBCOMMANDS
 
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
+        --deep                   Show every resource attached to a service
+                                 version, defaulting to the active version
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  service export --version=VERSION [<flags>]
+    Export the configuration of a Fastly service version to a TOML or JSON file
+
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+    -o, --output=OUTPUT          Path of the file to write (format is inferred
+                                 from a .toml or .json extension, defaults to
+                                 stdout)
+
+  service lint [<flags>]
+    Check the configuration of a Fastly service version for common problems,
+    failing if any errors are found
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --disable=DISABLE ...    Name of a rule to skip. Can be given multiple
+                                 times
+        --list-rules             List the available rules instead of checking a
+                                 service
 
   service list
     List Fastly services
 
 
+  service plan --file=FILE [<flags>]
+    Show the changes required to bring a service version in line with a service
+    definition file
+
+    -f, --file=FILE              Path to a service definition file, as written
+                                 by 'fastly service export'
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
   service search [<flags>]
     Search for a Fastly service by name
 
@@ -162,9 +246,13 @@ USAGE
 A tool to interact with the Fastly API
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             Render list and describe output as JSON
+      --dry-run          Print the API calls that would modify the account
+                         instead of making them
+      --profile=PROFILE  Credential profile to use (or via FASTLY_PROFILE)
 
 COMMANDS
   help [<command> ...]
@@ -275,6 +363,62 @@ COMMANDS
         --subnet=SUBNET          Number of bits for the subnet mask applied to
                                  the IP address
 
+  auth login [<flags>]
+    Log in with a username and password, creating an API token for the CLI
+
+    --username=USERNAME  Login of the user. Prompted for if not provided and not
+                         in the config file
+    --password=PASSWORD  Password of the user. Prompted for if not provided
+    --otp=OTP            Two-factor authentication code. Prompted for when the
+                         password is
+    --name="fastly-cli"  Name of the token to create
+    --scope=SCOPE ...    Authorization scope of the token (global, purge_select,
+                         purge_all, global:read). Can be given multiple times.
+                         Defaults to global
+    --expires-in=720h    How long the token is valid for, e.g. 720h. Use 0 for a
+                         token that doesn't expire
+
+  auth logout
+    Revoke the API token stored in the config file and remove it
+
+
+  auth-token create [<flags>]
+    Create an API token
+
+    --name=NAME                    Name of the token
+    --scope=SCOPE ...              Authorization scope of the token (global,
+                                   purge_select, purge_all, global:read). Can be
+                                   given multiple times. Defaults to global
+    --service=SERVICE ...          Alphanumeric string identifying a service the
+                                   token is limited to. Can be given multiple
+                                   times
+    --expires=EXPIRES              Time the token expires, in RFC 3339 format,
+                                   e.g. 2021-12-31T23:59:59Z
+    --username=USERNAME            Login of the user creating the token.
+                                   Defaults to the email in the config file
+    --password=PASSWORD            Password of the user creating the token.
+                                   Prompted for if not provided
+    --store                        Store the new token in the config file,
+                                   within the selected profile if there is one
+    --store-profile=STORE-PROFILE  Store the new token in the named credential
+                                   profile, creating the profile if needed
+
+  auth-token delete --id=ID
+    Revoke an API token
+
+    --id=ID  Alphanumeric string identifying the token
+
+  auth-token delete-current
+    Revoke the API token the CLI is currently using, removing it from the config
+    file if it was stored there
+
+
+  auth-token list [<flags>]
+    List the API tokens of the current user, or of a whole customer
+
+    --customer-id=CUSTOMER-ID  Alphanumeric string identifying the customer
+                               whose tokens to list. Requires a superuser token
+
   backend create --version=VERSION --name=NAME --address=ADDRESS [<flags>]
     Create a backend on a Fastly service version
 
@@ -349,6 +493,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              Backend name
+        --force                  Delete the backend even if it is still assigned
+                                 to a director
 
   backend describe --version=VERSION --name=NAME [<flags>]
     Show detailed information about a backend on a Fastly service version
@@ -432,6 +578,77 @@ COMMANDS
                                    https://www.openssl.org/docs/man1.0.2/man1/ciphers
                                    for details)
 
+  cache-setting create --version=VERSION --name=NAME [<flags>]
+    Create a cache setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+        --action=ACTION          If set, will cause vcl_fetch to terminate after
+                                 processing this setting (cache, pass, restart)
+        --ttl=TTL                Maximum time in seconds to consider the object
+                                 fresh in the cache
+        --stale-ttl=STALE-TTL    Maximum time in seconds to continue to use a
+                                 stale version of the object if future requests
+                                 to the backend fail
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the cache
+                                 setting
+
+  cache-setting delete --version=VERSION --name=NAME [<flags>]
+    Delete a cache setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+
+  cache-setting describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a cache setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of cache setting
+
+  cache-setting list --version=VERSION [<flags>]
+    List cache settings on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  cache-setting update --version=VERSION --name=NAME [<flags>]
+    Update a cache setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+        --new-name=NEW-NAME      New cache setting name
+        --action=ACTION          If set, will cause vcl_fetch to terminate after
+                                 processing this setting (cache, pass, restart)
+        --ttl=TTL                Maximum time in seconds to consider the object
+                                 fresh in the cache
+        --stale-ttl=STALE-TTL    Maximum time in seconds to continue to use a
+                                 stale version of the object if future requests
+                                 to the backend fail
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the cache
+                                 setting
+
   compute build [<flags>]
     Build a Compute@Edge package locally
 
@@ -453,6 +670,8 @@ COMMANDS
         --comment=COMMENT        Human-readable comment
         --domain=DOMAIN          The name of the domain associated to the
                                  package
+        --force-activate         Activate even if another version was activated
+                                 while the package was being deployed
     -p, --path=PATH              Path to package
 
   compute init [<flags>]
@@ -488,6 +707,8 @@ COMMANDS
         --comment=COMMENT        Human-readable comment
         --domain=DOMAIN          The name of the domain associated to the
                                  package
+        --force-activate         Activate even if another version was activated
+                                 while the package was being deployed
     -p, --path=PATH              Path to package
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
@@ -522,11 +743,80 @@ COMMANDS
 
     -p, --path=PATH  Path to package
 
+  condition create --name=NAME --version=VERSION --statement=STATEMENT --type=TYPE [<flags>]
+    Create a condition on a Fastly service version
+
+    -n, --name=NAME              Name of the condition
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --statement=STATEMENT    Conditional expression in VCL used to determine
+                                 if the condition is met, e.g. req.url ~
+                                 "^/api/"
+        --type=TYPE              Type of the condition (REQUEST, CACHE,
+                                 RESPONSE)
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+        --comment=COMMENT        A descriptive note
+    -p, --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  condition delete --name=NAME --version=VERSION [<flags>]
+    Delete a condition on a Fastly service version
+
+    -n, --name=NAME              Name of the condition to delete
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  condition describe --name=NAME --version=VERSION [<flags>]
+    Show detailed information about a condition on a Fastly service version
+
+    -n, --name=NAME              Name of the condition
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  condition list --version=VERSION [<flags>]
+    List conditions on a Fastly service version
+
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  condition update --name=NAME --version=VERSION [<flags>]
+    Update a condition on a Fastly service version
+
+    -n, --name=NAME              Name of the condition to update
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+        --comment=COMMENT        A descriptive note
+    -p, --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --statement=STATEMENT    Conditional expression in VCL used to determine
+                                 if the condition is met
+        --type=TYPE              Type of the condition (REQUEST, CACHE,
+                                 RESPONSE)
+
   configure [<flags>]
     Configure the Fastly CLI
 
     -l, --location  Print the location of the CLI configuration file
     -d, --display   Print the CLI configuration file
+        --encrypt   Encrypt the stored API token with a passphrase, which
+                    is prompted for or read from FASTLY_TOKEN_PASSPHRASE.
+                    A plaintext token already in the config file is encrypted in
+                    place
 
   dictionary create --version=VERSION --name=NAME [<flags>]
     Create a Fastly edge dictionary on a Fastly service version
@@ -638,6 +928,108 @@ COMMANDS
         --key=KEY                Dictionary item key
         --value=VALUE            Dictionary item value
 
+  director backend add --version=VERSION --director=DIRECTOR --backend=BACKEND [<flags>]
+    Assign a backend to a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -d, --director=DIRECTOR      Director name
+    -b, --backend=BACKEND        Backend name
+
+  director backend list --version=VERSION --director=DIRECTOR [<flags>]
+    List the backends assigned to a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -d, --director=DIRECTOR      Director name
+
+  director backend remove --version=VERSION --director=DIRECTOR --backend=BACKEND [<flags>]
+    Remove a backend from a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -d, --director=DIRECTOR      Director name
+    -b, --backend=BACKEND        Backend name
+
+  director create --version=VERSION --name=NAME [<flags>]
+    Create a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Director name
+        --type=random            How the director selects a backend (random,
+                                 round-robin, hash, client)
+        --quorum=QUORUM          Percentage of capacity that needs to be up for
+                                 the director to be considered up
+        --retries=RETRIES        How many backends to search if the first one
+                                 fails
+        --shield=SHIELD          Selected POP to serve as a shield for the
+                                 backends
+        --comment=COMMENT        A descriptive note
+
+  director delete --version=VERSION --name=NAME [<flags>]
+    Delete a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Director name
+
+  director describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of director
+
+  director list --version=VERSION [<flags>]
+    List directors on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  director update --version=VERSION --name=NAME [<flags>]
+    Update a director on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Director name
+        --new-name=NEW-NAME      New director name
+        --type=TYPE              How the director selects a backend (random,
+                                 round-robin, hash, client)
+        --quorum=QUORUM          Percentage of capacity that needs to be up for
+                                 the director to be considered up
+        --retries=RETRIES        How many backends to search if the first one
+                                 fails
+        --shield=SHIELD          Selected POP to serve as a shield for the
+                                 backends
+        --comment=COMMENT        A descriptive note
+
   domain create --name=NAME --version=VERSION [<flags>]
     Create a domain on a Fastly service version
 
@@ -691,6 +1083,175 @@ COMMANDS
         --new-name=NEW-NAME      New domain name
         --comment=COMMENT        A descriptive note
 
+  gzip create --version=VERSION --name=NAME [<flags>]
+    Create a gzip configuration on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+        --content-types=CONTENT-TYPES
+                                 Space-separated list of content types to
+                                 compress, e.g. "text/html application/json"
+        --extensions=EXTENSIONS  Space-separated list of file extensions to
+                                 compress, e.g. "css js html"
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the gzip
+                                 configuration
+
+  gzip delete --version=VERSION --name=NAME [<flags>]
+    Delete a gzip configuration on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+
+  gzip describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a gzip configuration on a Fastly service
+    version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of gzip configuration
+
+  gzip list --version=VERSION [<flags>]
+    List gzip configurations on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  gzip update --version=VERSION --name=NAME [<flags>]
+    Update a gzip configuration on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+        --new-name=NEW-NAME      New gzip configuration name
+        --content-types=CONTENT-TYPES
+                                 Space-separated list of content types to
+                                 compress, e.g. "text/html application/json"
+        --extensions=EXTENSIONS  Space-separated list of file extensions to
+                                 compress, e.g. "css js html"
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the gzip
+                                 configuration
+
+  header create --version=VERSION --name=NAME --action=ACTION --type=TYPE --dst=DST [<flags>]
+    Create a header object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+        --action=ACTION          Action to perform on the header (set, append,
+                                 delete, regex, regex_repeat)
+        --type=TYPE              When the header is processed (request, fetch,
+                                 cache, response)
+        --dst=DST                Header to set, e.g. http.X-Cache
+        --src=SRC                Variable to be used as a source for the header
+                                 content
+        --regex=REGEX            Regular expression to use with the regex and
+                                 regex_repeat actions
+        --substitution=SUBSTITUTION
+                                 Value to substitute in place of regular
+                                 expression matches
+        --ignore-if-set          Don't add the header if it is already set (only
+                                 applies to the set action)
+        --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the header
+                                 during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to a cached response
+        --response-condition=RESPONSE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to a response
+
+  header delete --version=VERSION --name=NAME [<flags>]
+    Delete a header object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+
+  header describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a header object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of header
+
+  header list --version=VERSION [<flags>]
+    List header objects on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  header update --version=VERSION --name=NAME [<flags>]
+    Update a header object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+        --new-name=NEW-NAME      New header name
+        --action=ACTION          Action to perform on the header (set, append,
+                                 delete, regex, regex_repeat)
+        --type=TYPE              When the header is processed (request, fetch,
+                                 cache, response)
+        --dst=DST                Header to set, e.g. http.X-Cache
+        --src=SRC                Variable to be used as a source for the header
+                                 content
+        --regex=REGEX            Regular expression to use with the regex and
+                                 regex_repeat actions
+        --substitution=SUBSTITUTION
+                                 Value to substitute in place of regular
+                                 expression matches
+        --ignore-if-set          Don't add the header if it is already set (only
+                                 applies to the set action)
+        --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the header
+                                 during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to a cached response
+        --response-condition=RESPONSE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to a response
+
   healthcheck create --version=VERSION --name=NAME [<flags>]
     Create a healthcheck on a Fastly service version
 
@@ -778,6 +1339,15 @@ COMMANDS
         --initial=INITIAL        When loading a config, the initial number of
                                  probes to be seen as OK
 
+  history [<flags>]
+    List the changes made to your account with the CLI, or describe one in
+    detail
+
+    --id=ID                  ID of a journal entry to describe
+    --limit=20               Maximum number of entries to list, most recent
+                             first
+    --service-id=SERVICE-ID  Only list changes to this service
+
   ip-list
     List Fastly's public IPs
 
@@ -3801,15 +4371,246 @@ COMMANDS
         --search-padding=2s      Time beyond from/to to consider in searches
         --stream=STREAM          Output: stdout, stderr, both (default)
 
-  pops
-    List Fastly datacenters
-
-
-  purge [<flags>]
-    Invalidate objects in the Fastly cache
+  pool create --version=VERSION --name=NAME [<flags>]
+    Create a load-balancing pool on a Fastly service version
 
-        --all                    Purge everything from a service
-        --file=FILE              Purge a service of a newline delimited list of
+    -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
+                                   then fastly.toml)
+        --version=VERSION          'latest', 'active', or the number of a
+                                   specific version
+        --autoclone                If the selected service version is not
+                                   editable, clone it and use the clone.
+    -n, --name=NAME                Pool name
+        --type=TYPE                How the pool selects a server (random, hash,
+                                   client)
+        --comment=COMMENT          A descriptive note
+        --quorum=QUORUM            Percentage of capacity that needs to be up
+                                   for the pool to be considered up
+        --healthcheck=HEALTHCHECK  The name of the healthcheck to use with this
+                                   pool
+        --shield=SHIELD            The shield POP designated to reduce inbound
+                                   load on this pool by serving the cached data
+                                   to the rest of the network
+        --request-condition=REQUEST-CONDITION
+                                   Condition, which if met, will select this
+                                   pool during a request
+        --override-host=OVERRIDE-HOST
+                                   The hostname to override the Host header
+        --max-conn-default=MAX-CONN-DEFAULT
+                                   Maximum number of connections for servers
+                                   that don't set their own
+        --connect-timeout=CONNECT-TIMEOUT
+                                   How long to wait for a timeout in
+                                   milliseconds
+        --first-byte-timeout=FIRST-BYTE-TIMEOUT
+                                   How long to wait for the first bytes in
+                                   milliseconds
+        --use-tls                  Whether or not to use TLS to reach the
+                                   servers
+        --tls-check-cert           Be strict on checking TLS certs
+        --tls-ca-cert=TLS-CA-CERT  CA certificate attached to the servers
+        --tls-client-cert=TLS-CLIENT-CERT
+                                   Client certificate attached to the servers
+        --tls-client-key=TLS-CLIENT-KEY
+                                   Client key attached to the servers
+        --tls-cert-hostname=TLS-CERT-HOSTNAME
+                                   The hostname used to verify a server's
+                                   certificate
+        --tls-sni-hostname=TLS-SNI-HOSTNAME
+                                   The hostname sent in the SNI portion of the
+                                   TLS handshake
+        --min-tls-version=MIN-TLS-VERSION
+                                   Minimum allowed TLS version on connections to
+                                   this pool
+        --max-tls-version=MAX-TLS-VERSION
+                                   Maximum allowed TLS version on connections to
+                                   this pool
+        --tls-ciphers=TLS-CIPHERS  List of OpenSSL ciphers (see
+                                   https://www.openssl.org/docs/man1.0.2/man1/ciphers
+                                   for details)
+
+  pool delete --version=VERSION --name=NAME [<flags>]
+    Delete a load-balancing pool on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Pool name
+
+  pool describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a load-balancing pool on a Fastly service
+    version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of pool
+
+  pool list --version=VERSION [<flags>]
+    List load-balancing pools on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  pool server add --pool=POOL --address=ADDRESS [<flags>]
+    Add a server to a load-balancing pool
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -p, --pool=POOL              Name of the pool
+        --address=ADDRESS        A hostname, IPv4, or IPv6 address for the
+                                 server
+        --port=PORT              Port number of the address
+        --weight=WEIGHT          Weight (1-100) used to load balance this server
+                                 against others
+        --max-conn=MAX-CONN      Maximum number of connections. Uses the pool's
+                                 default if unset
+        --override-host=OVERRIDE-HOST
+                                 The hostname to override the Host header
+        --disabled               Add the server without sending it any traffic
+        --comment=COMMENT        A descriptive note
+
+  pool server list --pool=POOL [<flags>]
+    List the servers in a load-balancing pool
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -p, --pool=POOL              Name of the pool
+
+  pool server remove --pool=POOL --server=SERVER [<flags>]
+    Remove a server from a load-balancing pool
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -p, --pool=POOL              Name of the pool
+        --server=SERVER          ID of the server
+
+  pool server update --pool=POOL --server=SERVER [<flags>]
+    Update a server in a load-balancing pool
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -p, --pool=POOL              Name of the pool
+        --server=SERVER          ID of the server
+        --address=ADDRESS        A hostname, IPv4, or IPv6 address for the
+                                 server
+        --port=PORT              Port number of the address
+        --weight=WEIGHT          Weight (1-100) used to load balance this server
+                                 against others
+        --max-conn=MAX-CONN      Maximum number of connections. Uses the pool's
+                                 default if unset
+        --override-host=OVERRIDE-HOST
+                                 The hostname to override the Host header
+        --disabled               Stop sending the server any traffic
+        --comment=COMMENT        A descriptive note
+
+  pool update --version=VERSION --name=NAME [<flags>]
+    Update a load-balancing pool on a Fastly service version
+
+    -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
+                                   then fastly.toml)
+        --version=VERSION          'latest', 'active', or the number of a
+                                   specific version
+        --autoclone                If the selected service version is not
+                                   editable, clone it and use the clone.
+    -n, --name=NAME                Pool name
+        --new-name=NEW-NAME        New pool name
+        --type=TYPE                How the pool selects a server (random, hash,
+                                   client)
+        --comment=COMMENT          A descriptive note
+        --quorum=QUORUM            Percentage of capacity that needs to be up
+                                   for the pool to be considered up
+        --healthcheck=HEALTHCHECK  The name of the healthcheck to use with this
+                                   pool
+        --shield=SHIELD            The shield POP designated to reduce inbound
+                                   load on this pool by serving the cached data
+                                   to the rest of the network
+        --request-condition=REQUEST-CONDITION
+                                   Condition, which if met, will select this
+                                   pool during a request
+        --override-host=OVERRIDE-HOST
+                                   The hostname to override the Host header
+        --max-conn-default=MAX-CONN-DEFAULT
+                                   Maximum number of connections for servers
+                                   that don't set their own
+        --connect-timeout=CONNECT-TIMEOUT
+                                   How long to wait for a timeout in
+                                   milliseconds
+        --first-byte-timeout=FIRST-BYTE-TIMEOUT
+                                   How long to wait for the first bytes in
+                                   milliseconds
+        --use-tls                  Whether or not to use TLS to reach the
+                                   servers
+        --tls-check-cert           Be strict on checking TLS certs
+        --tls-ca-cert=TLS-CA-CERT  CA certificate attached to the servers
+        --tls-client-cert=TLS-CLIENT-CERT
+                                   Client certificate attached to the servers
+        --tls-client-key=TLS-CLIENT-KEY
+                                   Client key attached to the servers
+        --tls-cert-hostname=TLS-CERT-HOSTNAME
+                                   The hostname used to verify a server's
+                                   certificate
+        --tls-sni-hostname=TLS-SNI-HOSTNAME
+                                   The hostname sent in the SNI portion of the
+                                   TLS handshake
+        --min-tls-version=MIN-TLS-VERSION
+                                   Minimum allowed TLS version on connections to
+                                   this pool
+        --max-tls-version=MAX-TLS-VERSION
+                                   Maximum allowed TLS version on connections to
+                                   this pool
+        --tls-ciphers=TLS-CIPHERS  List of OpenSSL ciphers (see
+                                   https://www.openssl.org/docs/man1.0.2/man1/ciphers
+                                   for details)
+
+  pops
+    List Fastly datacenters
+
+
+  profile create --name=NAME [<flags>]
+    Create a credential profile
+
+    -n, --name=NAME  Profile name
+        --default    Make the profile the default
+
+  profile delete --name=NAME
+    Delete a credential profile
+
+    -n, --name=NAME  Profile name
+
+  profile list
+    List credential profiles
+
+
+  profile update --name=NAME
+    Replace the token of a credential profile
+
+    -n, --name=NAME  Profile name
+
+  profile use --name=NAME
+    Set the default credential profile
+
+    -n, --name=NAME  Profile name
+
+  purge [<flags>]
+    Invalidate objects in the Fastly cache
+
+        --all                    Purge everything from a service
+        --file=FILE              Purge a service of a newline delimited list of
                                  Surrogate Keys
         --key=KEY                Purge a service of objects tagged with a
                                  Surrogate Key
@@ -3819,6 +4620,198 @@ COMMANDS
                                  rather than making them inaccessible
         --url=URL                Purge an individual URL
 
+  request-setting create --version=VERSION --name=NAME [<flags>]
+    Create a request setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+        --action=ACTION          Allows you to terminate request handling and
+                                 immediately perform an action (lookup, pass)
+        --force-miss             Allows you to force a cache miss for the
+                                 request
+        --force-ssl              Forces the request use SSL (redirects a non-SSL
+                                 to SSL)
+        --bypass-busy-wait       Disable collapsed forwarding, so you don't wait
+                                 for other objects to origin
+        --max-stale-age=MAX-STALE-AGE
+                                 How old an object is allowed to be to serve
+                                 stale-if-error or stale-while-revalidate
+        --hash-keys=HASH-KEYS    Comma separated list of varnish request object
+                                 fields that should be in the hash key
+        --xff=XFF                Short for X-Forwarded-For (clear, leave,
+                                 append, append_all, overwrite)
+        --timer-support          Injects the X-Timer info into the request for
+                                 viewing origin fetch durations
+        --geo-headers            Injects Fastly-Geo-Country, Fastly-Geo-City,
+                                 and Fastly-Geo-Region into the request headers
+        --default-host=DEFAULT-HOST
+                                 Sets the host header
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the request
+                                 setting
+
+  request-setting delete --version=VERSION --name=NAME [<flags>]
+    Delete a request setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+
+  request-setting describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a request setting on a Fastly service
+    version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of request setting
+
+  request-setting list --version=VERSION [<flags>]
+    List request settings on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  request-setting update --version=VERSION --name=NAME [<flags>]
+    Update a request setting on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+        --new-name=NEW-NAME      New request setting name
+        --action=ACTION          Allows you to terminate request handling and
+                                 immediately perform an action (lookup, pass)
+        --force-miss             Allows you to force a cache miss for the
+                                 request
+        --force-ssl              Forces the request use SSL (redirects a non-SSL
+                                 to SSL)
+        --bypass-busy-wait       Disable collapsed forwarding, so you don't wait
+                                 for other objects to origin
+        --max-stale-age=MAX-STALE-AGE
+                                 How old an object is allowed to be to serve
+                                 stale-if-error or stale-while-revalidate
+        --hash-keys=HASH-KEYS    Comma separated list of varnish request object
+                                 fields that should be in the hash key
+        --xff=XFF                Short for X-Forwarded-For (clear, leave,
+                                 append, append_all, overwrite)
+        --timer-support          Injects the X-Timer info into the request for
+                                 viewing origin fetch durations
+        --geo-headers            Injects Fastly-Geo-Country, Fastly-Geo-City,
+                                 and Fastly-Geo-Region into the request headers
+        --default-host=DEFAULT-HOST
+                                 Sets the host header
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the request
+                                 setting
+
+  response-object create --version=VERSION --name=NAME [<flags>]
+    Create a response object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+        --status=200             The HTTP status code, defaults to 200
+        --response="OK"          The HTTP status text, defaults to 'OK'
+        --content=CONTENT        Response body passed as file path or content,
+                                 e.g. $(< maintenance.html)
+        --content-type=CONTENT-TYPE
+                                 The MIME type of the content, e.g. text/html
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will serve the
+                                 response object during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will serve the
+                                 response object from vcl_fetch
+
+  response-object delete --version=VERSION --name=NAME [<flags>]
+    Delete a response object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+
+  response-object describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a response object on a Fastly service
+    version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of response object
+
+  response-object list --version=VERSION [<flags>]
+    List response objects on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  response-object update --version=VERSION --name=NAME [<flags>]
+    Update a response object on a Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+        --new-name=NEW-NAME      New response object name
+        --status=STATUS          The HTTP status code
+        --response=RESPONSE      The HTTP status text
+        --content=CONTENT        Response body passed as file path or content,
+                                 e.g. $(< maintenance.html)
+        --content-type=CONTENT-TYPE
+                                 The MIME type of the content, e.g. text/html
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will serve the
+                                 response object during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will serve the
+                                 response object from vcl_fetch
+
+  service apply --file=FILE [<flags>]
+    Clone a service version and apply the changes described by a service
+    definition file
+
+    -f, --file=FILE              Path to a service definition file, as written
+                                 by 'fastly service export'
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --activate               Activate the new service version once the
+                                 changes are applied
+        --force                  With --activate, activate even if another
+                                 version was activated while the command was
+                                 running
+
   service create --name=NAME [<flags>]
     Create a Fastly service
 
@@ -3839,11 +4832,50 @@ COMMANDS
 
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
+        --deep                   Show every resource attached to a service
+                                 version, defaulting to the active version
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  service export --version=VERSION [<flags>]
+    Export the configuration of a Fastly service version to a TOML or JSON file
+
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+    -o, --output=OUTPUT          Path of the file to write (format is inferred
+                                 from a .toml or .json extension, defaults to
+                                 stdout)
+
+  service lint [<flags>]
+    Check the configuration of a Fastly service version for common problems,
+    failing if any errors are found
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --disable=DISABLE ...    Name of a rule to skip. Can be given multiple
+                                 times
+        --list-rules             List the available rules instead of checking a
+                                 service
 
   service list
     List Fastly services
 
 
+  service plan --file=FILE [<flags>]
+    Show the changes required to bring a service version in line with a service
+    definition file
+
+    -f, --file=FILE              Path to a service definition file, as written
+                                 by 'fastly service export'
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
   service search [<flags>]
     Search for a Fastly service by name
 
@@ -3866,6 +4898,26 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --force                  Activate even if another version was activated
+                                 while the command was running
+        --watch=WATCH            Watch the realtime stats for this long after
+                                 activating, e.g. 5m, and reactivate the
+                                 previously active version if the error rate
+                                 exceeds the maximum
+        --max-error-rate="2%"    Maximum percentage of requests resulting in an
+                                 error in any one second while watching
+        --max-5xx-rate=MAX-5XX-RATE
+                                 Maximum percentage of requests resulting in a
+                                 5xx response in any one second while watching
+
+  service-version activate-group --file=FILE [<flags>]
+    Activate versions of several Fastly services together, rolling them all back
+    if any activation fails
+
+    -f, --file=FILE  Path to a TOML file listing the services and versions to
+                     activate, in order
+        --force      Activate even if another version of a service was activated
+                     while the command was running
 
   service-version clone --version=VERSION [<flags>]
     Clone a Fastly service version
@@ -3883,6 +4935,16 @@ COMMANDS
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
 
+  service-version diff [<flags>]
+    Show the differences between two Fastly service versions
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --from="active"          'latest', 'active', or the number of the
+                                 version to compare from
+        --to="latest"            'latest', 'active', or the number of the
+                                 version to compare to
+
   service-version list [<flags>]
     List Fastly service versions
 
@@ -3897,6 +4959,18 @@ COMMANDS
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
 
+  service-version rollback [<flags>]
+    Reactivate a previously active Fastly service version
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --to=TO                  Number of the version to roll back to
+        --steps=STEPS            Number of previously active versions to go back
+                                 (default 1)
+    -y, --auto-yes               Roll back without asking for confirmation
+        --force                  Roll back even if another version was activated
+                                 while the command was running
+
   service-version update --version=VERSION [<flags>]
     Update a Fastly service version
 
@@ -3908,6 +4982,28 @@ COMMANDS
                                  editable, clone it and use the clone.
         --comment=COMMENT        Human-readable comment
 
+  session abort
+    Discard the draft version of the edit session and end the session
+
+
+  session begin [<flags>]
+    Clone the active version of a service into a draft version, which subsequent
+    commands target by default
+
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  session commit [<flags>]
+    Validate the draft version of the edit session and end the session
+
+    --activate  Activate the draft version once it's validated
+    --force     Activate even if another version was activated since the session
+                began
+
+  session status
+    Show the edit session in progress and the changes made to its draft version
+
+
   stats historical [<flags>]
     View historical stats for a Fastly service
 
@@ -3931,10 +5027,209 @@ COMMANDS
     List stats regions
 
 
+  tls report [<flags>]
+    Report when each custom and subscription certificate expires, and the
+    service domains that use it. Exits non-zero if any certificate expires
+    within the threshold
+
+    --expiring-within="30d"    Threshold for flagging a certificate as expiring,
+                               e.g. 30d or 72h
+    --cert-file=CERT-FILE ...  Path to the PEM encoded copy of a certificate
+                               in the account, whose SANs are used in place of
+                               the domains returned by the API. Can be given
+                               multiple times
+
+  tls-custom activation create --cert-id=CERT-ID --domain=DOMAIN [<flags>]
+    Enable TLS for a domain using a custom certificate
+
+    --cert-id=CERT-ID      ID of the TLS certificate to serve
+    --domain=DOMAIN        The TLS domain to enable, which must be listed on the
+                           certificate
+    --config-id=CONFIG-ID  ID of the TLS configuration to use. Defaults to the
+                           account's default configuration
+
+  tls-custom activation delete --id=ID
+    Disable TLS for a domain
+
+    --id=ID  ID of the activation
+
+  tls-custom activation describe --id=ID
+    Show detailed information about a TLS activation
+
+    --id=ID  ID of the activation
+
+  tls-custom activation list [<flags>]
+    List TLS activations
+
+    --filter-cert=FILTER-CERT      Limit the returned activations to those using
+                                   the given TLS certificate ID
+    --filter-config=FILTER-CONFIG  Limit the returned activations to those using
+                                   the given TLS configuration ID
+    --filter-domain=FILTER-DOMAIN  Limit the returned activations to the given
+                                   TLS domain
+    --page=PAGE                    Page number of the results to fetch
+    --per-page=PER-PAGE            Number of results per page
+
+  tls-custom activation update --id=ID --cert-id=CERT-ID
+    Switch the custom certificate used by a TLS activation
+
+    --id=ID            ID of the activation
+    --cert-id=CERT-ID  ID of the TLS certificate to serve
+
+  tls-custom certificate create --cert=CERT [<flags>]
+    Upload a custom TLS certificate
+
+    --cert=CERT  Path to, or content of, the PEM encoded certificate chain
+    --key=KEY    Path to, or content of, the PEM encoded private key, to check
+                 it matches the certificate. The key itself isn't uploaded
+    --name=NAME  A customizable name for the certificate. Defaults to the
+                 certificate's common name
+
+  tls-custom certificate delete --id=ID
+    Delete a custom TLS certificate
+
+    --id=ID  ID of the certificate
+
+  tls-custom certificate describe --id=ID
+    Show detailed information about a custom TLS certificate
+
+    --id=ID  ID of the certificate
+
+  tls-custom certificate list [<flags>]
+    List custom TLS certificates
+
+    --filter-domain=FILTER-DOMAIN  Limit the returned certificates to those that
+                                   include the given TLS domain ID
+    --sort=SORT                    Sort by created_at, not_before or not_after.
+                                   Prefix with - for descending order
+    --page=PAGE                    Page number of the results to fetch
+    --per-page=PER-PAGE            Number of results per page
+
+  tls-custom certificate update --id=ID --cert=CERT [<flags>]
+    Replace a custom TLS certificate, e.g. when renewing it
+
+    --id=ID      ID of the certificate
+    --cert=CERT  Path to, or content of, the new PEM encoded certificate chain
+    --key=KEY    Path to, or content of, the PEM encoded private key, to check
+                 it matches the certificate. The key itself isn't uploaded
+    --name=NAME  A customizable name for the certificate
+
+  tls-custom domain list [<flags>]
+    List TLS domains
+
+    --filter-in-use            Limit the returned domains to those in use (or,
+                               with --no-filter-in-use, not in use) by an
+                               activation
+    --filter-cert=FILTER-CERT  Limit the returned domains to those listed on the
+                               given TLS certificate ID
+    --filter-subscription=FILTER-SUBSCRIPTION
+                               Limit the returned domains to those belonging to
+                               the given TLS subscription ID
+    --page=PAGE                Page number of the results to fetch
+    --per-page=PER-PAGE        Number of results per page
+
+  tls-custom private-key create --key=KEY --name=NAME
+    Upload a TLS private key
+
+    --key=KEY    Path to, or content of, the PEM encoded private key
+    --name=NAME  A customizable name for the private key
+
+  tls-custom private-key delete --id=ID
+    Delete a TLS private key. Only keys not in use by a certificate can be
+    deleted
+
+    --id=ID  ID of the private key
+
+  tls-custom private-key describe --id=ID
+    Show detailed information about a TLS private key
+
+    --id=ID  ID of the private key
+
+  tls-custom private-key list [<flags>]
+    List TLS private keys
+
+    --filter-in-use=FILTER-IN-USE  Limit the returned keys to those without any
+                                   matching TLS certificates (false)
+    --page=PAGE                    Page number of the results to fetch
+    --per-page=PER-PAGE            Number of results per page
+
+  tls-subscription create --domain=DOMAIN [<flags>]
+    Create a TLS subscription, having Fastly obtain a certificate for the given
+    domains
+
+    --domain=DOMAIN ...            Domain to secure. Can be given multiple times
+    --common-name=COMMON-NAME      The domain to use as the certificate's common
+                                   name. Defaults to the first --domain
+    --cert-authority=lets-encrypt  The certificate authority to obtain the
+                                   certificate from (lets-encrypt, globalsign)
+    --config-id=CONFIG-ID          ID of the TLS configuration to use. Defaults
+                                   to the account's default configuration
+
+  tls-subscription delete --id=ID [<flags>]
+    Delete a TLS subscription
+
+        --id=ID  ID of the subscription
+    -f, --force  Delete the subscription even if its certificate is in use by an
+                 activation
+
+  tls-subscription describe --id=ID
+    Show detailed information about a TLS subscription, including any
+    outstanding DNS challenges
+
+    --id=ID  ID of the subscription
+
+  tls-subscription list [<flags>]
+    List TLS subscriptions
+
+    --filter-state=FILTER-STATE    Limit the returned subscriptions by state,
+                                   e.g. pending, processing, issued or renewing
+    --filter-domain=FILTER-DOMAIN  Limit the returned subscriptions to those
+                                   covering the given TLS domain
+    --page=PAGE                    Page number of the results to fetch
+    --per-page=PER-PAGE            Number of results per page
+
+  undo [<flags>]
+    Undo the changes made by a previous command, by default the most recent one
+
+        --id=ID     ID of the journal entry to undo, as listed by fastly history
+    -y, --auto-yes  Undo without asking for confirmation
+
   update
     Update the CLI to the latest version
 
 
+  user create --login=LOGIN --name=NAME [<flags>]
+    Invite a user to the Fastly account
+
+    --login=LOGIN  The user's email address, used to log in
+    --name=NAME    The user's real name
+    --role=ROLE    The permissions role assigned to the user (user, billing,
+                   engineer, superuser)
+
+  user delete --id=ID
+    Remove a user from the Fastly account
+
+    --id=ID  Alphanumeric string identifying the user
+
+  user describe --id=ID
+    Show detailed information about a user
+
+    --id=ID  Alphanumeric string identifying the user
+
+  user list [<flags>]
+    List the users of a Fastly account
+
+    --customer-id=CUSTOMER-ID  Alphanumeric string identifying the customer.
+                               Defaults to the customer of the current user
+
+  user update --id=ID [<flags>]
+    Update a user's name or role
+
+    --id=ID      Alphanumeric string identifying the user
+    --name=NAME  The user's real name
+    --role=ROLE  The permissions role assigned to the user (user, billing,
+                 engineer, superuser)
+
   vcl custom create --content=CONTENT --name=NAME --version=VERSION [<flags>]
     Upload a VCL for a particular service and version
 
@@ -106,7 +106,10 @@ func Usage(args []string, app *kingpin.Application, out, err io.Writer) string {
 // if you add/remove a global flag you will also need to update flag binding in
 // pkg/app/app.go.
 var globalFlags = map[string]bool{
+	"dry-run": true,
 	"help":    true,
+	"json":    true,
+	"profile": true,
 	"token":   true,
 	"verbose": true,
 }
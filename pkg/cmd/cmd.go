@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -59,6 +60,18 @@ func (b Base) Name() string {
 	return b.CmdClause.FullCommand()
 }
 
+// WriteJSON encodes value as indented JSON to the given writer. It's used by
+// commands to honour the global --json flag.
+func (b Base) WriteJSON(out io.Writer, value interface{}) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(value); err != nil {
+		b.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encoding JSON output: %w", err)
+	}
+	return nil
+}
+
 // Optional models an optional type that consumers can use to assert whether the
 // inner value has been set and is therefore valid for use.
 type Optional struct {
@@ -111,6 +124,7 @@ type OptionalInt struct {
 // ServiceDetailsOpts provides data and behaviours required by the
 // ServiceDetails function.
 type ServiceDetailsOpts struct {
+	ActiveVersionGuard *ActiveVersionGuard
 	AllowActiveLocked  bool
 	AutoCloneFlag      OptionalAutoClone
 	Client             api.Interface
@@ -132,6 +146,15 @@ func ServiceDetails(opts ServiceDetailsOpts) (serviceID string, serviceVersion *
 		return serviceID, serviceVersion, err
 	}
 
+	// The active version is recorded as the selected version is cloned, if
+	// --autoclone is set, so that a command which goes on to activate the
+	// clone can detect a concurrent activation.
+	if opts.ActiveVersionGuard != nil {
+		if err := opts.ActiveVersionGuard.RecordBase(opts.Client, serviceID, v); err != nil {
+			return serviceID, v, err
+		}
+	}
+
 	if opts.AutoCloneFlag.WasSet {
 		currentVersion := v
 		v, err = opts.AutoCloneFlag.Parse(currentVersion, serviceID, opts.VerboseMode, opts.Out, opts.Client)
@@ -8,20 +8,43 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
 	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/session"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v3/fastly"
 	"github.com/fastly/kingpin"
+	toml "github.com/pelletier/go-toml"
 )
 
 // RegisterServiceIDFlag defines a --service-id flag that will attempt to
 // acquire the Service ID from multiple sources.
 //
-// See: manifest.Data.ServiceID() for the sources.
+// See: manifest.Data.ServiceID() for the sources. During an edit session the
+// flag defaults to the session's service, but only when none of the other
+// sources provide one.
 func (b Base) RegisterServiceIDFlag(dst *string) {
-	b.CmdClause.Flag("service-id", "Service ID (falls back to FASTLY_SERVICE_ID, then fastly.toml)").Short('s').StringVar(dst)
+	clause := b.CmdClause.Flag("service-id", "Service ID (falls back to FASTLY_SERVICE_ID, then fastly.toml)").Short('s')
+	if s := b.session(); s != nil {
+		if _, source := FallbackServiceID(); source == manifest.SourceUndefined {
+			clause = clause.Default(s.ServiceID)
+		}
+	}
+	clause.StringVar(dst)
+}
+
+// FallbackServiceID returns the service ID that --service-id falls back to
+// when it isn't set, and where it came from.
+func FallbackServiceID() (string, manifest.Source) {
+	var d manifest.Data
+	// The manifest is decoded directly, as File.Read can rewrite the file.
+	if bs, err := os.ReadFile(manifest.Filename); err == nil {
+		_ = toml.Unmarshal(bs, &d.File)
+	}
+	return d.ServiceID()
 }
 
 // ServiceVersionFlagOpts enables easy configuration of the --version flag
@@ -39,17 +62,30 @@ type ServiceVersionFlagOpts struct {
 
 // RegisterServiceVersionFlag defines a --version flag that accepts multiple values
 // such as 'latest', 'active' and numerical values which are then converted
-// into the appropriate service version.
+// into the appropriate service version. During an edit session a required
+// flag instead defaults to the session's draft version.
 func (b Base) RegisterServiceVersionFlag(opts ServiceVersionFlagOpts) {
 	clause := b.CmdClause.Flag("version", "'latest', 'active', or the number of a specific version")
 	if !opts.Optional {
-		clause = clause.Required()
+		if s := b.session(); s != nil {
+			clause = clause.Default(strconv.Itoa(s.Version))
+		} else {
+			clause = clause.Required()
+		}
 	} else {
 		clause = clause.Action(opts.Action)
 	}
 	clause.StringVar(opts.Dst)
 }
 
+// session returns the edit session in progress, if any.
+func (b Base) session() *session.State {
+	if b.Globals == nil {
+		return nil
+	}
+	return b.Globals.Session
+}
+
 // OptionalServiceVersion represents a Fastly service version.
 type OptionalServiceVersion struct {
 	OptionalString
@@ -150,6 +186,37 @@ func GetActiveVersion(vs []*fastly.Version) (*fastly.Version, error) {
 	return nil, fmt.Errorf("no active service version found")
 }
 
+// GetPreviousVersions returns the versions which were active before the
+// currently active version, most recently active first. lastActive holds the
+// times at which versions were known to be active, e.g. from the journal.
+// Otherwise locked versions are presumed to have been active when they were
+// last updated, as activating a version locks it.
+func GetPreviousVersions(vs []*fastly.Version, lastActive map[int]time.Time) []*fastly.Version {
+	var (
+		previous []*fastly.Version
+		times    = make(map[int]time.Time)
+	)
+	for _, v := range vs {
+		t, ok := lastActive[v.Number]
+		if v.Locked && v.UpdatedAt != nil && v.UpdatedAt.After(t) {
+			t, ok = *v.UpdatedAt, true
+		}
+		if v.Active || !ok {
+			continue
+		}
+		times[v.Number] = t
+		previous = append(previous, v)
+	}
+	sort.SliceStable(previous, func(i, j int) bool {
+		a, b := previous[i], previous[j]
+		if times[a.Number].Equal(times[b.Number]) {
+			return a.Number > b.Number
+		}
+		return times[a.Number].After(times[b.Number])
+	})
+	return previous
+}
+
 // GetSpecifiedVersion returns the specified service version.
 func GetSpecifiedVersion(vs []*fastly.Version, version string) (*fastly.Version, error) {
 	i, err := strconv.Atoi(version)
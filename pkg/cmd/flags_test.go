@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/mock"
@@ -170,6 +171,43 @@ func TestGetLatestActiveVersion(t *testing.T) {
 	}
 }
 
+func TestGetPreviousVersions(t *testing.T) {
+	versions := []*fastly.Version{
+		{Number: 1, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-01T01:00:00Z")},
+		{Number: 2, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-02T01:00:00Z")},
+		{Number: 3, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-03T01:00:00Z")},
+		{Number: 4, Active: true, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-04T01:00:00Z")},
+		{Number: 5, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-05T01:00:00Z")},
+	}
+	for _, testcase := range []struct {
+		name       string
+		lastActive map[int]time.Time
+		want       []int
+	}{
+		{
+			name: "locked versions",
+			want: []int{2, 1},
+		},
+		{
+			name: "journaled activations",
+			lastActive: map[int]time.Time{
+				1: *testutil.MustParseTimeRFC3339("2000-01-06T01:00:00Z"),
+				3: *testutil.MustParseTimeRFC3339("2000-01-03T02:00:00Z"),
+				4: *testutil.MustParseTimeRFC3339("2000-01-06T01:00:00Z"),
+			},
+			want: []int{1, 3, 2},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var have []int
+			for _, v := range cmd.GetPreviousVersions(versions, testcase.lastActive) {
+				have = append(have, v.Number)
+			}
+			testutil.AssertEqual(t, testcase.want, have)
+		})
+	}
+}
+
 func TestGetSpecifiedVersion(t *testing.T) {
 	for _, testcase := range []struct {
 		name          string
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ActiveVersionGuard is an optimistic concurrency check, which prevents a
+// command from activating a service version if someone else activated a
+// different version while the command was running. The active version is
+// recorded when the version to activate is cloned, or when the edit session
+// it belongs to began, and checked again immediately before activating.
+type ActiveVersionGuard struct {
+	// Force disables the check.
+	Force bool
+	// ForceFlag is the flag which sets Force, suggested when the check fails.
+	// It defaults to --force.
+	ForceFlag string
+
+	serviceID string
+	active    int
+	recorded  bool
+}
+
+// Record notes the version of the service which is currently active.
+func (g *ActiveVersionGuard) Record(client api.Interface, serviceID string) error {
+	active, err := activeVersion(client, serviceID)
+	if err != nil {
+		return err
+	}
+	g.Set(serviceID, active)
+	return nil
+}
+
+// RecordBase notes the version of the service which is active as a new version
+// is cloned from base, or selected when base is edited in place. That's base
+// itself when it's active, which saves looking the active version up.
+func (g *ActiveVersionGuard) RecordBase(client api.Interface, serviceID string, base *fastly.Version) error {
+	if base.Active {
+		g.Set(serviceID, base.Number)
+		return nil
+	}
+	return g.Record(client, serviceID)
+}
+
+// Set notes the version of the service which was active at the start of the
+// operation, where 0 means no version was active.
+func (g *ActiveVersionGuard) Set(serviceID string, active int) {
+	g.serviceID = serviceID
+	g.active = active
+	g.recorded = true
+}
+
+// Check returns an error if the active version of the service has changed
+// since it was recorded. Nothing is checked if Force is set or if the active
+// version wasn't recorded, e.g. because the service was only just created.
+func (g *ActiveVersionGuard) Check(client api.Interface) error {
+	if g.Force || !g.recorded {
+		return nil
+	}
+
+	active, err := activeVersion(client, g.serviceID)
+	if err != nil {
+		return err
+	}
+	if active == g.active {
+		return nil
+	}
+
+	flag := g.ForceFlag
+	if flag == "" {
+		flag = "--force"
+	}
+	return errors.RemediationError{
+		Inner:       fmt.Errorf("the active version of service %s changed from %s to %s while this command was running", g.serviceID, describeActive(g.active), describeActive(active)),
+		Remediation: fmt.Sprintf("Someone else may be changing the service. Review the changes with `fastly service-version diff --service-id %s`, then run the command again, or use %s to activate regardless.", g.serviceID, flag),
+	}
+}
+
+// activeVersion returns the number of the service's active version, or 0 if
+// no version is active.
+func activeVersion(client api.Interface, serviceID string) (int, error) {
+	vs, err := client.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error listing service versions: %w", err)
+	}
+	if v, err := GetActiveVersion(vs); err == nil {
+		return v.Number, nil
+	}
+	return 0, nil
+}
+
+func describeActive(version int) string {
+	if version == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("version %d", version)
+}
@@ -69,6 +69,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, a)
+	}
+
 	c.print(out, a)
 	return nil
 }
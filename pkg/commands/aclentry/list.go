@@ -56,6 +56,10 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, as)
+	}
+
 	if c.Globals.Verbose() {
 		c.printVerbose(out, serviceID, as)
 	} else {
@@ -0,0 +1,323 @@
+package auth_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
+)
+
+func TestLogin(t *testing.T) {
+	var revoked bool
+	revoke := func() error {
+		revoked = true
+		return nil
+	}
+
+	var (
+		args     = testutil.Args
+		validAPI = mock.API{
+			GetTokenSelfFn: func() (*fastly.Token, error) { return &fastly.Token{UserID: "user-1"}, nil },
+			GetUserFn: func(i *fastly.GetUserInput) (*fastly.User, error) {
+				if i.ID != "user-1" {
+					return nil, errTest
+				}
+				return &fastly.User{Login: "test@example.com"}, nil
+			},
+		}
+	)
+
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		file         config.File
+		api          mock.API
+		client       *tokenClient
+		stdin        string
+		wantError    string
+		wantOutput   []string
+		wantForm     map[string]string
+		wantOTP      string
+		wantUser     *config.User
+		wantProfiles map[string]*config.Profile
+		wantRevoked  bool
+	}{
+		{
+			name:      "dry run",
+			args:      args("auth login --username test@example.com --password secret --dry-run"),
+			client:    &tokenClient{code: http.StatusOK, body: tokenOK},
+			wantError: "--dry-run isn't supported by auth login",
+		},
+		{
+			name:       "all flags",
+			args:       args("auth login --username test@example.com --password secret --otp 123456 --scope global:read --name laptop"),
+			api:        validAPI,
+			client:     &tokenClient{code: http.StatusOK, body: tokenOK},
+			wantOutput: []string{"Logged in as test@example.com", "The token expires at:\n\t2030-01-01T00:00:00Z"},
+			wantForm:   map[string]string{"username": "test@example.com", "password": "secret", "scope": "global:read", "name": "laptop"},
+			wantOTP:    "123456",
+			wantUser:   &config.User{Email: "test@example.com", Token: "s3cr3t"},
+		},
+		{
+			name:       "interactive",
+			args:       args("auth login"),
+			api:        validAPI,
+			client:     &tokenClient{code: http.StatusOK, body: tokenOK},
+			stdin:      "test@example.com\nsecret\n654321\n",
+			wantOutput: []string{"Username: ", "Password: ", "Two-factor authentication code", "Logged in as test@example.com"},
+			wantForm:   map[string]string{"username": "test@example.com", "password": "secret", "name": "fastly-cli"},
+			wantOTP:    "654321",
+			wantUser:   &config.User{Email: "test@example.com", Token: "s3cr3t"},
+		},
+		{
+			name:       "username from selected profile",
+			args:       args("auth login --profile sandbox"),
+			file:       fileWithProfiles(),
+			api:        validAPI,
+			client:     &tokenClient{code: http.StatusOK, body: tokenOK},
+			stdin:      "secret\n\n",
+			wantOutput: []string{"Logged in as test@example.com (profile: sandbox)"},
+			wantForm:   map[string]string{"username": "sandbox@example.com", "password": "secret"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {APIEndpoint: config.DefaultEndpoint, Email: "test@example.com", Token: "s3cr3t"},
+			},
+		},
+		{
+			name:      "two-factor code required",
+			args:      args("auth login --username test@example.com --password secret"),
+			client:    &tokenClient{code: http.StatusBadRequest, body: `{"msg":"2fa.verify"}`},
+			wantError: "two-factor authentication code required",
+		},
+		{
+			name:      "bad credentials",
+			args:      args("auth login --username test@example.com --password wrong"),
+			client:    &tokenClient{code: http.StatusUnauthorized, body: `{"msg":"Invalid username or password"}`},
+			wantError: "error from API: Invalid username or password",
+		},
+		{
+			name:   "token fails validation",
+			args:   args("auth login --username test@example.com --password secret"),
+			client: &tokenClient{code: http.StatusOK, body: tokenOK},
+			api: mock.API{
+				GetTokenSelfFn:    func() (*fastly.Token, error) { return nil, errTest },
+				DeleteTokenSelfFn: revoke,
+			},
+			wantError:   "error validating token: " + errTest.Error(),
+			wantRevoked: true,
+		},
+		{
+			name:   "token user can't be fetched",
+			args:   args("auth login --username test@example.com --password secret"),
+			client: &tokenClient{code: http.StatusOK, body: tokenOK},
+			api: mock.API{
+				GetTokenSelfFn:    func() (*fastly.Token, error) { return &fastly.Token{UserID: "user-1"}, nil },
+				GetUserFn:         func(i *fastly.GetUserInput) (*fastly.User, error) { return nil, errTest },
+				DeleteTokenSelfFn: revoke,
+			},
+			wantError:   "error fetching token user: " + errTest.Error(),
+			wantRevoked: true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+			testutil.AssertNoError(t, os.Chmod(configFilePath, 0644))
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.HTTPClient = testcase.client
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Stdin = iotest.OneByteReader(strings.NewReader(testcase.stdin))
+			revoked = false
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertBool(t, testcase.wantRevoked, revoked)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for k, v := range testcase.wantForm {
+				testutil.AssertString(t, v, testcase.client.form.Get(k))
+			}
+			if testcase.wantOTP != "" {
+				testutil.AssertString(t, testcase.wantOTP, testcase.client.otp)
+			}
+			if testcase.wantUser != nil || testcase.wantProfiles != nil {
+				f := readConfig(t, configFilePath)
+				if testcase.wantUser != nil {
+					testutil.AssertEqual(t, *testcase.wantUser, f.User)
+				}
+				if testcase.wantProfiles != nil {
+					testutil.AssertEqual(t, testcase.wantProfiles, f.Profiles)
+				}
+				fi, err := os.Stat(configFilePath)
+				testutil.AssertNoError(t, err)
+				testutil.AssertString(t, "-rw-------", fi.Mode().Perm().String())
+			}
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		file         config.File
+		api          mock.API
+		wantError    string
+		wantOutput   []string
+		wantUser     *config.User
+		wantProfiles map[string]*config.Profile
+		wantRevoked  bool
+	}{
+		{
+			name:      "token from flag",
+			args:      args("auth logout --token abc"),
+			wantError: "not logged in: no token stored in the config file",
+		},
+		{
+			name: "token from user section",
+			args: args("auth logout"),
+			file: config.File{User: config.User{Email: "test@example.com", Token: "abc"}},
+			api: mock.API{
+				DeleteTokenSelfFn: func() error { return nil },
+			},
+			wantOutput: []string{"Logged out"},
+			wantUser:   &config.User{Email: "test@example.com"},
+		},
+		{
+			name: "expired token in profile",
+			args: args("auth logout --profile sandbox"),
+			file: fileWithProfiles(),
+			api: mock.API{
+				DeleteTokenSelfFn: func() error { return &fastly.HTTPError{StatusCode: http.StatusUnauthorized} },
+			},
+			wantOutput: []string{"The token was already invalid.", "Logged out (profile: sandbox)"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {Email: "sandbox@example.com"},
+			},
+		},
+		{
+			name: "API error",
+			args: args("auth logout"),
+			file: fileWithProfiles(),
+			api: mock.API{
+				DeleteTokenSelfFn: func() error { return errTest },
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantUser != nil {
+				testutil.AssertEqual(t, *testcase.wantUser, readConfig(t, configFilePath).User)
+			}
+			if testcase.wantProfiles != nil {
+				testutil.AssertEqual(t, testcase.wantProfiles, readConfig(t, configFilePath).Profiles)
+			}
+		})
+	}
+}
+
+// TestLogoutDryRun checks that the token is left in the config file, as the
+// dry-run client doesn't revoke it.
+func TestLogoutDryRun(t *testing.T) {
+	configFilePath := testutil.MakeTempFile(t, "")
+	defer os.RemoveAll(configFilePath)
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("auth logout --dry-run"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{})
+	opts.ConfigFile = fileWithProfiles()
+	opts.ConfigPath = configFilePath
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "DRY RUN: DeleteTokenSelf")
+	testutil.AssertStringContains(t, stdout.String(), "Dry run: the token wasn't removed from the config file.")
+
+	p, err := os.ReadFile(configFilePath)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "", string(p))
+}
+
+var errTest = errors.New("fixture error")
+
+var tokenOK = `{"id":"tok-1","access_token":"s3cr3t","expires_at":"2030-01-01T00:00:00Z"}`
+
+// tokenClient records the token request it receives and responds with a
+// fixed status code and body.
+type tokenClient struct {
+	code int
+	body string
+	form url.Values
+	otp  string
+}
+
+var _ api.HTTPClient = (*tokenClient)(nil)
+
+func (c *tokenClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, "/tokens") {
+		return nil, fmt.Errorf("unexpected request %s %s", req.Method, req.URL)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, err
+	}
+	c.form = req.PostForm
+	c.otp = req.Header.Get("Fastly-OTP")
+
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(c.code)
+	io.WriteString(rec, c.body)
+	return rec.Result(), nil
+}
+
+func fileWithProfiles() config.File {
+	return config.File{
+		Profiles: map[string]*config.Profile{
+			"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+			"sandbox": {Email: "sandbox@example.com", Token: "456"},
+		},
+	}
+}
+
+func readConfig(t *testing.T, path string) config.File {
+	t.Helper()
+	p, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	var f config.File
+	testutil.AssertNoError(t, toml.Unmarshal(p, &f))
+	return f
+}
@@ -0,0 +1,3 @@
+// Package auth contains commands to log in to Fastly with a username and
+// password, minting an API token for the CLI, and to log out again.
+package auth
@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/useragent"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// scopes are the authorization scopes a token can be granted.
+var scopes = []string{
+	string(fastly.GlobalScope),
+	string(fastly.PurgeSelectScope),
+	string(fastly.PurgeAllScope),
+	string(fastly.GlobalReadScope),
+}
+
+// LoginCommand exchanges a username and password, plus a two-factor
+// authentication code if required, for a new API token which is then stored
+// in the config file.
+type LoginCommand struct {
+	cmd.Base
+	client         api.HTTPClient
+	clientFactory  APIClientFactory
+	configFilePath string
+
+	username  string
+	password  string
+	otp       string
+	name      string
+	scopes    []string
+	expiresIn time.Duration
+}
+
+// NewLoginCommand returns a usable command registered under the parent.
+func NewLoginCommand(parent cmd.Registerer, client api.HTTPClient, configFilePath string, cf APIClientFactory, globals *config.Data) *LoginCommand {
+	var c LoginCommand
+	c.Globals = globals
+	c.client = client
+	c.clientFactory = cf
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("login", "Log in with a username and password, creating an API token for the CLI")
+	c.CmdClause.Flag("username", "Login of the user. Prompted for if not provided and not in the config file").StringVar(&c.username)
+	c.CmdClause.Flag("password", "Password of the user. Prompted for if not provided").StringVar(&c.password)
+	c.CmdClause.Flag("otp", "Two-factor authentication code. Prompted for when the password is").StringVar(&c.otp)
+	c.CmdClause.Flag("name", "Name of the token to create").Default("fastly-cli").StringVar(&c.name)
+	c.CmdClause.Flag("scope", "Authorization scope of the token (global, purge_select, purge_all, global:read). Can be given multiple times. Defaults to global").HintOptions(scopes...).EnumsVar(&c.scopes, scopes...)
+	c.CmdClause.Flag("expires-in", "How long the token is valid for, e.g. 720h. Use 0 for a token that doesn't expire").Default("720h").DurationVar(&c.expiresIn)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *LoginCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	// The token is created with a direct API request, which the dry-run
+	// client can't intercept.
+	if c.Globals.DryRun() {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("--dry-run isn't supported by auth login"),
+			Remediation: "Run the command without --dry-run.",
+		}
+	}
+
+	// The token replaces any stored in the selected profile, or the [user]
+	// section of the config file, and is encrypted if the stored one is.
+	profileName, _ := c.Globals.Profile()
+	encryptedToken := c.Globals.File.User.EncryptedToken
+	if profileName != "" {
+		encryptedToken = ""
+		if p, ok := c.Globals.File.Profiles[profileName]; ok {
+			encryptedToken = p.EncryptedToken
+		}
+	}
+	passphrase, err := c.Globals.Passphrase(encryptedToken)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if err := c.prompt(in, out); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	endpoint, _ := c.Globals.Endpoint()
+
+	progress := text.NewQuietProgress(out)
+	defer func() {
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			progress.Fail() // progress.Done is handled inline
+		}
+	}()
+
+	progress.Step("Creating token...")
+
+	t, err := c.createToken(endpoint)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Validating token...")
+
+	client, err := c.clientFactory(t.AccessToken, endpoint)
+	if err != nil {
+		return fmt.Errorf("error regenerating Fastly API client: %w", err)
+	}
+
+	// The token is revoked if it can't be validated or stored, rather than
+	// being left behind in the account unused. The original error is returned.
+	defer func() {
+		if err != nil {
+			if rerr := client.DeleteTokenSelf(); rerr != nil {
+				c.Globals.ErrLog.Add(fmt.Errorf("error revoking token: %w", rerr))
+			}
+		}
+	}()
+	self, err := client.GetTokenSelf()
+	if err != nil {
+		return fmt.Errorf("error validating token: %w", err)
+	}
+	user, err := client.GetUser(&fastly.GetUserInput{ID: self.UserID})
+	if err != nil {
+		return fmt.Errorf("error fetching token user: %w", err)
+	}
+
+	progress.Step("Persisting configuration...")
+
+	token, encryptedToken, err := config.SealToken(t.AccessToken, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if profileName != "" {
+		if c.Globals.File.Profiles == nil {
+			c.Globals.File.Profiles = make(map[string]*config.Profile)
+		}
+		p, ok := c.Globals.File.Profiles[profileName]
+		if !ok {
+			p = &config.Profile{}
+			c.Globals.File.Profiles[profileName] = p
+		}
+		p.APIEndpoint = endpoint
+		p.Email = user.Login
+		p.Token = token
+		p.EncryptedToken = encryptedToken
+		if c.Globals.File.DefaultProfile() == "" {
+			c.Globals.File.SetDefaultProfile(profileName)
+		}
+	} else {
+		c.Globals.File.Fastly.APIEndpoint = endpoint
+		c.Globals.File.User.Email = user.Login
+		c.Globals.File.User.Token = token
+		c.Globals.File.User.EncryptedToken = encryptedToken
+	}
+
+	if err := writeConfig(&c.Globals.File, c.configFilePath); err != nil {
+		return err
+	}
+
+	progress.Done()
+	config.CheckFilePermissions(out, c.configFilePath)
+
+	if profileName != "" {
+		text.Success(out, "Logged in as %s (profile: %s)", user.Login, profileName)
+	} else {
+		text.Success(out, "Logged in as %s", user.Login)
+	}
+	if t.ExpiresAt != nil {
+		text.Description(out, "The token expires at", t.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// prompt interactively asks for any credentials not provided via flags. The
+// two-factor authentication code is only asked for alongside the password, so
+// that a fully specified command line never blocks waiting for input.
+func (c *LoginCommand) prompt(in io.Reader, out io.Writer) error {
+	var err error
+
+	if c.username == "" {
+		if _, p := c.Globals.SelectedProfile(); p != nil && p.Email != "" {
+			c.username = p.Email
+		} else {
+			c.username = c.Globals.File.User.Email
+		}
+	}
+	if c.username == "" {
+		c.username, err = text.Input(out, "Username: ", in, validateNotEmpty("username"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.password == "" {
+		c.password, err = text.InputSecure(out, "Password: ", in, validateNotEmpty("password"))
+		if err != nil {
+			return err
+		}
+		text.Break(out)
+		if c.otp == "" {
+			c.otp, err = text.Input(out, "Two-factor authentication code (leave blank if not enabled): ", in)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// tokenResponse models the subset of the tokens endpoint response that's
+// needed, along with the error message returned on failure.
+type tokenResponse struct {
+	ID          string     `json:"id"`
+	AccessToken string     `json:"access_token"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	Msg         string     `json:"msg"`
+	Detail      string     `json:"detail"`
+}
+
+// createToken calls the tokens endpoint directly, as the API client library
+// has no way of passing a two-factor authentication code.
+func (c *LoginCommand) createToken(endpoint string) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("username", c.username)
+	form.Set("password", c.password)
+	form.Set("name", c.name)
+	if len(c.scopes) > 0 {
+		form.Set("scope", strings.Join(c.scopes, " "))
+	}
+	if c.expiresIn > 0 {
+		form.Set("expires_at", time.Now().Add(c.expiresIn).UTC().Format(time.RFC3339))
+	}
+
+	fullurl := fmt.Sprintf("%s/tokens", strings.TrimSuffix(endpoint, "/"))
+	req, err := http.NewRequest("POST", fullurl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error constructing API request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", useragent.Name)
+	if c.otp != "" {
+		req.Header.Set("Fastly-OTP", c.otp)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing API request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var t tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("error decoding API response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return &t, nil
+	case strings.Contains(t.Msg, "2fa") && c.otp == "":
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("error from API: two-factor authentication code required"),
+			Remediation: "Provide the code from your authenticator app via --otp, or omit --password to be prompted for it.",
+		}
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusBadRequest:
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("error from API: %s", responseMessage(resp.Status, t)),
+			Remediation: "Check the username, password and two-factor authentication code, then try again.",
+		}
+	default:
+		return nil, fmt.Errorf("error from API: %s", responseMessage(resp.Status, t))
+	}
+}
+
+// responseMessage picks the most descriptive error from an API response.
+func responseMessage(status string, t tokenResponse) string {
+	switch {
+	case t.Detail != "":
+		return t.Detail
+	case t.Msg != "":
+		return t.Msg
+	default:
+		return status
+	}
+}
+
+// writeConfig saves the config file, making sure only its owner can access it
+// even when the file already existed with looser permissions.
+func writeConfig(f *config.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), config.DirectoryPermissions); err != nil {
+		return fmt.Errorf("error creating config file directory: %w", err)
+	}
+	if err := f.Write(path); err != nil {
+		return fmt.Errorf("error saving config file: %w", err)
+	}
+	if err := os.Chmod(path, config.FilePermissions); err != nil {
+		return fmt.Errorf("error setting config file permissions: %w", err)
+	}
+	return nil
+}
+
+func validateNotEmpty(field string) func(string) error {
+	return func(s string) error {
+		if s == "" {
+			return fmt.Errorf("%s cannot be empty", field)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// LogoutCommand revokes the API token stored in the config file and removes
+// it from disk.
+type LogoutCommand struct {
+	cmd.Base
+	configFilePath string
+}
+
+// NewLogoutCommand returns a usable command registered under the parent.
+func NewLogoutCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *LogoutCommand {
+	var c LogoutCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("logout", "Revoke the API token stored in the config file and remove it")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *LogoutCommand) Exec(in io.Reader, out io.Writer) error {
+	if _, source, _ := c.Globals.Token(); source != config.SourceFile {
+		return fsterr.RemediationError{
+			Inner:       errors.New("not logged in: no token stored in the config file"),
+			Remediation: "A token provided via --token or the environment isn't managed by the CLI. Use `fastly auth-token delete-current` to revoke it.",
+		}
+	}
+
+	// A token that's already been revoked, or has expired, is still scrubbed
+	// from disk so that logging out always succeeds.
+	if err := c.Globals.Client.DeleteTokenSelf(); err != nil {
+		var herr *fastly.HTTPError
+		if !errors.As(err, &herr) || herr.StatusCode != http.StatusUnauthorized {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		text.Warning(out, "The token was already invalid.")
+	}
+
+	// The dry-run client didn't revoke the token, so it's kept on disk.
+	if c.Globals.DryRun() {
+		text.Info(out, "Dry run: the token wasn't removed from the config file.")
+		return nil
+	}
+
+	name, p := c.Globals.SelectedProfile()
+	if p != nil && (p.Token != "" || p.EncryptedToken != "") {
+		p.Token = ""
+		p.EncryptedToken = ""
+	} else {
+		name = ""
+		c.Globals.File.User.Token = ""
+		c.Globals.File.User.EncryptedToken = ""
+	}
+
+	if err := writeConfig(&c.Globals.File, c.configFilePath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if name != "" {
+		text.Success(out, "Logged out (profile: %s)", name)
+		return nil
+	}
+	text.Success(out, "Logged out")
+	return nil
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+)
+
+// APIClientFactory allows the login command to build a Fastly API client for
+// a freshly minted token, in order to validate that token.
+// It's a redeclaration of the app.APIClientFactory to avoid an import loop.
+type APIClientFactory func(token, endpoint string) (api.Interface, error)
+
+// RootCommand is the parent command for all subcommands in this package.
+// It should be installed under the primary root command.
+type RootCommand struct {
+	cmd.Base
+	// no flags
+}
+
+// NewRootCommand returns a new command registered in the parent.
+func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
+	var c RootCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("auth", "Log in to and out of Fastly")
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
+	panic("unreachable")
+}
@@ -0,0 +1,470 @@
+package authtoken_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
+)
+
+func TestAuthTokenCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		file         config.File
+		api          mock.API
+		stdin        string
+		wantError    string
+		wantOutput   []string
+		wantProfiles map[string]*config.Profile
+		wantUser     *config.User
+	}{
+		{
+			name:      "missing username",
+			args:      args("auth-token create --password secret"),
+			wantError: "required flag --username not provided",
+		},
+		{
+			name:      "invalid expiry",
+			args:      args("auth-token create --username test@example.com --password secret --expires tomorrow"),
+			wantError: "error parsing --expires",
+		},
+		{
+			name:      "invalid scope",
+			args:      args("auth-token create --username test@example.com --password secret --scope everything"),
+			wantError: "enum value must be one of global,purge_select,purge_all,global:read, got 'everything'",
+		},
+		{
+			name:      "API error",
+			args:      args("auth-token create --username test@example.com --password secret"),
+			api:       mock.API{CreateTokenFn: createTokenError},
+			wantError: errTest.Error(),
+		},
+		{
+			name:       "all flags",
+			args:       args("auth-token create --name ci --username test@example.com --password secret --scope purge_select --scope purge_all --service 123 --service 456 --expires 2030-01-01T00:00:00Z"),
+			api:        mock.API{CreateTokenFn: createTokenOK},
+			wantOutput: []string{"Created token ci (ID tok-1)", "s3cr3t"},
+		},
+		{
+			name:       "username from profile and password prompt",
+			args:       args("auth-token create --name ci"),
+			file:       fileWithProfiles(),
+			stdin:      "hunter2\n",
+			api:        mock.API{CreateTokenFn: createTokenPrompted},
+			wantOutput: []string{"Password: ", "Created token ci (ID tok-1)"},
+		},
+		{
+			name:       "store in named profile",
+			args:       args("auth-token create --name ci --password secret --store-profile ci"),
+			file:       fileWithProfiles(),
+			api:        mock.API{CreateTokenFn: createTokenPrompted},
+			wantOutput: []string{"Created token ci (ID tok-1) and stored it in profile 'ci'"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {Email: "sandbox@example.com", Token: "456"},
+				"ci":      {APIEndpoint: config.DefaultEndpoint, Email: "prod@example.com", Token: "s3cr3t"},
+			},
+		},
+		{
+			name:       "store without profiles",
+			args:       args("auth-token create --name ci --username test@example.com --password secret --store"),
+			api:        mock.API{CreateTokenFn: createTokenOK},
+			wantOutput: []string{"Created token ci (ID tok-1) and stored it in the config file"},
+			wantUser:   &config.User{Email: "test@example.com", Token: "s3cr3t"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantProfiles != nil || testcase.wantUser != nil {
+				f := readConfig(t, configFilePath)
+				if testcase.wantProfiles != nil {
+					testutil.AssertEqual(t, testcase.wantProfiles, f.Profiles)
+				}
+				if testcase.wantUser != nil {
+					testutil.AssertEqual(t, *testcase.wantUser, f.User)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthTokenCreateEncrypted(t *testing.T) {
+	encrypted, err := config.EncryptToken("123", "secret")
+	testutil.AssertNoError(t, err)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		file        config.File
+		env         config.Environment
+		stdin       string
+		wantError   string
+		wantProfile string
+	}{
+		{
+			name:  "passphrase used to unlock the stored token",
+			args:  args("auth-token create --name ci --username test@example.com --password secret --store"),
+			file:  config.File{User: config.User{Email: "test@example.com", EncryptedToken: encrypted}},
+			stdin: "secret\n",
+		},
+		{
+			name: "passphrase from the environment",
+			args: args("auth-token create --name ci --username test@example.com --password secret --store-profile ci"),
+			file: config.File{Profiles: map[string]*config.Profile{
+				"prod": {Default: true, Token: "123"},
+				"ci":   {EncryptedToken: encrypted},
+			}},
+			env:         config.Environment{TokenPassphrase: "secret"},
+			wantProfile: "ci",
+		},
+		{
+			name: "no passphrase",
+			args: args("auth-token create --name ci --username test@example.com --password secret --store-profile ci"),
+			file: config.File{Profiles: map[string]*config.Profile{
+				"prod": {Default: true, Token: "123"},
+				"ci":   {EncryptedToken: encrypted},
+			}},
+			wantError: "the stored API token is encrypted and no passphrase was provided",
+		},
+		{
+			name: "incorrect passphrase",
+			args: args("auth-token create --name ci --username test@example.com --password secret --store-profile ci"),
+			file: config.File{Profiles: map[string]*config.Profile{
+				"prod": {Default: true, Token: "123"},
+				"ci":   {EncryptedToken: encrypted},
+			}},
+			env:       config.Environment{TokenPassphrase: "wrong"},
+			wantError: "incorrect passphrase",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{CreateTokenFn: createTokenOK})
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Env = testcase.env
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if testcase.wantError != "" {
+				return
+			}
+
+			// The new token must replace the encrypted one, encrypted with the
+			// same passphrase.
+			f := readConfig(t, configFilePath)
+			token, encryptedToken := f.User.Token, f.User.EncryptedToken
+			if testcase.wantProfile != "" {
+				p := f.Profiles[testcase.wantProfile]
+				token, encryptedToken = p.Token, p.EncryptedToken
+			}
+			testutil.AssertString(t, "", token)
+			decrypted, err := config.DecryptToken(encryptedToken, "secret")
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, "s3cr3t", decrypted)
+		})
+	}
+}
+
+// TestAuthTokenDryRun checks that the config file isn't written, as the
+// dry-run client neither creates nor revokes tokens.
+func TestAuthTokenDryRun(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		wantOutput []string
+	}{
+		{
+			name:       "create and store",
+			args:       args("auth-token create --name ci --password secret --store --dry-run"),
+			wantOutput: []string{"DRY RUN: CreateToken", "Password: <redacted>", "Dry run: the token wasn't stored in the config file."},
+		},
+		{
+			name:       "delete current",
+			args:       args("auth-token delete-current --dry-run"),
+			wantOutput: []string{"DRY RUN: DeleteTokenSelf", "Dry run: the token wasn't removed from the config file."},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{})
+			opts.ConfigFile = fileWithProfiles()
+			opts.ConfigPath = configFilePath
+			err := app.Run(opts)
+			testutil.AssertNoError(t, err)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+
+			p, err := os.ReadFile(configFilePath)
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, "", string(p))
+		})
+	}
+}
+
+func TestAuthTokenList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:       args("auth-token list"),
+			API:        mock.API{ListTokensFn: listTokensOK},
+			WantOutput: listTokensShortOutput,
+		},
+		{
+			Args:       args("auth-token list --customer-id abc"),
+			API:        mock.API{ListCustomerTokensFn: listCustomerTokensOK},
+			WantOutput: listTokensShortOutput,
+		},
+		{
+			Args:       args("auth-token list --verbose"),
+			API:        mock.API{ListTokensFn: listTokensOK},
+			WantOutput: listTokensVerboseOutput,
+		},
+		{
+			Args:      args("auth-token list"),
+			API:       mock.API{ListTokensFn: listTokensError},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestAuthTokenDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("auth-token delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("auth-token delete --id tok-1"),
+			API:       mock.API{DeleteTokenFn: deleteTokenError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("auth-token delete --id tok-1"),
+			API:        mock.API{DeleteTokenFn: deleteTokenOK},
+			WantOutput: "Deleted token tok-1",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestAuthTokenDeleteCurrent(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		file         config.File
+		api          mock.API
+		wantError    string
+		wantOutput   []string
+		wantProfiles map[string]*config.Profile
+	}{
+		{
+			name:      "API error",
+			args:      args("auth-token delete-current"),
+			file:      fileWithProfiles(),
+			api:       mock.API{DeleteTokenSelfFn: func() error { return errTest }},
+			wantError: errTest.Error(),
+		},
+		{
+			name:       "token from profile",
+			args:       args("auth-token delete-current --profile sandbox"),
+			file:       fileWithProfiles(),
+			api:        mock.API{DeleteTokenSelfFn: func() error { return nil }},
+			wantOutput: []string{"Removed the token from profile 'sandbox'", "Deleted the current token"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {Email: "sandbox@example.com"},
+			},
+		},
+		{
+			name:       "token from flag",
+			args:       args("auth-token delete-current --token abc"),
+			file:       fileWithProfiles(),
+			api:        mock.API{DeleteTokenSelfFn: func() error { return nil }},
+			wantOutput: []string{"Deleted the current token"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantProfiles != nil {
+				testutil.AssertEqual(t, testcase.wantProfiles, readConfig(t, configFilePath).Profiles)
+			}
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func fileWithProfiles() config.File {
+	return config.File{
+		Profiles: map[string]*config.Profile{
+			"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+			"sandbox": {Email: "sandbox@example.com", Token: "456"},
+		},
+	}
+}
+
+func readConfig(t *testing.T, path string) config.File {
+	t.Helper()
+	p, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	var f config.File
+	testutil.AssertNoError(t, toml.Unmarshal(p, &f))
+	return f
+}
+
+func createTokenOK(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	if i.Username != "test@example.com" || i.Password != "secret" {
+		return nil, errTest
+	}
+	if len(i.Services) > 0 {
+		expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		if i.Scope != "purge_select purge_all" || strings.Join(i.Services, ",") != "123,456" || i.ExpiresAt == nil || !i.ExpiresAt.Equal(expires) {
+			return nil, errTest
+		}
+	}
+	return &fastly.Token{ID: "tok-1", Name: i.Name, AccessToken: "s3cr3t"}, nil
+}
+
+func createTokenPrompted(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	if i.Username != "prod@example.com" || (i.Password != "hunter2" && i.Password != "secret") {
+		return nil, errTest
+	}
+	return &fastly.Token{ID: "tok-1", Name: i.Name, AccessToken: "s3cr3t"}, nil
+}
+
+func createTokenError(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	return nil, errTest
+}
+
+func listTokensOK() ([]*fastly.Token, error) {
+	return []*fastly.Token{
+		{
+			ID:       "tok-1",
+			Name:     "ci",
+			UserID:   "user-1",
+			Scope:    fastly.PurgeAllScope,
+			Services: []string{"123"},
+		},
+		{
+			ID:     "tok-2",
+			Name:   "laptop",
+			UserID: "user-1",
+			Scope:  fastly.GlobalScope,
+		},
+	}, nil
+}
+
+func listCustomerTokensOK(i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+	if i.CustomerID != "abc" {
+		return nil, errTest
+	}
+	return listTokensOK()
+}
+
+func listTokensError() ([]*fastly.Token, error) {
+	return nil, errTest
+}
+
+var listTokensShortOutput = strings.TrimSpace(`
+ID     NAME    USER ID  SCOPE
+tok-1  ci      user-1   purge_all
+tok-2  laptop  user-1   global
+`) + "\n"
+
+var listTokensVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Token 1/2",
+	"	ID: tok-1",
+	"	Name: ci",
+	"	User ID: user-1",
+	"	Scope: purge_all",
+	"	Services: 123",
+	"	IP: ",
+	"Token 2/2",
+	"	ID: tok-2",
+	"	Name: laptop",
+	"	User ID: user-1",
+	"	Scope: global",
+	"	Services: ",
+	"	IP: ",
+}, "\n") + "\n\n"
+
+func deleteTokenOK(i *fastly.DeleteTokenInput) error {
+	return nil
+}
+
+func deleteTokenError(i *fastly.DeleteTokenInput) error {
+	return errTest
+}
@@ -0,0 +1,30 @@
+package authtoken
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fastly/cli/pkg/config"
+)
+
+// email returns the login of the user whose credentials are configured,
+// preferring the selected profile over the top-level [user] section.
+func email(globals *config.Data) string {
+	if _, p := globals.SelectedProfile(); p != nil && p.Email != "" {
+		return p.Email
+	}
+	return globals.File.User.Email
+}
+
+// persist writes the in-memory configuration back to disk, ensuring the config
+// file directory exists first.
+func persist(f *config.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), config.DirectoryPermissions); err != nil {
+		return fmt.Errorf("error creating config file directory: %w", err)
+	}
+	if err := f.Write(path); err != nil {
+		return fmt.Errorf("error saving config file: %w", err)
+	}
+	return nil
+}
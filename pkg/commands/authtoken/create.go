@@ -0,0 +1,207 @@
+package authtoken
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// scopes are the authorization scopes a token can be granted.
+var scopes = []string{
+	string(fastly.GlobalScope),
+	string(fastly.PurgeSelectScope),
+	string(fastly.PurgeAllScope),
+	string(fastly.GlobalReadScope),
+}
+
+// CreateCommand calls the Fastly API to create an API token.
+type CreateCommand struct {
+	cmd.Base
+	configFilePath string
+	input          fastly.CreateTokenInput
+	scopes         []string
+	expires        string
+	store          bool
+	storeProfile   string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("create", "Create an API token").Alias("add")
+	c.CmdClause.Flag("name", "Name of the token").StringVar(&c.input.Name)
+	c.CmdClause.Flag("scope", "Authorization scope of the token (global, purge_select, purge_all, global:read). Can be given multiple times. Defaults to global").HintOptions(scopes...).EnumsVar(&c.scopes, scopes...)
+	c.CmdClause.Flag("service", "Alphanumeric string identifying a service the token is limited to. Can be given multiple times").StringsVar(&c.input.Services)
+	c.CmdClause.Flag("expires", "Time the token expires, in RFC 3339 format, e.g. 2021-12-31T23:59:59Z").StringVar(&c.expires)
+	c.CmdClause.Flag("username", "Login of the user creating the token. Defaults to the email in the config file").StringVar(&c.input.Username)
+	c.CmdClause.Flag("password", "Password of the user creating the token. Prompted for if not provided").StringVar(&c.input.Password)
+	c.CmdClause.Flag("store", "Store the new token in the config file, within the selected profile if there is one").BoolVar(&c.store)
+	c.CmdClause.Flag("store-profile", "Store the new token in the named credential profile, creating the profile if needed").StringVar(&c.storeProfile)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	c.input.Scope = fastly.TokenScope(strings.Join(c.scopes, " "))
+
+	if c.expires != "" {
+		t, err := time.Parse(time.RFC3339, c.expires)
+		if err != nil {
+			return errors.RemediationError{
+				Inner:       fmt.Errorf("error parsing --expires: %w", err),
+				Remediation: "Provide the expiry time in RFC 3339 format, e.g. 2021-12-31T23:59:59Z.",
+			}
+		}
+		c.input.ExpiresAt = &t
+	}
+
+	if c.input.Username == "" {
+		c.input.Username = email(c.Globals)
+	}
+	if c.input.Username == "" {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("error parsing arguments: required flag --username not provided"),
+			Remediation: "Provide the login of the user creating the token via --username, or run `fastly configure` to store it in the config file.",
+		}
+	}
+
+	// Check up front that a stored token which is encrypted can be replaced
+	// by an encrypted one, rather than creating a token that can't be saved.
+	var passphrase string
+	if (c.store || c.storeProfile != "") && !c.Globals.DryRun() {
+		var err error
+		passphrase, err = c.Globals.Passphrase(c.storedEncryptedToken())
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
+	if c.input.Password == "" {
+		password, err := text.InputSecure(out, "Password: ", in, validatePasswordNotEmpty)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		text.Break(out)
+		c.input.Password = password
+	}
+
+	t, err := c.Globals.Client.CreateToken(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Name":     c.input.Name,
+			"Username": c.input.Username,
+			"Scope":    c.input.Scope,
+		})
+		return err
+	}
+
+	if c.store || c.storeProfile != "" {
+		// The dry-run client returns a placeholder without an access token,
+		// which mustn't replace the stored one.
+		if c.Globals.DryRun() {
+			text.Info(out, "Dry run: the token wasn't stored in the config file.")
+			return nil
+		}
+		location, err := c.save(t, passphrase)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		text.Success(out, "Created token %s (ID %s) and stored it in %s", t.Name, t.ID, location)
+		return nil
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, t)
+	}
+
+	text.Success(out, "Created token %s (ID %s)", t.Name, t.ID)
+	text.Break(out)
+	text.Description(out, "Access token, which can't be displayed again", t.AccessToken)
+	return nil
+}
+
+// save writes the token into the chosen profile, or the selected profile, or
+// failing that the top-level [user] section of the config file. It returns a
+// description of where the token was stored. The token is encrypted with
+// passphrase unless it's empty.
+func (c *CreateCommand) save(t *fastly.Token, passphrase string) (string, error) {
+	endpoint := config.DefaultEndpoint
+	if e, source := c.Globals.Endpoint(); source == config.SourceFlag || source == config.SourceEnvironment {
+		endpoint = e
+	}
+
+	token, encryptedToken, err := config.SealToken(t.AccessToken, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	name := c.profileName()
+
+	location := "the config file"
+	if name != "" {
+		if c.Globals.File.Profiles == nil {
+			c.Globals.File.Profiles = make(map[string]*config.Profile)
+		}
+		p, ok := c.Globals.File.Profiles[name]
+		if !ok {
+			p = &config.Profile{}
+			c.Globals.File.Profiles[name] = p
+		}
+		p.APIEndpoint = endpoint
+		p.Email = c.input.Username
+		p.Token = token
+		p.EncryptedToken = encryptedToken
+		if c.Globals.File.DefaultProfile() == "" {
+			c.Globals.File.SetDefaultProfile(name)
+		}
+		location = fmt.Sprintf("profile '%s'", name)
+	} else {
+		c.Globals.File.Fastly.APIEndpoint = endpoint
+		c.Globals.File.User.Email = c.input.Username
+		c.Globals.File.User.Token = token
+		c.Globals.File.User.EncryptedToken = encryptedToken
+	}
+
+	return location, persist(&c.Globals.File, c.configFilePath)
+}
+
+// profileName returns the name of the profile the token is stored in, or an
+// empty string for the [user] section of the config file.
+func (c *CreateCommand) profileName() string {
+	if c.storeProfile != "" {
+		return c.storeProfile
+	}
+	name, _ := c.Globals.Profile()
+	return name
+}
+
+// storedEncryptedToken returns the encrypted token which storing the new
+// token replaces, if any.
+func (c *CreateCommand) storedEncryptedToken() string {
+	if name := c.profileName(); name != "" {
+		if p, ok := c.Globals.File.Profiles[name]; ok {
+			return p.EncryptedToken
+		}
+		return ""
+	}
+	return c.Globals.File.User.EncryptedToken
+}
+
+func validatePasswordNotEmpty(s string) error {
+	if s == "" {
+		return fmt.Errorf("password cannot be empty")
+	}
+	return nil
+}
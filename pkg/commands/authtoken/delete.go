@@ -0,0 +1,38 @@
+package authtoken
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to revoke an API token.
+type DeleteCommand struct {
+	cmd.Base
+	Input fastly.DeleteTokenInput
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Revoke an API token").Alias("remove")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the token").Required().StringVar(&c.Input.TokenID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeleteToken(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Token ID": c.Input.TokenID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted token %s", c.Input.TokenID)
+	return nil
+}
@@ -0,0 +1,60 @@
+package authtoken
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DeleteCurrentCommand calls the Fastly API to revoke the API token used to
+// authenticate the request.
+type DeleteCurrentCommand struct {
+	cmd.Base
+	configFilePath string
+}
+
+// NewDeleteCurrentCommand returns a usable command registered under the parent.
+func NewDeleteCurrentCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *DeleteCurrentCommand {
+	var c DeleteCurrentCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("delete-current", "Revoke the API token the CLI is currently using, removing it from the config file if it was stored there")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCurrentCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeleteTokenSelf(); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	// The dry-run client didn't revoke the token, so it's kept on disk.
+	if c.Globals.DryRun() {
+		text.Info(out, "Dry run: the token wasn't removed from the config file.")
+		return nil
+	}
+
+	// A revoked token left in the config file would only cause confusing
+	// authentication errors later on, so remove it.
+	if _, source, _ := c.Globals.Token(); source == config.SourceFile {
+		if name, p := c.Globals.SelectedProfile(); p != nil && (p.Token != "" || p.EncryptedToken != "") {
+			p.Token = ""
+			p.EncryptedToken = ""
+			text.Info(out, "Removed the token from profile '%s'", name)
+		} else {
+			c.Globals.File.User.Token = ""
+			c.Globals.File.User.EncryptedToken = ""
+			text.Info(out, "Removed the token from the config file")
+		}
+		if err := persist(&c.Globals.File, c.configFilePath); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
+	text.Success(out, "Deleted the current token")
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package authtoken contains commands to inspect, create and revoke Fastly
+// API tokens, optionally storing newly created tokens in the CLI config file.
+package authtoken
@@ -0,0 +1,67 @@
+package authtoken
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list API tokens.
+type ListCommand struct {
+	cmd.Base
+	customerID string
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List the API tokens of the current user, or of a whole customer")
+	c.CmdClause.Flag("customer-id", "Alphanumeric string identifying the customer whose tokens to list. Requires a superuser token").StringVar(&c.customerID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var (
+		tokens []*fastly.Token
+		err    error
+	)
+	if c.customerID != "" {
+		tokens, err = c.Globals.Client.ListCustomerTokens(&fastly.ListCustomerTokensInput{CustomerID: c.customerID})
+	} else {
+		tokens, err = c.Globals.Client.ListTokens()
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Customer ID": c.customerID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, tokens)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "USER ID", "SCOPE")
+		for _, t := range tokens {
+			tw.AddLine(t.ID, t.Name, t.UserID, t.Scope)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, t := range tokens {
+		fmt.Fprintf(out, "Token %d/%d\n", i+1, len(tokens))
+		text.PrintToken(out, "\t", t)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
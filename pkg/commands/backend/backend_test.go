@@ -2,7 +2,9 @@ package backend_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -63,6 +65,19 @@ func TestBackendCreate(t *testing.T) {
 			},
 			WantOutput: "Created backend www.test.com (service 123 version 4)",
 		},
+		// The following test is the same as above but with --dry-run, so we can
+		// validate the clone and creation are printed rather than made.
+		{
+			Args: args("backend create --service-id 123 --version 1 --address 127.0.0.1 --name www.test.com --autoclone --dry-run"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantOutputs: []string{
+				"DRY RUN: CloneVersion\n\tServiceID: 123\n\tServiceVersion: 1\n",
+				"DRY RUN: CreateBackend\n\tServiceID: 123\n\tServiceVersion: 1\n\tName: www.test.com\n\tAddress: 127.0.0.1\n",
+				"Created backend www.test.com (service 123 version 1)",
+			},
+		},
 		// The following test is the same as above but appends both --use-ssl and
 		// --verbose so we may validate the expected output message regarding a
 		// missing port is displayed.
@@ -94,6 +109,9 @@ func TestBackendCreate(t *testing.T) {
 			err := app.Run(opts)
 			testutil.AssertErrorContains(t, err, testcase.WantError)
 			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
 		})
 	}
 }
@@ -162,6 +180,45 @@ func TestBackendList(t *testing.T) {
 	}
 }
 
+func TestBackendListJSON(t *testing.T) {
+	// The verbose listing, which starts with the service ID and version, mustn't
+	// corrupt the JSON output.
+	for _, args := range []string{"backend list --service-id 123 --version 1 --json", "backend list --service-id 123 --version 1 --json --verbose"} {
+		t.Run(args, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args(args), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+			})
+			err := app.Run(opts)
+			testutil.AssertNoError(t, err)
+
+			var have []*fastly.Backend
+			if err := json.Unmarshal(stdout.Bytes(), &have); err != nil {
+				t.Fatalf("unexpected JSON output: %v\n%s", err, stdout.String())
+			}
+			want, _ := listBackendsOK(&fastly.ListBackendsInput{ServiceID: "123", ServiceVersion: 1})
+			testutil.AssertEqual(t, want, have)
+		})
+	}
+}
+
+func TestBackendCreateJSONVerbose(t *testing.T) {
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("backend create --service-id 123 --version 1 --address 127.0.0.1 --name www.test.com --autoclone --json --verbose"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{
+		ListVersionsFn:  testutil.ListVersions,
+		CloneVersionFn:  testutil.CloneVersionResult(4),
+		CreateBackendFn: createBackendOK,
+	})
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	if strings.Contains(stdout.String(), "automatically cloned") {
+		t.Errorf("unexpected verbose output with --json:\n%s", stdout.String())
+	}
+}
+
 func TestBackendDescribe(t *testing.T) {
 	args := testutil.Args
 	scenarios := []testutil.TestScenario{
@@ -198,6 +255,24 @@ func TestBackendDescribe(t *testing.T) {
 	}
 }
 
+func TestBackendDescribeJSON(t *testing.T) {
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("backend describe --service-id 123 --version 1 --name www.test.com --json"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		GetBackendFn:   getBackendOK,
+	})
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+
+	var have *fastly.Backend
+	if err := json.Unmarshal(stdout.Bytes(), &have); err != nil {
+		t.Fatalf("unexpected JSON output: %v\n%s", err, stdout.String())
+	}
+	want, _ := getBackendOK(&fastly.GetBackendInput{ServiceID: "123", ServiceVersion: 1})
+	testutil.AssertEqual(t, want, have)
+}
+
 func TestBackendUpdate(t *testing.T) {
 	args := testutil.Args
 	scenarios := []testutil.TestScenario{
@@ -259,6 +334,7 @@ func TestBackendDelete(t *testing.T) {
 			API: mock.API{
 				ListVersionsFn:  testutil.ListVersions,
 				CloneVersionFn:  testutil.CloneVersionResult(4),
+				ListDirectorsFn: listDirectorsEmpty,
 				DeleteBackendFn: deleteBackendError,
 			},
 			WantError: errTest.Error(),
@@ -268,10 +344,44 @@ func TestBackendDelete(t *testing.T) {
 			API: mock.API{
 				ListVersionsFn:  testutil.ListVersions,
 				CloneVersionFn:  testutil.CloneVersionResult(4),
+				ListDirectorsFn: listDirectorsEmpty,
 				DeleteBackendFn: deleteBackendOK,
 			},
 			WantOutput: "Deleted backend www.test.com (service 123 version 4)",
 		},
+		{
+			Args: args("backend delete --service-id 123 --version 1 --name www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				ListDirectorsFn:      listDirectorsOK,
+				GetDirectorBackendFn: getDirectorBackendOK,
+				DeleteBackendFn:      deleteBackendOK,
+			},
+			WantError: "backend www.test.com is still assigned to director(s): origin",
+		},
+		{
+			Args: args("backend delete --service-id 123 --version 1 --name www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				ListDirectorsFn:      listDirectorsOK,
+				GetDirectorBackendFn: getDirectorBackendError,
+				DeleteBackendFn:      deleteBackendOK,
+			},
+			WantError: "error checking whether director fallback uses backend www.test.com: " + errTest.Error(),
+		},
+		{
+			Args: args("backend delete --service-id 123 --version 1 --name www.test.com --autoclone --force"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				ListDirectorsFn:      listDirectorsOK,
+				GetDirectorBackendFn: getDirectorBackendOK,
+				DeleteBackendFn:      deleteBackendOK,
+			},
+			WantOutput: "Deleted backend www.test.com (service 123 version 4)",
+		},
 	}
 	for _, testcase := range scenarios {
 		t.Run(testcase.Name, func(t *testing.T) {
@@ -449,3 +559,32 @@ func deleteBackendOK(i *fastly.DeleteBackendInput) error {
 func deleteBackendError(i *fastly.DeleteBackendInput) error {
 	return errTest
 }
+
+func listDirectorsEmpty(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return []*fastly.Director{}, nil
+}
+
+func listDirectorsOK(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return []*fastly.Director{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "fallback"},
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin"},
+	}, nil
+}
+
+// getDirectorBackendOK reports the backend as assigned to the "origin"
+// director only.
+func getDirectorBackendOK(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	if i.Director != "origin" {
+		return nil, &fastly.HTTPError{StatusCode: http.StatusNotFound}
+	}
+	return &fastly.DirectorBackend{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Director:       i.Director,
+		Backend:        i.Backend,
+	}, nil
+}
+
+func getDirectorBackendError(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return nil, errTest
+}
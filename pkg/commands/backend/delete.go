@@ -1,7 +1,9 @@
 package backend
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/compute/manifest"
@@ -18,6 +20,7 @@ type DeleteCommand struct {
 	Input          fastly.DeleteBackendInput
 	serviceVersion cmd.OptionalServiceVersion
 	autoClone      cmd.OptionalAutoClone
+	force          bool
 }
 
 // NewDeleteCommand returns a usable command registered under the parent.
@@ -36,6 +39,7 @@ func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteComman
 		Dst:    &c.autoClone.Value,
 	})
 	c.CmdClause.Flag("name", "Backend name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("force", "Delete the backend even if it is still assigned to a director").BoolVar(&c.force)
 	return &c
 }
 
@@ -60,6 +64,24 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	c.Input.ServiceID = serviceID
 	c.Input.ServiceVersion = serviceVersion.Number
 
+	directors, err := c.directors()
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+	if len(directors) > 0 {
+		if !c.force {
+			return errors.RemediationError{
+				Inner:       fmt.Errorf("backend %s is still assigned to director(s): %s", c.Input.Name, strings.Join(directors, ", ")),
+				Remediation: "Remove the backend from each director with `fastly director backend remove`, or pass --force to delete it anyway.",
+			}
+		}
+		text.Warning(out, "Backend %s is still assigned to director(s): %s", c.Input.Name, strings.Join(directors, ", "))
+	}
+
 	if err := c.Globals.Client.DeleteBackend(&c.Input); err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
 			"Service ID":      serviceID,
@@ -71,3 +93,34 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	text.Success(out, "Deleted backend %s (service %s version %d)", c.Input.Name, c.Input.ServiceID, c.Input.ServiceVersion)
 	return nil
 }
+
+// directors returns the names of the directors on the service version that
+// the backend is assigned to. Only a 404 from the lookup means the backend
+// isn't assigned to a director, any other error stops the delete.
+func (c *DeleteCommand) directors() ([]string, error) {
+	directors, err := c.Globals.Client.ListDirectors(&fastly.ListDirectorsInput{
+		ServiceID:      c.Input.ServiceID,
+		ServiceVersion: c.Input.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, d := range directors {
+		_, err := c.Globals.Client.GetDirectorBackend(&fastly.GetDirectorBackendInput{
+			ServiceID:      c.Input.ServiceID,
+			ServiceVersion: c.Input.ServiceVersion,
+			Director:       d.Name,
+			Backend:        c.Input.Name,
+		})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error checking whether director %s uses backend %s: %w", d.Name, c.Input.Name, err)
+		}
+		names = append(names, d.Name)
+	}
+	return names, nil
+}
@@ -65,6 +65,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, backend)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", backend.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", backend.ServiceVersion)
 	text.PrintBackend(out, "", backend)
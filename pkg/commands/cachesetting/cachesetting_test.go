@@ -0,0 +1,330 @@
+package cachesetting_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestCacheSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("cache-setting create --service-id 123 --version 3 --ttl 60"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("cache-setting create --service-id 123 --version 3 --name foo --action deliver"),
+			WantError: "error parsing arguments: enum value must be one of cache,pass,restart, got 'deliver'",
+		},
+		{
+			Args: args("cache-setting create --service-id 123 --version 1 --name foo"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("cache-setting create --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("cache-setting create --service-id 123 --version 1 --name foo --action pass --ttl 60 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingOK,
+			},
+			WantOutput: "Created cache setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("cache-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			WantOutput: listCacheSettingsShortOutput,
+		},
+		{
+			Args: args("cache-setting list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			WantOutput: listCacheSettingsVerboseOutput,
+		},
+		{
+			Args: args("cache-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCacheSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("cache-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("cache-setting describe --service-id 123 --version 1 --name static"),
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("cache-setting describe --service-id 123 --version 1 --name static"),
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingOK,
+			},
+			WantOutput: describeCacheSettingOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCacheSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("cache-setting update --service-id 123 --version 3 --ttl 60"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("cache-setting update --service-id 123 --version 1 --name foo --ttl 60 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("cache-setting update --service-id 123 --version 1 --name foo --ttl 60 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingOK,
+			},
+			WantOutput: "Updated cache setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("cache-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("cache-setting delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("cache-setting delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingOK,
+			},
+			WantOutput: "Deleted cache setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createCacheSettingOK(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if i.Action != fastly.CacheSettingActionPass || i.TTL != 60 {
+		return nil, errTest
+	}
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createCacheSettingError(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func listCacheSettingsOK(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return []*fastly.CacheSetting{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "api",
+			Action:         fastly.CacheSettingActionPass,
+			CacheCondition: "api-request",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "static",
+			Action:         fastly.CacheSettingActionCache,
+			TTL:            3600,
+			StaleTTL:       86400,
+			CacheCondition: "static-asset",
+		},
+	}, nil
+}
+
+func listCacheSettingsError(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+var listCacheSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME    ACTION  TTL   STALE TTL  CACHE CONDITION
+123      1        api     pass    0     0          api-request
+123      1        static  cache   3600  86400      static-asset
+`) + "\n"
+
+var listCacheSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Cache setting 1/2",
+	"		Name: api",
+	"		Action: pass",
+	"		TTL: 0",
+	"		Stale TTL: 0",
+	"		Cache condition: api-request",
+	"	Cache setting 2/2",
+	"		Name: static",
+	"		Action: cache",
+	"		TTL: 3600",
+	"		Stale TTL: 86400",
+	"		Cache condition: static-asset",
+}, "\n") + "\n\n"
+
+func getCacheSettingOK(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         fastly.CacheSettingActionCache,
+		TTL:            3600,
+		StaleTTL:       86400,
+	}, nil
+}
+
+func getCacheSettingError(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+var describeCacheSettingOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: static",
+	"Action: cache",
+	"TTL: 3600",
+	"Stale TTL: 86400",
+	"Cache condition: ",
+}, "\n") + "\n"
+
+func updateCacheSettingOK(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if i.NewName != nil || i.Action != "" || i.StaleTTL != nil {
+		return nil, errTest
+	}
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		TTL:            *i.TTL,
+	}, nil
+}
+
+func updateCacheSettingError(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func deleteCacheSettingOK(i *fastly.DeleteCacheSettingInput) error {
+	return nil
+}
+
+func deleteCacheSettingError(i *fastly.DeleteCacheSettingInput) error {
+	return errTest
+}
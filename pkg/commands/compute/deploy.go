@@ -39,6 +39,7 @@ type DeployCommand struct {
 	Backend        Backend
 	Comment        cmd.OptionalString
 	Domain         string
+	ForceActivate  bool
 	Manifest       manifest.Data
 	Path           string
 	ServiceVersion cmd.OptionalServiceVersion
@@ -72,6 +73,7 @@ func NewDeployCommand(parent cmd.Registerer, client api.HTTPClient, globals *con
 	c.CmdClause.Flag("accept-defaults", "Accept default values for all prompts and perform deploy non-interactively").BoolVar(&c.AcceptDefaults)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.Comment.Set).StringVar(&c.Comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").StringVar(&c.Domain)
+	c.CmdClause.Flag("force-activate", "Activate even if another version was activated while the package was being deployed").BoolVar(&c.ForceActivate)
 	c.CmdClause.Flag("path", "Path to package").Short('p').StringVar(&c.Path)
 	return &c
 }
@@ -79,7 +81,10 @@ func NewDeployCommand(parent cmd.Registerer, client api.HTTPClient, globals *con
 // Exec implements the command interface.
 func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	// Exit early if no token configured.
-	_, s := c.Globals.Token()
+	_, s, err := c.Globals.Token()
+	if err != nil {
+		return err
+	}
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
 	}
@@ -103,18 +108,34 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		serviceVersion *fastly.Version
 	)
 
+	// Deploying can take a while, during which someone else may activate a
+	// different version of an existing service.
+	guard := cmd.ActiveVersionGuard{Force: c.ForceActivate, ForceFlag: "--force-activate"}
+
 	serviceID, sidSrc := c.Manifest.ServiceID()
 	if sidSrc == manifest.SourceUndefined {
 		newService = true
-		serviceID, serviceVersion, err = manageNoServiceIDFlow(c.AcceptDefaults, in, out, verbose, apiClient, pkgName, errLog, &c.Manifest.File)
+		serviceID, serviceVersion, err = manageNoServiceIDFlow(c.AcceptDefaults, c.Globals.DryRun(), in, out, verbose, apiClient, pkgName, errLog, &c.Manifest.File)
 		if err != nil {
 			return err
 		}
+		if c.Globals.DryRun() {
+			// Everything after this point depends on the service existing.
+			text.Info(out, "Dry run: the remaining steps require the new service to exist, and were skipped.")
+			return nil
+		}
 		if serviceID == "" {
 			// The user said NO to creating a service when prompted.
 			return nil
 		}
 	} else {
+		err = guard.Record(apiClient, serviceID)
+		if err != nil {
+			errLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return err
+		}
 		serviceVersion, err = manageExistingServiceFlow(serviceID, c.ServiceVersion, apiClient, verbose, out, errLog)
 		if err != nil {
 			return err
@@ -283,6 +304,11 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
+	err = guard.Check(apiClient)
+	if err != nil {
+		return err
+	}
+
 	progress.Step("Activating version...")
 
 	_, err = apiClient.ActivateVersion(&fastly.ActivateVersionInput{
@@ -358,6 +384,7 @@ func packagePath(path string, name string, source manifest.Source) (string, erro
 // manageNoServiceIDFlow handles creating a new service when no Service ID is found.
 func manageNoServiceIDFlow(
 	acceptDefaults bool,
+	dryRun bool,
 	in io.Reader,
 	out io.Writer,
 	verbose bool,
@@ -406,6 +433,10 @@ func manageNoServiceIDFlow(
 
 	progress.Done()
 
+	if dryRun {
+		return serviceID, serviceVersion, nil
+	}
+
 	err = updateManifestServiceID(manifestFile, manifest.Filename, serviceID)
 	if err != nil {
 		errLog.AddWithContext(err, map[string]interface{}{
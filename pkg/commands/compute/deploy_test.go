@@ -302,6 +302,46 @@ func TestDeploy(t *testing.T) {
 				"Activating version...",
 			},
 		},
+		{
+			name: "active version changed during deploy",
+			args: args("compute deploy --service-id 123 --token 123"),
+			api: mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(2),
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				GetServiceFn:      getServiceOK,
+				ListDomainsFn:     listDomainsOk,
+				ListBackendsFn:    listBackendsOk,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				ActivateVersionFn: activateVersionOk,
+			},
+			wantError: "the active version of service 123 changed from version 1 to version 3 while this command was running",
+			wantOutput: []string{
+				"Uploading package...",
+			},
+			dontWantOutput: []string{
+				"Activating version...",
+			},
+		},
+		{
+			name: "active version changed during deploy with --force-activate",
+			args: args("compute deploy --service-id 123 --token 123 --force-activate"),
+			api: mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(2),
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				GetServiceFn:      getServiceOK,
+				ListDomainsFn:     listDomainsOk,
+				ListBackendsFn:    listBackendsOk,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				ActivateVersionFn: activateVersionOk,
+			},
+			wantOutput: []string{
+				"Uploading package...",
+				"Activating version...",
+				"Deployed package (service 123, version 4)",
+			},
+		},
 		{
 			name: "identical package",
 			args: args("compute deploy --service-id 123 --token 123"),
@@ -1008,6 +1048,22 @@ func activateVersionError(i *fastly.ActivateVersionInput) (*fastly.Version, erro
 	return nil, testutil.Err
 }
 
+// listVersionsActivatedAfter returns a ListVersions function which reports
+// version 3 as active, instead of version 1, after n calls, as if someone else
+// activated it during the deploy.
+func listVersionsActivatedAfter(n int) func(*fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	var calls int
+	return func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+		vs, err := testutil.ListVersions(i)
+		calls++
+		if calls > n {
+			vs[0].Active = false
+			vs[2].Active = true
+		}
+		return vs, err
+	}
+}
+
 func listDomainsError(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
 	return nil, testutil.Err
 }
@@ -27,6 +27,7 @@ type PublishCommand struct {
 	acceptDefaults cmd.OptionalBool
 	comment        cmd.OptionalString
 	domain         cmd.OptionalString
+	forceActivate  cmd.OptionalBool
 	path           cmd.OptionalString
 	serviceVersion cmd.OptionalServiceVersion
 }
@@ -52,6 +53,7 @@ func NewPublishCommand(parent cmd.Registerer, globals *config.Data, build *Build
 	c.CmdClause.Flag("accept-defaults", "Accept default values for all prompts and perform deploy non-interactively").Action(c.acceptDefaults.Set).BoolVar(&c.acceptDefaults.Value)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.comment.Set).StringVar(&c.comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").Action(c.domain.Set).StringVar(&c.domain.Value)
+	c.CmdClause.Flag("force-activate", "Activate even if another version was activated while the package was being deployed").Action(c.forceActivate.Set).BoolVar(&c.forceActivate.Value)
 	c.CmdClause.Flag("path", "Path to package").Short('p').Action(c.path.Set).StringVar(&c.path.Value)
 	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
 	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
@@ -112,6 +114,9 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.comment.WasSet {
 		c.deploy.Comment = c.comment
 	}
+	if c.forceActivate.WasSet {
+		c.deploy.ForceActivate = c.forceActivate.Value
+	}
 	c.deploy.Manifest = c.manifest
 
 	err = c.deploy.Exec(in, out)
@@ -44,7 +44,10 @@ func NewUpdateCommand(parent cmd.Registerer, client api.HTTPClient, globals *con
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	// Exit early if no token configured.
-	_, s := c.Globals.Token()
+	_, s, err := c.Globals.Token()
+	if err != nil {
+		return err
+	}
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
 	}
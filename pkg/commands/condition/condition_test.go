@@ -0,0 +1,419 @@
+package condition_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestConditionCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition create --statement foo --type REQUEST --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate missing --statement flag",
+			Args:      args("condition create --name always --type REQUEST --version 3"),
+			WantError: "error parsing arguments: required flag --statement not provided",
+		},
+		{
+			Name:      "validate invalid --type flag",
+			Args:      args("condition create --name always --statement foo --type BEEP --version 3"),
+			WantError: "error parsing arguments: enum value must be one of REQUEST,CACHE,RESPONSE, got 'BEEP'",
+		},
+		{
+			Name:      "validate missing --service-id flag",
+			Args:      args("condition create --name always --statement foo --type REQUEST --version 3"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Name: "validate missing --autoclone flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Args:      args("condition create --name always --statement foo --type REQUEST --service-id 123 --version 1"),
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Name: "validate CreateCondition API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateConditionFn: func(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("condition create --name always --statement foo --type REQUEST --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateCondition API success",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CreateConditionFn: createCondition,
+			},
+			Args:       args("condition create --name always --statement true --type REQUEST --priority 5 --service-id 123 --version 3"),
+			WantOutput: "Created condition 'always' (service: 123, version: 3, type: REQUEST)",
+		},
+		{
+			Name: "validate --autoclone results in cloned service version",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateConditionFn: createCondition,
+			},
+			Args:       args("condition create --autoclone --name always --statement true --type CACHE --service-id 123 --version 1"),
+			WantOutput: "Created condition 'always' (service: 123, version: 4, type: CACHE)",
+		},
+		{
+			Name: "validate --comment is set by an update",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CreateConditionFn: createCondition,
+				UpdateConditionFn: func(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+					if i.Name != "always" || i.Comment == nil || *i.Comment != "api" || i.Statement != nil || i.Type != nil {
+						return nil, testutil.Err
+					}
+					return &fastly.Condition{
+						Comment:        *i.Comment,
+						Name:           i.Name,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Type:           "REQUEST",
+					}, nil
+				},
+			},
+			Args:       args("condition create --name always --statement true --type REQUEST --comment api --service-id 123 --version 3"),
+			WantOutput: "Created condition 'always' (service: 123, version: 3, type: REQUEST)",
+		},
+		{
+			Name: "validate UpdateCondition API error when setting --comment",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CreateConditionFn: createCondition,
+				UpdateConditionFn: func(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("condition create --name always --statement true --type REQUEST --comment note --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition delete --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate missing --version flag",
+			Args:      args("condition delete --name always"),
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Name: "validate missing --autoclone flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Args:      args("condition delete --name always --service-id 123 --version 1"),
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Name: "validate DeleteCondition API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteConditionFn: func(i *fastly.DeleteConditionInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("condition delete --name always --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteCondition API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteConditionFn: func(i *fastly.DeleteConditionInput) error {
+					return nil
+				},
+			},
+			Args:       args("condition delete --name always --service-id 123 --version 3"),
+			WantOutput: "Deleted condition 'always' (service: 123, version: 3)",
+		},
+		{
+			Name: "validate --autoclone results in cloned service version",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteConditionFn: func(i *fastly.DeleteConditionInput) error {
+					return nil
+				},
+			},
+			Args:       args("condition delete --autoclone --name always --service-id 123 --version 1"),
+			WantOutput: "Deleted condition 'always' (service: 123, version: 4)",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition describe --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetCondition API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: func(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("condition describe --name always --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetCondition API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getCondition,
+			},
+			Args:       args("condition describe --name always --service-id 123 --version 3"),
+			WantOutput: "\nService ID: 123\nService Version: 3\n\nName: always\nStatement: req.url ~ \"^/api/\"\nType: REQUEST\nPriority: 10\nComment: api traffic\n",
+		},
+		{
+			Name: "validate missing --autoclone flag is OK",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getCondition,
+			},
+			Args:       args("condition describe --name always --service-id 123 --version 1"),
+			WantOutput: "\nService ID: 123\nService Version: 1\n\nName: always\n",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --version flag",
+			Args:      args("condition list"),
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Name: "validate ListConditions API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListConditionsFn: func(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("condition list --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListConditions API success",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditions,
+			},
+			Args:       args("condition list --service-id 123 --version 3"),
+			WantOutput: "SERVICE ID  VERSION  NAME    TYPE      PRIORITY  STATEMENT\n123         3        api     REQUEST   10        req.url ~ \"^/api/\"\n123         3        static  RESPONSE  20        resp.status == 200\n",
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditions,
+			},
+			Args:       args("condition list --service-id 123 --verbose --version 1"),
+			WantOutput: "\nService ID: 123\nService Version: 1\n\n\tCondition 1/2\n\t\tName: api\n\t\tStatement: req.url ~ \"^/api/\"\n\t\tType: REQUEST\n\t\tPriority: 10\n\n\tCondition 2/2\n\t\tName: static\n\t\tStatement: resp.status == 200\n\t\tType: RESPONSE\n\t\tPriority: 20\n\n",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition update --priority 1 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate missing optional flags",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Args:      args("condition update --name always --service-id 123 --version 3"),
+			WantError: "error parsing arguments: must provide at least one of --comment, --priority, --statement or --type",
+		},
+		{
+			Name: "validate missing --autoclone flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Args:      args("condition update --name always --priority 1 --service-id 123 --version 1"),
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Name: "validate UpdateCondition API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				UpdateConditionFn: func(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("condition update --name always --priority 1 --service-id 123 --version 3"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateCondition API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				UpdateConditionFn: func(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+					if i.Statement != nil || i.Type != nil || i.Comment != nil {
+						return nil, testutil.Err
+					}
+					return &fastly.Condition{
+						Name:           i.Name,
+						Priority:       *i.Priority,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+					}, nil
+				},
+			},
+			Args:       args("condition update --name always --priority 1 --service-id 123 --version 3"),
+			WantOutput: "Updated condition 'always' (service: 123, version: 3)",
+		},
+		{
+			Name: "validate --autoclone results in cloned service version",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateConditionFn: func(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+					return &fastly.Condition{
+						Name:           i.Name,
+						Type:           *i.Type,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+					}, nil
+				},
+			},
+			Args:       args("condition update --autoclone --name always --type CACHE --service-id 123 --version 1"),
+			WantOutput: "Updated condition 'always' (service: 123, version: 4)",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		Name:           i.Name,
+		Priority:       i.Priority,
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Statement:      i.Statement,
+		Type:           i.Type,
+	}, nil
+}
+
+func getCondition(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		Comment:        "api traffic",
+		Name:           i.Name,
+		Priority:       10,
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Statement:      `req.url ~ "^/api/"`,
+		Type:           "REQUEST",
+	}, nil
+}
+
+func listConditions(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return []*fastly.Condition{
+		{
+			Name:           "api",
+			Priority:       10,
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Statement:      `req.url ~ "^/api/"`,
+			Type:           "REQUEST",
+		},
+		{
+			Name:           "static",
+			Priority:       20,
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Statement:      "resp.status == 200",
+			Type:           "RESPONSE",
+		},
+	}, nil
+}
@@ -0,0 +1,126 @@
+package condition
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// conditionTypes is the set of condition types supported by the Fastly API.
+var conditionTypes = []string{"REQUEST", "CACHE", "RESPONSE"}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.CmdClause = parent.Command("create", "Create a condition on a Fastly service version").Alias("add")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("name", "Name of the condition").Short('n').Required().StringVar(&c.name)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("statement", "Conditional expression in VCL used to determine if the condition is met, e.g. req.url ~ \"^/api/\"").Required().StringVar(&c.statement)
+	c.CmdClause.Flag("type", "Type of the condition (REQUEST, CACHE, RESPONSE)").Required().EnumVar(&c.conditionType, conditionTypes...)
+
+	// Optional flags
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").Short('p').Action(c.priority.Set).IntVar(&c.priority.Value)
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+
+	return &c
+}
+
+// CreateCommand calls the Fastly API to create an appropriate resource.
+type CreateCommand struct {
+	cmd.Base
+
+	autoClone      cmd.OptionalAutoClone
+	comment        cmd.OptionalString
+	conditionType  string
+	manifest       manifest.Data
+	name           string
+	priority       cmd.OptionalInt
+	serviceVersion cmd.OptionalServiceVersion
+	statement      string
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := c.constructInput(serviceID, serviceVersion.Number)
+
+	cond, err := c.Globals.Client.CreateCondition(input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	// The API client can't set a comment when creating a condition, so it's
+	// set by a subsequent update.
+	if c.comment.WasSet {
+		cond, err = c.Globals.Client.UpdateCondition(&fastly.UpdateConditionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Name:           cond.Name,
+			Comment:        fastly.String(c.comment.Value),
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+				"Name":            input.Name,
+			})
+			return err
+		}
+	}
+
+	text.Success(out, "Created condition '%s' (service: %s, version: %d, type: %s)", cond.Name, cond.ServiceID, cond.ServiceVersion, cond.Type)
+	return nil
+}
+
+// constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *CreateCommand) constructInput(serviceID string, serviceVersion int) *fastly.CreateConditionInput {
+	var input fastly.CreateConditionInput
+
+	input.Name = c.name
+	input.ServiceID = serviceID
+	input.ServiceVersion = serviceVersion
+	input.Statement = c.statement
+	input.Type = c.conditionType
+
+	if c.priority.WasSet {
+		input.Priority = c.priority.Value
+	}
+
+	return &input
+}
@@ -0,0 +1,108 @@
+package condition
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.CmdClause = parent.Command("describe", "Show detailed information about a condition on a Fastly service version").Alias("get")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("name", "Name of the condition").Short('n').Required().StringVar(&c.name)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+
+	return &c
+}
+
+// DescribeCommand calls the Fastly API to describe an appropriate resource.
+type DescribeCommand struct {
+	cmd.Base
+
+	manifest       manifest.Data
+	name           string
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := c.constructInput(serviceID, serviceVersion.Number)
+
+	cond, err := c.Globals.Client.GetCondition(input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, cond)
+	}
+
+	c.print(out, cond)
+	return nil
+}
+
+// constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *DescribeCommand) constructInput(serviceID string, serviceVersion int) *fastly.GetConditionInput {
+	var input fastly.GetConditionInput
+
+	input.Name = c.name
+	input.ServiceID = serviceID
+	input.ServiceVersion = serviceVersion
+
+	return &input
+}
+
+// print displays the information returned from the API.
+func (c *DescribeCommand) print(out io.Writer, cond *fastly.Condition) {
+	fmt.Fprintf(out, "\nService ID: %s\n", cond.ServiceID)
+	fmt.Fprintf(out, "Service Version: %d\n\n", cond.ServiceVersion)
+	printCondition(out, "", cond)
+}
+
+// printCondition displays a single condition, with each line prefixed by the
+// given indentation.
+func printCondition(out io.Writer, prefix string, cond *fastly.Condition) {
+	fmt.Fprintf(out, "%sName: %s\n", prefix, cond.Name)
+	fmt.Fprintf(out, "%sStatement: %s\n", prefix, cond.Statement)
+	fmt.Fprintf(out, "%sType: %s\n", prefix, cond.Type)
+	fmt.Fprintf(out, "%sPriority: %d\n", prefix, cond.Priority)
+	if cond.Comment != "" {
+		fmt.Fprintf(out, "%sComment: %s\n", prefix, cond.Comment)
+	}
+}
@@ -0,0 +1,115 @@
+package condition
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.CmdClause = parent.Command("list", "List conditions on a Fastly service version")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+
+	return &c
+}
+
+// ListCommand calls the Fastly API to list appropriate resources.
+type ListCommand struct {
+	cmd.Base
+
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := c.constructInput(serviceID, serviceVersion.Number)
+
+	cs, err := c.Globals.Client.ListConditions(input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, cs)
+	}
+
+	if c.Globals.Verbose() {
+		c.printVerbose(out, serviceID, serviceVersion.Number, cs)
+	} else {
+		c.printSummary(out, cs)
+	}
+	return nil
+}
+
+// constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *ListCommand) constructInput(serviceID string, serviceVersion int) *fastly.ListConditionsInput {
+	var input fastly.ListConditionsInput
+
+	input.ServiceID = serviceID
+	input.ServiceVersion = serviceVersion
+
+	return &input
+}
+
+// printVerbose displays the information returned from the API in a verbose
+// format.
+func (c *ListCommand) printVerbose(out io.Writer, serviceID string, serviceVersion int, cs []*fastly.Condition) {
+	fmt.Fprintf(out, "\nService ID: %s\n", serviceID)
+	fmt.Fprintf(out, "Service Version: %d\n", serviceVersion)
+
+	for i, cond := range cs {
+		fmt.Fprintf(out, "\n\tCondition %d/%d\n", i+1, len(cs))
+		printCondition(out, "\t\t", cond)
+	}
+	fmt.Fprintln(out)
+}
+
+// printSummary displays the information returned from the API in a summarised
+// format.
+func (c *ListCommand) printSummary(out io.Writer, cs []*fastly.Condition) {
+	t := text.NewTable(out)
+	t.AddHeader("SERVICE ID", "VERSION", "NAME", "TYPE", "PRIORITY", "STATEMENT")
+	for _, cond := range cs {
+		t.AddLine(cond.ServiceID, cond.ServiceVersion, cond.Name, cond.Type, cond.Priority, cond.Statement)
+	}
+	t.Print()
+}
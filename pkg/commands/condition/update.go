@@ -0,0 +1,122 @@
+package condition
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.CmdClause = parent.Command("update", "Update a condition on a Fastly service version")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("name", "Name of the condition to update").Short('n').Required().StringVar(&c.name)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").Short('p').Action(c.priority.Set).IntVar(&c.priority.Value)
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("statement", "Conditional expression in VCL used to determine if the condition is met").Action(c.statement.Set).StringVar(&c.statement.Value)
+	c.CmdClause.Flag("type", "Type of the condition (REQUEST, CACHE, RESPONSE)").Action(c.conditionType.Set).EnumVar(&c.conditionType.Value, conditionTypes...)
+
+	return &c
+}
+
+// UpdateCommand calls the Fastly API to update an appropriate resource.
+type UpdateCommand struct {
+	cmd.Base
+
+	autoClone      cmd.OptionalAutoClone
+	comment        cmd.OptionalString
+	conditionType  cmd.OptionalString
+	manifest       manifest.Data
+	name           string
+	priority       cmd.OptionalInt
+	serviceVersion cmd.OptionalServiceVersion
+	statement      cmd.OptionalString
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input, err := c.constructInput(serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	cond, err := c.Globals.Client.UpdateCondition(input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated condition '%s' (service: %s, version: %d)", cond.Name, cond.ServiceID, cond.ServiceVersion)
+	return nil
+}
+
+// constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *UpdateCommand) constructInput(serviceID string, serviceVersion int) (*fastly.UpdateConditionInput, error) {
+	var input fastly.UpdateConditionInput
+
+	input.Name = c.name
+	input.ServiceID = serviceID
+	input.ServiceVersion = serviceVersion
+
+	if !c.comment.WasSet && !c.priority.WasSet && !c.statement.WasSet && !c.conditionType.WasSet {
+		return nil, fmt.Errorf("error parsing arguments: must provide at least one of --comment, --priority, --statement or --type")
+	}
+	if c.comment.WasSet {
+		input.Comment = fastly.String(c.comment.Value)
+	}
+	if c.priority.WasSet {
+		input.Priority = fastly.Int(c.priority.Value)
+	}
+	if c.statement.WasSet {
+		input.Statement = fastly.String(c.statement.Value)
+	}
+	if c.conditionType.WasSet {
+		input.Type = fastly.String(c.conditionType.Value)
+	}
+
+	return &input, nil
+}
@@ -6,12 +6,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
 )
 
 func TestConfigure(t *testing.T) {
@@ -319,6 +321,58 @@ func TestConfigure(t *testing.T) {
 [user]
   email = "test@example.com"
   token = "new_token"
+`,
+		},
+		{
+			name: "token written into selected profile",
+			args: args("configure --profile staging --token=abcdef"),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{
+				"Fastly API token provided via --token",
+				"Validating token...",
+				"Persisting configuration...",
+				"Configured the Fastly CLI (profile: staging)",
+			},
+			wantFile: `config_version = 0
+
+[cli]
+  last_checked = ""
+  remote_config = ""
+  ttl = ""
+  version = ""
+
+[fastly]
+  api_endpoint = ""
+
+[language]
+
+  [language.rust]
+    fastly_sys_constraint = ""
+    rustup_constraint = ""
+    toolchain_constraint = ""
+    toolchain_version = ""
+    wasm_wasi_target = ""
+
+[legacy]
+  email = ""
+  token = ""
+
+[profile]
+
+  [profile.staging]
+    api_endpoint = "https://api.fastly.com"
+    default = true
+    email = "test@example.com"
+    token = "abcdef"
+
+[starter-kits]
+
+[user]
+  email = ""
+  token = ""
 `,
 		},
 		{
@@ -360,3 +414,111 @@ func TestConfigure(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureEncrypt(t *testing.T) {
+	api := mock.API{
+		GetTokenSelfFn: func() (*fastly.Token, error) { return &fastly.Token{}, nil },
+		GetUserFn: func(*fastly.GetUserInput) (*fastly.User, error) {
+			return &fastly.User{Login: "test@example.com"}, nil
+		},
+	}
+	args := testutil.Args
+
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		env         config.Environment
+		file        config.File
+		stdin       string
+		wantError   string
+		wantOutput  []string
+		wantProfile string
+	}{
+		{
+			name: "migrate token from file",
+			args: args("configure --encrypt"),
+			env:  config.Environment{TokenPassphrase: "secret"},
+			file: config.File{User: config.User{Token: "abcdef"}},
+			wantOutput: []string{
+				"Encrypting the Fastly API token stored in the config file",
+				"Passphrase provided via FASTLY_TOKEN_PASSPHRASE",
+				"Configured the Fastly CLI",
+				"The API token is encrypted",
+			},
+		},
+		{
+			name: "migrate token from profile",
+			args: args("configure --encrypt --profile work"),
+			env:  config.Environment{TokenPassphrase: "secret"},
+			file: config.File{Profiles: map[string]*config.Profile{
+				"work": {Default: true, Token: "abcdef"},
+			}},
+			wantOutput: []string{
+				"Encrypting the Fastly API token stored in the config file",
+				"Configured the Fastly CLI (profile: work)",
+			},
+			wantProfile: "work",
+		},
+		{
+			name:  "passphrase prompted",
+			args:  args("configure --encrypt --token abcdef"),
+			stdin: "secret\nsecret\n",
+			wantOutput: []string{
+				"Passphrase to encrypt the token with: ",
+				"Confirm passphrase: ",
+				"Configured the Fastly CLI",
+			},
+		},
+		{
+			name:      "passphrase mismatch",
+			args:      args("configure --encrypt --token abcdef"),
+			stdin:     "secret\nsecrte\n",
+			wantError: "passphrases don't match",
+		},
+		{
+			name:      "empty passphrase",
+			args:      args("configure --encrypt --token abcdef"),
+			stdin:     "\n",
+			wantError: "passphrase cannot be empty",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Env = testcase.env
+			opts.Stdin = iotest.OneByteReader(strings.NewReader(testcase.stdin))
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantError != "" {
+				return
+			}
+
+			p, err := os.ReadFile(configFilePath)
+			testutil.AssertNoError(t, err)
+			if strings.Contains(string(p), "abcdef") {
+				t.Fatalf("config file contains the plaintext token:\n%s", p)
+			}
+
+			var f config.File
+			testutil.AssertNoError(t, toml.Unmarshal(p, &f))
+			token, encrypted := f.User.Token, f.User.EncryptedToken
+			if testcase.wantProfile != "" {
+				token, encrypted = f.Profiles[testcase.wantProfile].Token, f.Profiles[testcase.wantProfile].EncryptedToken
+			}
+			testutil.AssertString(t, "", token)
+			decrypted, err := config.DecryptToken(encrypted, "secret")
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, "abcdef", decrypted)
+		})
+	}
+}
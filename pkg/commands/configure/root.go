@@ -29,6 +29,7 @@ type RootCommand struct {
 	clientFactory  APIClientFactory
 	configFilePath string
 	display        bool
+	encrypt        bool
 	location       bool
 }
 
@@ -39,6 +40,7 @@ func NewRootCommand(parent cmd.Registerer, configFilePath string, cf APIClientFa
 	c.CmdClause = parent.Command("configure", "Configure the Fastly CLI")
 	c.CmdClause.Flag("location", "Print the location of the CLI configuration file").Short('l').BoolVar(&c.location)
 	c.CmdClause.Flag("display", "Print the CLI configuration file").Short('d').BoolVar(&c.display)
+	c.CmdClause.Flag("encrypt", fmt.Sprintf("Encrypt the stored API token with a passphrase, which is prompted for or read from %s. A plaintext token already in the config file is encrypted in place", env.TokenPassphrase)).BoolVar(&c.encrypt)
 	c.configFilePath = configFilePath
 	c.clientFactory = cf
 	return &c
@@ -74,12 +76,23 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	// Get the token provided by the user, if it was explicitly provided. If it
 	// wasn't provided, or if it only exists in the config file, take it
 	// interactively.
-	token, source := c.Globals.Token()
+	// A stored token that's still encrypted is replaced rather than reused, as
+	// the command doesn't ask for its passphrase.
+	token, source, err := c.Globals.Token()
+	if err != nil {
+		source = config.SourceUndefined
+	}
 	switch source { // TODO(pb): this can be duplicate output if --verbose is passed
 	case config.SourceFlag:
 		text.Output(out, "Fastly API token provided via --token")
 	case config.SourceEnvironment:
 		text.Output(out, "Fastly API token provided via %s", env.Token)
+	case config.SourceFile:
+		if c.encrypt {
+			text.Output(out, "Encrypting the Fastly API token stored in the config file")
+			break
+		}
+		fallthrough
 	default:
 		text.Output(out, `
 			An API token is used to authenticate requests to the Fastly API.
@@ -94,6 +107,15 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		text.Break(out)
 	}
 
+	var passphrase string
+	if c.encrypt {
+		passphrase, err = c.passphrase(in, out)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	text.Break(out)
 
 	progress := text.NewQuietProgress(out)
@@ -130,10 +152,43 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 	progress.Step("Persisting configuration...")
 
-	// Set everything in the File struct based on provided user input.
-	c.Globals.File.User.Token = token
-	c.Globals.File.User.Email = user.Login
-	c.Globals.File.Fastly.APIEndpoint = endpoint
+	// An encrypted token replaces the plaintext one, and vice versa, so that
+	// the config file never holds two different tokens for the same user.
+	var encryptedToken string
+	if c.encrypt {
+		encryptedToken, err = config.EncryptToken(token, passphrase)
+		if err != nil {
+			return fmt.Errorf("error encrypting token: %w", err)
+		}
+		token = ""
+	}
+
+	// Set everything in the File struct based on provided user input. If a
+	// credential profile has been selected then we write into that profile
+	// instead of the top-level [user] section.
+	profileName, _ := c.Globals.Profile()
+	if profileName != "" {
+		if c.Globals.File.Profiles == nil {
+			c.Globals.File.Profiles = make(map[string]*config.Profile)
+		}
+		p, ok := c.Globals.File.Profiles[profileName]
+		if !ok {
+			p = &config.Profile{}
+			c.Globals.File.Profiles[profileName] = p
+		}
+		p.Token = token
+		p.EncryptedToken = encryptedToken
+		p.Email = user.Login
+		p.APIEndpoint = endpoint
+		if c.Globals.File.DefaultProfile() == "" {
+			c.Globals.File.SetDefaultProfile(profileName)
+		}
+	} else {
+		c.Globals.File.User.Token = token
+		c.Globals.File.User.EncryptedToken = encryptedToken
+		c.Globals.File.User.Email = user.Login
+		c.Globals.File.Fastly.APIEndpoint = endpoint
+	}
 
 	// Make sure the config file directory exists.
 	dir := filepath.Dir(c.configFilePath)
@@ -164,11 +219,46 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	progress.Done()
 	text.Break(out)
 	text.Description(out, "You can find your configuration file at", filePath)
+	if c.encrypt {
+		text.Info(out, "The API token is encrypted. Provide the passphrase when prompted, or via %s.", env.TokenPassphrase)
+	}
+	if profileName != "" {
+		text.Success(out, "Configured the Fastly CLI (profile: %s)", profileName)
+		return nil
+	}
 	text.Success(out, "Configured the Fastly CLI")
 
 	return nil
 }
 
+// passphrase returns the passphrase to encrypt the token with, taking it from
+// the environment if set, otherwise asking for it twice to guard against
+// typos.
+func (c *RootCommand) passphrase(in io.Reader, out io.Writer) (string, error) {
+	if c.Globals.Env.TokenPassphrase != "" {
+		text.Output(out, "Passphrase provided via %s", env.TokenPassphrase)
+		return c.Globals.Env.TokenPassphrase, nil
+	}
+
+	passphrase, err := text.InputSecure(out, "Passphrase to encrypt the token with: ", in, validatePassphraseNotEmpty)
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", ErrEmptyPassphrase // input ended before a passphrase was given
+	}
+	text.Break(out)
+	confirm, err := text.InputSecure(out, "Confirm passphrase: ", in)
+	if err != nil {
+		return "", err
+	}
+	text.Break(out)
+	if confirm != passphrase {
+		return "", ErrPassphraseMismatch
+	}
+	return passphrase, nil
+}
+
 func validateTokenNotEmpty(s string) error {
 	if s == "" {
 		return ErrEmptyToken
@@ -179,3 +269,18 @@ func validateTokenNotEmpty(s string) error {
 // ErrEmptyToken is returned when a user tries to supply an emtpy string as a
 // token in the configure command.
 var ErrEmptyToken = errors.New("token cannot be empty")
+
+func validatePassphraseNotEmpty(s string) error {
+	if s == "" {
+		return ErrEmptyPassphrase
+	}
+	return nil
+}
+
+// ErrEmptyPassphrase is returned when a user tries to encrypt the token with
+// an empty passphrase.
+var ErrEmptyPassphrase = errors.New("passphrase cannot be empty")
+
+// ErrPassphraseMismatch is returned when the confirmation of the passphrase
+// doesn't match.
+var ErrPassphraseMismatch = errors.New("passphrases don't match")
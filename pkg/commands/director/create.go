@@ -0,0 +1,98 @@
+package director
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+var (
+	// directorTypeNames is the set of director types supported by the Fastly
+	// API, in the order of their numeric values.
+	directorTypeNames = []string{"random", "round-robin", "hash", "client"}
+
+	// directorTypes maps each director type name to go-fastly's DirectorType.
+	directorTypes = map[string]fastly.DirectorType{
+		"random":      fastly.DirectorTypeRandom,
+		"round-robin": fastly.DirectorTypeRoundRobin,
+		"hash":        fastly.DirectorTypeHash,
+		"client":      fastly.DirectorTypeClient,
+	}
+)
+
+// CreateCommand calls the Fastly API to create directors.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateDirectorInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// The type flag is validated against a fixed set of names, so it's stored
+	// as a plain string and converted to go-fastly's DirectorType later.
+	Type string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a director on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Director name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("type", "How the director selects a backend (random, round-robin, hash, client)").Default("random").EnumVar(&c.Type, directorTypeNames...)
+	c.CmdClause.Flag("quorum", "Percentage of capacity that needs to be up for the director to be considered up").UintVar(&c.Input.Quorum)
+	c.CmdClause.Flag("retries", "How many backends to search if the first one fails").UintVar(&c.Input.Retries)
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").StringVar(&c.Input.Shield)
+	c.CmdClause.Flag("comment", "A descriptive note").StringVar(&c.Input.Comment)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Type = directorTypes[c.Type]
+
+	d, err := c.Globals.Client.CreateDirector(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,101 @@
+package director
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// directorTypeName returns the flag name for the given DirectorType, falling
+// back to its numeric value for types this package doesn't know about.
+func directorTypeName(t fastly.DirectorType) string {
+	for name, v := range directorTypes {
+		if v == t {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// DescribeCommand calls the Fastly API to describe a director.
+type DescribeCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.GetDirectorInput
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("describe", "Show detailed information about a director on a Fastly service version").Alias("get")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("name", "Name of director").Short('n').Required().StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+
+	director, err := c.Globals.Client.GetDirector(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, director)
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", director.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", director.ServiceVersion)
+	printDirector(out, "", director)
+
+	return nil
+}
+
+// printDirector pretty prints a fastly.Director structure in verbose format
+// to the given io.Writer, with each line prefixed by prefix.
+func printDirector(out io.Writer, prefix string, d *fastly.Director) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", d.Name)
+	fmt.Fprintf(out, "Type: %s\n", directorTypeName(d.Type))
+	fmt.Fprintf(out, "Quorum: %d\n", d.Quorum)
+	fmt.Fprintf(out, "Retries: %d\n", d.Retries)
+	fmt.Fprintf(out, "Shield: %s\n", d.Shield)
+	fmt.Fprintf(out, "Comment: %s\n", d.Comment)
+}
@@ -0,0 +1,336 @@
+package director_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestDirectorCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("director create --service-id 123 --version 3 --name origin --type fallback"),
+			WantError: "error parsing arguments: enum value must be one of random,round-robin,hash,client, got 'fallback'",
+		},
+		{
+			Args: args("director create --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("director create --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				CreateDirectorFn: createDirectorError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director create --service-id 123 --version 1 --name origin --type hash --quorum 50 --retries 3 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				CreateDirectorFn: createDirectorOK,
+			},
+			WantOutput: "Created director origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("director list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			WantOutput: listDirectorsShortOutput,
+		},
+		{
+			Args: args("director list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			WantOutput: listDirectorsVerboseOutput,
+		},
+		{
+			Args: args("director list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestDirectorDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("director describe --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetDirectorFn:  getDirectorError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director describe --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetDirectorFn:  getDirectorOK,
+			},
+			WantOutput: describeDirectorOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestDirectorUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director update --service-id 123 --version 3 --quorum 50"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("director update --service-id 123 --version 1 --name origin --type client --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				UpdateDirectorFn: updateDirectorError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director update --service-id 123 --version 1 --name origin --type client --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				UpdateDirectorFn: updateDirectorOK,
+			},
+			WantOutput: "Updated director origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("director delete --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				DeleteDirectorFn: deleteDirectorError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director delete --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				DeleteDirectorFn: deleteDirectorOK,
+			},
+			WantOutput: "Deleted director origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createDirectorOK(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	if i.Type != fastly.DirectorTypeHash || i.Quorum != 50 || i.Retries != 3 {
+		return nil, errTest
+	}
+	return &fastly.Director{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createDirectorError(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return nil, errTest
+}
+
+func listDirectorsOK(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return []*fastly.Director{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "origin",
+			Type:           fastly.DirectorTypeRandom,
+			Quorum:         75,
+			Retries:        5,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "sticky",
+			Type:           fastly.DirectorTypeClient,
+			Quorum:         50,
+			Retries:        2,
+			Shield:         "iad-va-us",
+			Comment:        "session affinity",
+		},
+	}, nil
+}
+
+func listDirectorsError(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return nil, errTest
+}
+
+var listDirectorsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME    TYPE    QUORUM  RETRIES
+123      1        origin  random  75      5
+123      1        sticky  client  50      2
+`) + "\n"
+
+var listDirectorsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Director 1/2",
+	"		Name: origin",
+	"		Type: random",
+	"		Quorum: 75",
+	"		Retries: 5",
+	"		Shield: ",
+	"		Comment: ",
+	"	Director 2/2",
+	"		Name: sticky",
+	"		Type: client",
+	"		Quorum: 50",
+	"		Retries: 2",
+	"		Shield: iad-va-us",
+	"		Comment: session affinity",
+}, "\n") + "\n\n"
+
+func getDirectorOK(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	return &fastly.Director{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Type:           fastly.DirectorTypeHash,
+		Quorum:         75,
+		Retries:        5,
+		Comment:        "primary pool",
+	}, nil
+}
+
+func getDirectorError(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	return nil, errTest
+}
+
+var describeDirectorOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: origin",
+	"Type: hash",
+	"Quorum: 75",
+	"Retries: 5",
+	"Shield: ",
+	"Comment: primary pool",
+}, "\n") + "\n"
+
+func updateDirectorOK(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	if i.Type != fastly.DirectorTypeClient || i.NewName != nil || i.Quorum != nil {
+		return nil, errTest
+	}
+	return &fastly.Director{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Type:           i.Type,
+	}, nil
+}
+
+func updateDirectorError(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	return nil, errTest
+}
+
+func deleteDirectorOK(i *fastly.DeleteDirectorInput) error {
+	return nil
+}
+
+func deleteDirectorError(i *fastly.DeleteDirectorInput) error {
+	return errTest
+}
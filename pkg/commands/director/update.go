@@ -0,0 +1,113 @@
+package director
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update directors.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateDirectorInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName cmd.OptionalString
+	Type    cmd.OptionalString
+	Quorum  cmd.OptionalUint
+	Retries cmd.OptionalUint
+	Shield  cmd.OptionalString
+	Comment cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a director on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Director name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New director name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("type", "How the director selects a backend (random, round-robin, hash, client)").Action(c.Type.Set).EnumVar(&c.Type.Value, directorTypeNames...)
+	c.CmdClause.Flag("quorum", "Percentage of capacity that needs to be up for the director to be considered up").Action(c.Quorum.Set).UintVar(&c.Quorum.Value)
+	c.CmdClause.Flag("retries", "How many backends to search if the first one fails").Action(c.Retries.Set).UintVar(&c.Retries.Value)
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").Action(c.Shield.Set).StringVar(&c.Shield.Value)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	// Unlike the other fields, go-fastly doesn't take a pointer for the type
+	// and omits it from the request when left at its zero value.
+	if c.Type.WasSet {
+		c.input.Type = directorTypes[c.Type.Value]
+	}
+
+	if c.Quorum.WasSet {
+		c.input.Quorum = fastly.Uint(c.Quorum.Value)
+	}
+
+	if c.Retries.WasSet {
+		c.input.Retries = fastly.Uint(c.Retries.Value)
+	}
+
+	if c.Shield.WasSet {
+		c.input.Shield = fastly.String(c.Shield.Value)
+	}
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	d, err := c.Globals.Client.UpdateDirector(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,76 @@
+package directorbackend
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// AddCommand calls the Fastly API to assign a backend to a director.
+type AddCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateDirectorBackendInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+}
+
+// NewAddCommand returns a usable command registered under the parent.
+func NewAddCommand(parent cmd.Registerer, globals *config.Data) *AddCommand {
+	var c AddCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("add", "Assign a backend to a director on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("director", "Director name").Short('d').Required().StringVar(&c.Input.Director)
+	c.CmdClause.Flag("backend", "Backend name").Short('b').Required().StringVar(&c.Input.Backend)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *AddCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+
+	b, err := c.Globals.Client.CreateDirectorBackend(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+			"Director":        c.Input.Director,
+		})
+		return err
+	}
+
+	text.Success(out, "Added backend %s to director %s (service %s version %d)", b.Backend, b.Director, b.ServiceID, b.ServiceVersion)
+	return nil
+}
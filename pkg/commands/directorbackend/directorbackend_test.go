@@ -0,0 +1,202 @@
+package directorbackend_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestDirectorBackendAdd(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director backend add --service-id 123 --version 3 --backend www.test.com"),
+			WantError: "error parsing arguments: required flag --director not provided",
+		},
+		{
+			Args: args("director backend add --service-id 123 --version 1 --director origin --backend www.test.com"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("director backend add --service-id 123 --version 1 --director origin --backend www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:          testutil.ListVersions,
+				CloneVersionFn:          testutil.CloneVersionResult(4),
+				CreateDirectorBackendFn: createDirectorBackendError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director backend add --service-id 123 --version 1 --director origin --backend www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:          testutil.ListVersions,
+				CloneVersionFn:          testutil.CloneVersionResult(4),
+				CreateDirectorBackendFn: createDirectorBackendOK,
+			},
+			WantOutput: "Added backend www.test.com to director origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorBackendList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director backend list --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --director not provided",
+		},
+		{
+			Args: args("director backend list --service-id 123 --version 1 --director origin"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				ListBackendsFn:       listBackendsOK,
+				GetDirectorBackendFn: getDirectorBackendOK,
+			},
+			WantOutput: listDirectorBackendsOutput,
+		},
+		{
+			Args: args("director backend list --service-id 123 --version 1 --director origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director backend list --service-id 123 --version 1 --director origin"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				ListBackendsFn:       listBackendsOK,
+				GetDirectorBackendFn: getDirectorBackendError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestDirectorBackendRemove(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director backend remove --service-id 123 --version 1 --director origin"),
+			WantError: "error parsing arguments: required flag --backend not provided",
+		},
+		{
+			Args: args("director backend remove --service-id 123 --version 1 --director origin --backend www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:          testutil.ListVersions,
+				CloneVersionFn:          testutil.CloneVersionResult(4),
+				DeleteDirectorBackendFn: deleteDirectorBackendError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director backend remove --service-id 123 --version 1 --director origin --backend www.test.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:          testutil.ListVersions,
+				CloneVersionFn:          testutil.CloneVersionResult(4),
+				DeleteDirectorBackendFn: deleteDirectorBackendOK,
+			},
+			WantOutput: "Removed backend www.test.com from director origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createDirectorBackendOK(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return &fastly.DirectorBackend{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Director:       i.Director,
+		Backend:        i.Backend,
+	}, nil
+}
+
+func createDirectorBackendError(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return nil, errTest
+}
+
+func listBackendsOK(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "a.test.com"},
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "b.test.com"},
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "c.test.com"},
+	}, nil
+}
+
+func listBackendsError(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return nil, errTest
+}
+
+// getDirectorBackendOK reports every backend except b.test.com as assigned
+// to the director.
+func getDirectorBackendOK(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	if i.Backend == "b.test.com" {
+		return nil, &fastly.HTTPError{StatusCode: http.StatusNotFound}
+	}
+	return &fastly.DirectorBackend{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Director:       i.Director,
+		Backend:        i.Backend,
+	}, nil
+}
+
+func getDirectorBackendError(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return nil, errTest
+}
+
+var listDirectorBackendsOutput = strings.TrimSpace(`
+SERVICE  VERSION  DIRECTOR  BACKEND
+123      1        origin    a.test.com
+123      1        origin    c.test.com
+`) + "\n"
+
+func deleteDirectorBackendOK(i *fastly.DeleteDirectorBackendInput) error {
+	return nil
+}
+
+func deleteDirectorBackendError(i *fastly.DeleteDirectorBackendInput) error {
+	return errTest
+}
@@ -0,0 +1,3 @@
+// Package directorbackend contains commands to inspect and manipulate the
+// backends assigned to Fastly service directors.
+package directorbackend
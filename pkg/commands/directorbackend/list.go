@@ -0,0 +1,104 @@
+package directorbackend
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list the backends assigned to a
+// director.
+type ListCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	director       string
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("list", "List the backends assigned to a director on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("director", "Director name").Short('d').Required().StringVar(&c.director)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	// The Fastly API has no endpoint for listing a director's backends, so
+	// every backend on the service version is checked for a mapping instead.
+	backends, err := c.Globals.Client.ListBackends(&fastly.ListBackendsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	mappings := []*fastly.DirectorBackend{}
+	for _, backend := range backends {
+		b, err := c.Globals.Client.GetDirectorBackend(&fastly.GetDirectorBackendInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Director:       c.director,
+			Backend:        backend.Name,
+		})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+				"Director":        c.director,
+				"Backend":         backend.Name,
+			})
+			return err
+		}
+		mappings = append(mappings, b)
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, mappings)
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("SERVICE", "VERSION", "DIRECTOR", "BACKEND")
+	for _, b := range mappings {
+		tw.AddLine(b.ServiceID, b.ServiceVersion, b.Director, b.Backend)
+	}
+	tw.Print()
+	return nil
+}
@@ -64,6 +64,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, domain)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", domain.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", domain.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", domain.Name)
@@ -64,6 +64,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, dictionary)
+	}
+
 	text.Output(out, "Service ID: %s", dictionary.ServiceID)
 	text.Output(out, "Version: %d", dictionary.ServiceVersion)
 	text.PrintDictionary(out, "", dictionary)
@@ -63,6 +63,10 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, dictionaries)
+	}
+
 	text.Output(out, "Service ID: %s", serviceID)
 	text.Output(out, "Version: %d", c.Input.ServiceVersion)
 	for _, dictionary := range dictionaries {
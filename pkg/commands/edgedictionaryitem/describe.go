@@ -47,6 +47,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, dictionary)
+	}
+
 	text.Output(out, "Service ID: %s", c.Input.ServiceID)
 	text.PrintDictionaryItem(out, "", dictionary)
 	return nil
@@ -0,0 +1,322 @@
+package gzip_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestGzipCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("gzip create --service-id 123 --version 3 --extensions js"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("gzip create --service-id 123 --version 1 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("gzip create --service-id 123 --version 1 --name text --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("gzip create --service-id 123 --version 1 --name text --content-types text/html --extensions css --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipOK,
+			},
+			WantOutput: "Created gzip configuration text (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("gzip list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			WantOutput: listGzipsShortOutput,
+		},
+		{
+			Args: args("gzip list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			WantOutput: listGzipsVerboseOutput,
+		},
+		{
+			Args: args("gzip list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestGzipDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("gzip describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("gzip describe --service-id 123 --version 1 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("gzip describe --service-id 123 --version 1 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipOK,
+			},
+			WantOutput: describeGzipOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestGzipUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("gzip update --service-id 123 --version 3 --extensions js"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("gzip update --service-id 123 --version 1 --name text --extensions js --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("gzip update --service-id 123 --version 1 --name text --extensions js --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipOK,
+			},
+			WantOutput: "Updated gzip configuration text (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("gzip delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipOK,
+			},
+			WantOutput: "Deleted gzip configuration text (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createGzipOK(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	if i.ContentTypes != "text/html" || i.Extensions != "css" {
+		return nil, errTest
+	}
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createGzipError(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func listGzipsOK(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return []*fastly.Gzip{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "json",
+			ContentTypes:   "application/json",
+			Extensions:     "json",
+			CacheCondition: "api-response",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "text",
+			ContentTypes:   "text/html text/css",
+			Extensions:     "html css",
+			CacheCondition: "always",
+		},
+	}, nil
+}
+
+func listGzipsError(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+var listGzipsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME  CONTENT TYPES       EXTENSIONS  CACHE CONDITION
+123      1        json  application/json    json        api-response
+123      1        text  text/html text/css  html css    always
+`) + "\n"
+
+var listGzipsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Gzip 1/2",
+	"		Name: json",
+	"		Content types: application/json",
+	"		Extensions: json",
+	"		Cache condition: api-response",
+	"	Gzip 2/2",
+	"		Name: text",
+	"		Content types: text/html text/css",
+	"		Extensions: html css",
+	"		Cache condition: always",
+}, "\n") + "\n\n"
+
+func getGzipOK(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		ContentTypes:   "text/html text/css",
+		Extensions:     "html css",
+	}, nil
+}
+
+func getGzipError(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+var describeGzipOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: text",
+	"Content types: text/html text/css",
+	"Extensions: html css",
+	"Cache condition: ",
+}, "\n") + "\n"
+
+func updateGzipOK(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	if i.NewName != nil || i.ContentTypes != nil || i.CacheCondition != nil {
+		return nil, errTest
+	}
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Extensions:     *i.Extensions,
+	}, nil
+}
+
+func updateGzipError(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func deleteGzipOK(i *fastly.DeleteGzipInput) error {
+	return nil
+}
+
+func deleteGzipError(i *fastly.DeleteGzipInput) error {
+	return errTest
+}
@@ -0,0 +1,115 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+var (
+	// headerActions is the set of header actions supported by the Fastly API.
+	headerActions = []string{
+		string(fastly.HeaderActionSet),
+		string(fastly.HeaderActionAppend),
+		string(fastly.HeaderActionDelete),
+		string(fastly.HeaderActionRegex),
+		string(fastly.HeaderActionRegexRepeat),
+	}
+
+	// headerTypes is the set of header types supported by the Fastly API.
+	headerTypes = []string{
+		string(fastly.HeaderTypeRequest),
+		string(fastly.HeaderTypeFetch),
+		string(fastly.HeaderTypeCache),
+		string(fastly.HeaderTypeResponse),
+	}
+)
+
+// CreateCommand calls the Fastly API to create header objects.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateHeaderInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// The action and type flags are validated against a fixed set of values,
+	// so they're stored as plain strings and converted to go-fastly's custom
+	// HeaderAction and HeaderType types later, as is the boolean flag which
+	// becomes a `Compatibool`.
+	Action      string
+	Type        string
+	IgnoreIfSet bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a header object on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("action", "Action to perform on the header (set, append, delete, regex, regex_repeat)").Required().EnumVar(&c.Action, headerActions...)
+	c.CmdClause.Flag("type", "When the header is processed (request, fetch, cache, response)").Required().EnumVar(&c.Type, headerTypes...)
+	c.CmdClause.Flag("dst", "Header to set, e.g. http.X-Cache").Required().StringVar(&c.Input.Destination)
+	c.CmdClause.Flag("src", "Variable to be used as a source for the header content").StringVar(&c.Input.Source)
+	c.CmdClause.Flag("regex", "Regular expression to use with the regex and regex_repeat actions").StringVar(&c.Input.Regex)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of regular expression matches").StringVar(&c.Input.Substitution)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it is already set (only applies to the set action)").BoolVar(&c.IgnoreIfSet)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").UintVar(&c.Input.Priority)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will apply the header during a request").StringVar(&c.Input.RequestCondition)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the header to a cached response").StringVar(&c.Input.CacheCondition)
+	c.CmdClause.Flag("response-condition", "Condition which, if met, will apply the header to a response").StringVar(&c.Input.ResponseCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Action = fastly.HeaderAction(c.Action)
+	c.Input.Type = fastly.HeaderType(c.Type)
+	c.Input.IgnoreIfSet = fastly.Compatibool(c.IgnoreIfSet)
+
+	h, err := c.Globals.Client.CreateHeader(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created header %s (service %s version %d)", h.Name, h.ServiceID, h.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,359 @@
+package header_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestHeaderCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("header create --service-id 123 --version 3 --action set --type request --dst http.X-Foo"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("header create --service-id 123 --version 3 --name foo --action replace --type request --dst http.X-Foo"),
+			WantError: "error parsing arguments: enum value must be one of set,append,delete,regex,regex_repeat, got 'replace'",
+		},
+		{
+			Args: args("header create --service-id 123 --version 1 --name foo --action set --type request --dst http.X-Foo"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("header create --service-id 123 --version 1 --name foo --action set --type request --dst http.X-Foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("header create --service-id 123 --version 1 --name foo --action set --type request --dst http.X-Foo --src req.http.Bar --ignore-if-set --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderOK,
+			},
+			WantOutput: "Created header foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("header list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			WantOutput: listHeadersShortOutput,
+		},
+		{
+			Args: args("header list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			WantOutput: listHeadersVerboseOutput,
+		},
+		{
+			Args: args("header list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestHeaderDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("header describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("header describe --service-id 123 --version 1 --name foo"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("header describe --service-id 123 --version 1 --name foo"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderOK,
+			},
+			WantOutput: describeHeaderOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestHeaderUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("header update --service-id 123 --version 3 --new-name bar"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("header update --service-id 123 --version 1 --name foo --new-name bar --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("header update --service-id 123 --version 1 --name foo --new-name bar --dst http.X-Bar --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderOK,
+			},
+			WantOutput: "Updated header bar (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("header delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("header delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("header delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderOK,
+			},
+			WantOutput: "Deleted header foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createHeaderOK(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	if i.Action != fastly.HeaderActionSet || i.Type != fastly.HeaderTypeRequest || !bool(i.IgnoreIfSet) {
+		return nil, errTest
+	}
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createHeaderError(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func listHeadersOK(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return []*fastly.Header{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "cache-status",
+			Action:         fastly.HeaderActionSet,
+			Type:           fastly.HeaderTypeResponse,
+			Destination:    "http.X-Cache",
+			Source:         "fastly_info.state",
+			Priority:       10,
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "strip-cookie",
+			Action:           fastly.HeaderActionDelete,
+			Type:             fastly.HeaderTypeRequest,
+			Destination:      "http.Cookie",
+			Priority:         100,
+			RequestCondition: "static",
+		},
+	}, nil
+}
+
+func listHeadersError(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return nil, errTest
+}
+
+var listHeadersShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME          TYPE      ACTION  DESTINATION   PRIORITY
+123      1        cache-status  response  set     http.X-Cache  10
+123      1        strip-cookie  request   delete  http.Cookie   100
+`) + "\n"
+
+var listHeadersVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Header 1/2",
+	"		Name: cache-status",
+	"		Action: set",
+	"		Type: response",
+	"		Destination: http.X-Cache",
+	"		Source: fastly_info.state",
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 10",
+	"		Request condition: ",
+	"		Cache condition: ",
+	"		Response condition: ",
+	"	Header 2/2",
+	"		Name: strip-cookie",
+	"		Action: delete",
+	"		Type: request",
+	"		Destination: http.Cookie",
+	"		Source: ",
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 100",
+	"		Request condition: static",
+	"		Cache condition: ",
+	"		Response condition: ",
+}, "\n") + "\n\n"
+
+func getHeaderOK(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         fastly.HeaderActionRegex,
+		Type:           fastly.HeaderTypeCache,
+		Destination:    "http.Location",
+		Source:         "beresp.http.Location",
+		Regex:          "^http://",
+		Substitution:   "https://",
+		Priority:       10,
+	}, nil
+}
+
+func getHeaderError(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+var describeHeaderOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: foo",
+	"Action: regex",
+	"Type: cache",
+	"Destination: http.Location",
+	"Source: beresp.http.Location",
+	"Regex: ^http://",
+	"Substitution: https://",
+	"Ignore if set: false",
+	"Priority: 10",
+	"Request condition: ",
+	"Cache condition: ",
+	"Response condition: ",
+}, "\n") + "\n"
+
+func updateHeaderOK(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	if i.Action != nil || i.Source != nil || i.Priority != nil {
+		return nil, errTest
+	}
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+		Destination:    *i.Destination,
+	}, nil
+}
+
+func updateHeaderError(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func deleteHeaderOK(i *fastly.DeleteHeaderInput) error {
+	return nil
+}
+
+func deleteHeaderError(i *fastly.DeleteHeaderInput) error {
+	return errTest
+}
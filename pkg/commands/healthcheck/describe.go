@@ -65,6 +65,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, healthCheck)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", healthCheck.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", healthCheck.ServiceVersion)
 	text.PrintHealthCheck(out, "", healthCheck)
@@ -0,0 +1,3 @@
+// Package history contains commands to browse the journal of API changes made
+// with the CLI, and to undo them.
+package history
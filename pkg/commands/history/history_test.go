@@ -0,0 +1,210 @@
+package history_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestHistory(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:       "list",
+			Args:       args("history"),
+			WantOutput: listOutput,
+		},
+		{
+			Name:       "list filtered by service",
+			Args:       args("history --service-id 456"),
+			WantOutput: filteredOutput,
+		},
+		{
+			Name:       "list limited",
+			Args:       args("history --limit 1"),
+			WantOutput: filteredOutput,
+		},
+		{
+			Name:       "describe",
+			Args:       args("history --id 1"),
+			WantOutput: describeOutput,
+		},
+		{
+			Name:       "describe an entry that can't be undone",
+			Args:       args("history --id 2"),
+			WantOutput: describePurgeOutput,
+		},
+		{
+			Name:      "describe unknown entry",
+			Args:      args("history --id 3"),
+			WantError: "journal entry 3 not found",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			path := writeJournal(t, fixtureEntries())
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.JournalPath = path
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestUndo(t *testing.T) {
+	var deleted []*fastly.DeleteBackendInput
+	api := mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		CloneVersionFn: testutil.CloneVersionResult(4),
+		CreateBackendFn: func(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+		DeleteBackendFn: func(i *fastly.DeleteBackendInput) error {
+			deleted = append(deleted, i)
+			return nil
+		},
+		GetBackendFn: func(i *fastly.GetBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, Address: "127.0.0.1"}, nil
+		},
+	}
+	path := filepath.Join(t.TempDir(), "journal.json")
+	run := func(args, stdin string) (string, error) {
+		var stdout bytes.Buffer
+		opts := testutil.NewRunOpts(testutil.Args(args), &stdout)
+		opts.APIClient = mock.APIClient(api)
+		opts.JournalPath = path
+		opts.Stdin = strings.NewReader(stdin)
+		err := app.Run(opts)
+		return stdout.String(), err
+	}
+
+	_, err := run("undo", "")
+	testutil.AssertErrorContains(t, err, "there's nothing to undo")
+
+	_, err = run("backend create --service-id 123 --version 1 --address 127.0.0.1 --name www.test.com --autoclone --token abc", "")
+	testutil.AssertNoError(t, err)
+
+	out, err := run("undo", "n\n")
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, out, "Undoing entry 1: fastly backend create --service-id 123 --version 1 --address 127.0.0.1 --name www.test.com --autoclone --token REDACTED\n\tDeleteBackend (ServiceID: 123, ServiceVersion: 4, Name: www.test.com)\n")
+	testutil.AssertStringContains(t, out, "Undo cancelled.")
+	if len(deleted) != 0 {
+		t.Fatal("want no changes when cancelled")
+	}
+
+	out, err = run("undo --auto-yes", "")
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, out, "Undid entry 1")
+	testutil.AssertEqual(t, []*fastly.DeleteBackendInput{{ServiceID: "123", ServiceVersion: 4, Name: "www.test.com"}}, deleted)
+
+	_, err = run("undo --id 1", "")
+	testutil.AssertErrorContains(t, err, "entry 1 was already undone")
+
+	// The undo is itself journaled, so it can be undone in turn.
+	entries, err := journal.Read(path)
+	testutil.AssertNoError(t, err)
+	if len(entries) != 2 || entries[0].UndoneAt == nil {
+		t.Fatalf("want entry 1 undone and the undo journaled, have %+v", entries)
+	}
+	testutil.AssertString(t, "undo --auto-yes", strings.Join(entries[1].Args, " "))
+
+	out, err = run("undo -y", "")
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, out, "\tCreateBackend (ServiceID: 123, ServiceVersion: 4)\n")
+}
+
+func TestUndoNotPossible(t *testing.T) {
+	path := writeJournal(t, fixtureEntries())
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("undo --id 2"), &stdout)
+	opts.JournalPath = path
+	err := app.Run(opts)
+	testutil.AssertErrorContains(t, err, "entry 2 can't be undone: PurgeAll can't be undone")
+}
+
+func fixtureEntries() []journal.Entry {
+	encode := func(v interface{}) json.RawMessage {
+		data, _ := json.Marshal(v)
+		return data
+	}
+	return []journal.Entry{
+		{
+			ID:             1,
+			Time:           time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			Args:           []string{"backend", "update", "--service-id", "123", "--version", "2", "--name", "www", "--port", "8080"},
+			ServiceID:      "123",
+			ServiceVersion: 2,
+			Operations: []journal.Operation{
+				{
+					Method: "UpdateBackend",
+					Input:  encode(fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: fastly.Uint(8080)}),
+					Before: encode(fastly.Backend{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: 80}),
+					Result: encode(fastly.Backend{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: 8080}),
+				},
+			},
+		},
+		{
+			ID:        2,
+			Time:      time.Date(2021, 6, 2, 12, 0, 0, 0, time.UTC),
+			Args:      []string{"purge", "--all", "--service-id", "456"},
+			ServiceID: "456",
+			Operations: []journal.Operation{
+				{Method: "PurgeAll", Input: encode(fastly.PurgeAllInput{ServiceID: "456"})},
+			},
+		},
+	}
+}
+
+func writeJournal(t *testing.T, entries []journal.Entry) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "journal.json")
+	if err := journal.Write(path, entries); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+var listOutput = "" +
+	"ID  TIME                  COMMAND                                                                    SERVICE  VERSION  UNDONE\n" +
+	"2   2021-06-02T12:00:00Z  fastly purge --all --service-id 456                                        456               \n" +
+	"1   2021-06-01T12:00:00Z  fastly backend update --service-id 123 --version 2 --name www --port 8080  123      2        \n"
+
+var filteredOutput = "" +
+	"ID  TIME                  COMMAND                              SERVICE  VERSION  UNDONE\n" +
+	"2   2021-06-02T12:00:00Z  fastly purge --all --service-id 456  456               \n"
+
+var describeOutput = strings.TrimSpace(`
+ID: 1
+Time: 2021-06-01T12:00:00Z
+Command: fastly backend update --service-id 123 --version 2 --name www --port 8080
+Service ID: 123
+Version: 2
+Operations:
+	UpdateBackend
+Undo:
+	UpdateBackend (ServiceID: 123, ServiceVersion: 2, Name: www)
+`) + "\n"
+
+var describePurgeOutput = strings.TrimSpace(`
+ID: 2
+Time: 2021-06-02T12:00:00Z
+Command: fastly purge --all --service-id 456
+Service ID: 456
+Operations:
+	PurgeAll
+Undo: not possible, PurgeAll can't be undone: the API has no inverse operation
+`) + "\n"
@@ -0,0 +1,154 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/segmentio/textio"
+)
+
+// RootCommand lists the journal of changes made with the CLI, or describes a
+// single entry.
+type RootCommand struct {
+	cmd.Base
+	journalPath string
+
+	id        int
+	limit     int
+	serviceID string
+}
+
+// NewRootCommand returns a new command registered in the parent.
+func NewRootCommand(parent cmd.Registerer, journalPath string, globals *config.Data) *RootCommand {
+	var c RootCommand
+	c.Globals = globals
+	c.journalPath = journalPath
+	c.CmdClause = parent.Command("history", "List the changes made to your account with the CLI, or describe one in detail")
+	c.CmdClause.Flag("id", "ID of a journal entry to describe").IntVar(&c.id)
+	c.CmdClause.Flag("limit", "Maximum number of entries to list, most recent first").Default("20").IntVar(&c.limit)
+	c.CmdClause.Flag("service-id", "Only list changes to this service").StringVar(&c.serviceID)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
+	entries, err := journal.Read(c.journalPath)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.id != 0 {
+		e, ok := journal.Find(entries, c.id)
+		if !ok {
+			return errors.RemediationError{
+				Inner:       fmt.Errorf("journal entry %d not found", c.id),
+				Remediation: "Run `fastly history` to list the available entries.",
+			}
+		}
+		if c.Globals.JSON() {
+			return c.WriteJSON(out, e)
+		}
+		printEntry(out, "", *e)
+		return nil
+	}
+
+	// Most recent first.
+	var selected []journal.Entry
+	for i := len(entries) - 1; i >= 0 && (c.limit <= 0 || len(selected) < c.limit); i-- {
+		if c.serviceID == "" || entries[i].ServiceID == c.serviceID {
+			selected = append(selected, entries[i])
+		}
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, selected)
+	}
+
+	if c.Globals.Verbose() {
+		for i, e := range selected {
+			fmt.Fprintf(out, "Entry %d/%d\n", i+1, len(selected))
+			printEntry(out, "\t", e)
+			fmt.Fprintln(out)
+		}
+		return nil
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("ID", "TIME", "COMMAND", "SERVICE", "VERSION", "UNDONE")
+	for _, e := range selected {
+		tw.AddLine(e.ID, e.Time.Format(time.RFC3339), command(e), e.ServiceID, version(e), undone(e))
+	}
+	tw.Print()
+	return nil
+}
+
+// printEntry writes the details of an entry, including how it would be
+// undone, with each line prefixed by prefix.
+func printEntry(out io.Writer, prefix string, e journal.Entry) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %d\n", e.ID)
+	fmt.Fprintf(out, "Time: %s\n", e.Time.Format(time.RFC3339))
+	fmt.Fprintf(out, "Command: %s\n", command(e))
+	if e.ServiceID != "" {
+		fmt.Fprintf(out, "Service ID: %s\n", e.ServiceID)
+	}
+	if e.ServiceVersion != 0 {
+		fmt.Fprintf(out, "Version: %d\n", e.ServiceVersion)
+	}
+	if e.UndoneAt != nil {
+		fmt.Fprintf(out, "Undone at: %s\n", e.UndoneAt.Format(time.RFC3339))
+	}
+
+	fmt.Fprintf(out, "Operations:\n")
+	for _, op := range e.Operations {
+		if op.Succeeded() {
+			fmt.Fprintf(out, "\t%s\n", op.Method)
+		} else {
+			fmt.Fprintf(out, "\t%s (failed: %s)\n", op.Method, op.Error)
+		}
+	}
+
+	if e.UndoneAt != nil {
+		return
+	}
+	calls, err := journal.Undo(e)
+	switch {
+	case err != nil:
+		fmt.Fprintf(out, "Undo: not possible, %s\n", err)
+	case len(calls) == 0:
+		fmt.Fprintf(out, "Undo: nothing to undo\n")
+	default:
+		fmt.Fprintf(out, "Undo:\n")
+		for _, c := range calls {
+			fmt.Fprintf(out, "\t%s\n", c)
+		}
+	}
+}
+
+func command(e journal.Entry) string {
+	return strings.TrimSpace("fastly " + strings.Join(e.Args, " "))
+}
+
+func version(e journal.Entry) string {
+	if e.ServiceVersion == 0 {
+		return ""
+	}
+	return strconv.Itoa(e.ServiceVersion)
+}
+
+func undone(e journal.Entry) string {
+	if e.UndoneAt == nil {
+		return ""
+	}
+	return e.UndoneAt.Format(time.RFC3339)
+}
@@ -0,0 +1,132 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UndoCommand reverses the changes recorded by a journal entry.
+type UndoCommand struct {
+	cmd.Base
+	journalPath string
+
+	id      int
+	autoYes bool
+}
+
+// NewUndoCommand returns a new command registered in the parent.
+func NewUndoCommand(parent cmd.Registerer, journalPath string, globals *config.Data) *UndoCommand {
+	var c UndoCommand
+	c.Globals = globals
+	c.journalPath = journalPath
+	c.CmdClause = parent.Command("undo", "Undo the changes made by a previous command, by default the most recent one")
+	c.CmdClause.Flag("id", "ID of the journal entry to undo, as listed by fastly history").IntVar(&c.id)
+	c.CmdClause.Flag("auto-yes", "Undo without asking for confirmation").Short('y').BoolVar(&c.autoYes)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *UndoCommand) Exec(in io.Reader, out io.Writer) error {
+	entries, err := journal.Read(c.journalPath)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	e, err := c.entry(entries)
+	if err != nil {
+		return err
+	}
+
+	calls, err := journal.Undo(*e)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("entry %d can't be undone: %w", e.ID, err),
+			Remediation: fmt.Sprintf("Revert the change manually. Run `fastly history --id %d` for details of what was changed.", e.ID),
+		}
+	}
+
+	fmt.Fprintf(out, "Undoing entry %d: %s\n", e.ID, command(*e))
+	for _, call := range calls {
+		fmt.Fprintf(out, "\t%s\n", call)
+	}
+	text.Break(out)
+
+	if len(calls) > 0 && !c.autoYes {
+		answer, err := text.Input(out, "Are you sure you want to make these changes? [y/N] ", in)
+		if err != nil {
+			return err
+		}
+		if answer != "y" && answer != "Y" {
+			text.Info(out, "Undo cancelled.")
+			return nil
+		}
+	}
+
+	for i, call := range calls {
+		if err := call.Invoke(c.Globals.Client); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Entry": e.ID,
+				"Call":  call.String(),
+			})
+			return errors.RemediationError{
+				Inner:       fmt.Errorf("error undoing entry %d: %s: %w", e.ID, call.Method, err),
+				Remediation: fmt.Sprintf("%d of %d changes were undone. Revert the remainder manually.", i, len(calls)),
+			}
+		}
+	}
+
+	// Nothing was actually undone, so the entry can still be undone for real.
+	if c.Globals.DryRun() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	e.UndoneAt = &now
+	if err := journal.Write(c.journalPath, entries); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Undid entry %d", e.ID)
+	return nil
+}
+
+// entry returns the entry selected by the id argument, or the most recent
+// entry that hasn't been undone.
+func (c *UndoCommand) entry(entries []journal.Entry) (*journal.Entry, error) {
+	if c.id == 0 {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].UndoneAt == nil {
+				return &entries[i], nil
+			}
+		}
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("there's nothing to undo"),
+			Remediation: "Run `fastly history` to list the changes made with the CLI.",
+		}
+	}
+
+	e, ok := journal.Find(entries, c.id)
+	if !ok {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("journal entry %d not found", c.id),
+			Remediation: "Run `fastly history` to list the available entries.",
+		}
+	}
+	if e.UndoneAt != nil {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("entry %d was already undone at %s", e.ID, e.UndoneAt.Format(time.RFC3339)),
+			Remediation: "Run `fastly history` to list the available entries.",
+		}
+	}
+	return e, nil
+}
@@ -27,7 +27,10 @@ func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
 // Exec implements the command interface.
 func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 	// Exit early if no token configured.
-	_, s := c.Globals.Token()
+	_, s, err := c.Globals.Token()
+	if err != nil {
+		return err
+	}
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
 	}
@@ -64,6 +64,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, azureblob)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", azureblob.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", azureblob.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", azureblob.Name)
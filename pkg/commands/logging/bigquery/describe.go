@@ -64,6 +64,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, bq)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", bq.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", bq.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", bq.Name)
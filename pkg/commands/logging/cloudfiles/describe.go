@@ -64,6 +64,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, cloudfiles)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", cloudfiles.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", cloudfiles.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", cloudfiles.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, datadog)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", datadog.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", datadog.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", datadog.Name)
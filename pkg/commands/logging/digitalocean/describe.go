@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, digitalocean)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", digitalocean.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", digitalocean.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", digitalocean.Name)
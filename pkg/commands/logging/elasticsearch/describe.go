@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, elasticsearch)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", elasticsearch.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", elasticsearch.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", elasticsearch.Name)
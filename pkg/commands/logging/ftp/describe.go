@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, ftp)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", ftp.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", ftp.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", ftp.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, gcs)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", gcs.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", gcs.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", gcs.Name)
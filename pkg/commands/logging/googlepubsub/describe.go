@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, googlepubsub)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", googlepubsub.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", googlepubsub.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", googlepubsub.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, heroku)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", heroku.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", heroku.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", heroku.Name)
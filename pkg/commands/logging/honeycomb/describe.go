@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, honeycomb)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", honeycomb.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", honeycomb.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", honeycomb.Name)
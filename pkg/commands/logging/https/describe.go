@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, https)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", https.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", https.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", https.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, kafka)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", kafka.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", kafka.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", kafka.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, kinesis)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", kinesis.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", kinesis.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", kinesis.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, logentries)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", logentries.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", logentries.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", logentries.Name)
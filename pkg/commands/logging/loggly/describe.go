@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, loggly)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", loggly.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", loggly.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", loggly.Name)
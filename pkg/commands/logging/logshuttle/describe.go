@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, logshuttle)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", logshuttle.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", logshuttle.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", logshuttle.Name)
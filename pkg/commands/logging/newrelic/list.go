@@ -68,6 +68,10 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, l)
+	}
+
 	if c.Globals.Verbose() {
 		c.printVerbose(out, serviceID, serviceVersion.Number, l)
 	} else {
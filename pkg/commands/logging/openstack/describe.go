@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, openstack)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", openstack.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", openstack.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", openstack.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, papertrail)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", papertrail.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", papertrail.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", papertrail.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, s3)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", s3.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", s3.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", s3.Name)
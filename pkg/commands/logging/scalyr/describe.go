@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, scalyr)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", scalyr.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", scalyr.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", scalyr.Name)
@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, sftp)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", sftp.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", sftp.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", sftp.Name)
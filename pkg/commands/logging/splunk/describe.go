@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, splunk)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", splunk.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", splunk.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", splunk.Name)
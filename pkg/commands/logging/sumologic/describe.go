@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, sumologic)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", sumologic.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", sumologic.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", sumologic.Name)
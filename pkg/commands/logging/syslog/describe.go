@@ -61,6 +61,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, syslog)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", syslog.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", syslog.ServiceVersion)
 	fmt.Fprintf(out, "Name: %s\n", syslog.Name)
@@ -128,7 +128,11 @@ func (c *TailCommand) Exec(in io.Reader, out io.Writer) error {
 	c.doneCh = make(chan struct{})
 
 	c.hClient = http.DefaultClient
-	c.token, _ = c.Globals.Token()
+	token, _, err := c.Globals.Token()
+	if err != nil {
+		return err
+	}
+	c.token = token
 
 	// Adjust the from/to times if they are
 	// defined. We adjust the times based on searchPadding.
@@ -0,0 +1,111 @@
+package pool
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// poolTypes is the set of pool types supported by the Fastly API.
+var poolTypes = []string{
+	string(fastly.PoolTypeRandom),
+	string(fastly.PoolTypeHash),
+	string(fastly.PoolTypeClient),
+}
+
+// CreateCommand calls the Fastly API to create pools.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreatePoolInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// The type flag is validated against a fixed set of values, so it's stored
+	// as a plain string and converted to go-fastly's PoolType later, as are
+	// the boolean flags which become a `Compatibool`.
+	Type         string
+	UseTLS       bool
+	TLSCheckCert bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a load-balancing pool on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Pool name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("type", "How the pool selects a server (random, hash, client)").EnumVar(&c.Type, poolTypes...)
+	c.CmdClause.Flag("comment", "A descriptive note").StringVar(&c.Input.Comment)
+	c.CmdClause.Flag("quorum", "Percentage of capacity that needs to be up for the pool to be considered up").UintVar(&c.Input.Quorum)
+	c.CmdClause.Flag("healthcheck", "The name of the healthcheck to use with this pool").StringVar(&c.Input.Healthcheck)
+	c.CmdClause.Flag("shield", "The shield POP designated to reduce inbound load on this pool by serving the cached data to the rest of the network").StringVar(&c.Input.Shield)
+	c.CmdClause.Flag("request-condition", "Condition, which if met, will select this pool during a request").StringVar(&c.Input.RequestCondition)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").StringVar(&c.Input.OverrideHost)
+	c.CmdClause.Flag("max-conn-default", "Maximum number of connections for servers that don't set their own").UintVar(&c.Input.MaxConnDefault)
+	c.CmdClause.Flag("connect-timeout", "How long to wait for a timeout in milliseconds").UintVar(&c.Input.ConnectTimeout)
+	c.CmdClause.Flag("first-byte-timeout", "How long to wait for the first bytes in milliseconds").UintVar(&c.Input.FirstByteTimeout)
+	c.CmdClause.Flag("use-tls", "Whether or not to use TLS to reach the servers").BoolVar(&c.UseTLS)
+	c.CmdClause.Flag("tls-check-cert", "Be strict on checking TLS certs").BoolVar(&c.TLSCheckCert)
+	c.CmdClause.Flag("tls-ca-cert", "CA certificate attached to the servers").StringVar(&c.Input.TLSCACert)
+	c.CmdClause.Flag("tls-client-cert", "Client certificate attached to the servers").StringVar(&c.Input.TLSClientCert)
+	c.CmdClause.Flag("tls-client-key", "Client key attached to the servers").StringVar(&c.Input.TLSClientKey)
+	c.CmdClause.Flag("tls-cert-hostname", "The hostname used to verify a server's certificate").StringVar(&c.Input.TLSCertHostname)
+	c.CmdClause.Flag("tls-sni-hostname", "The hostname sent in the SNI portion of the TLS handshake").StringVar(&c.Input.TLSSNIHostname)
+	c.CmdClause.Flag("min-tls-version", "Minimum allowed TLS version on connections to this pool").StringVar(&c.Input.MinTLSVersion)
+	c.CmdClause.Flag("max-tls-version", "Maximum allowed TLS version on connections to this pool").StringVar(&c.Input.MaxTLSVersion)
+	c.CmdClause.Flag("tls-ciphers", "List of OpenSSL ciphers (see https://www.openssl.org/docs/man1.0.2/man1/ciphers for details)").StringVar(&c.Input.TLSCiphers)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Type = fastly.PoolType(c.Type)
+	c.Input.UseTLS = fastly.Compatibool(c.UseTLS)
+	c.Input.TLSCheckCert = fastly.Compatibool(c.TLSCheckCert)
+
+	p, err := c.Globals.Client.CreatePool(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created pool %s (service %s version %d)", p.Name, p.ServiceID, p.ServiceVersion)
+	return nil
+}
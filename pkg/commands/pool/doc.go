@@ -0,0 +1,3 @@
+// Package pool contains commands to inspect and manipulate Fastly service
+// load-balancing pools.
+package pool
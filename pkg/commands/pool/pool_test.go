@@ -0,0 +1,391 @@
+package pool_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestPoolCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("pool create --service-id 123 --version 3 --name origin --type round-robin"),
+			WantError: "error parsing arguments: enum value must be one of random,hash,client, got 'round-robin'",
+		},
+		{
+			Args: args("pool create --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("pool create --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreatePoolFn:   createPoolError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool create --service-id 123 --version 1 --name origin --type hash --quorum 50 --healthcheck check --use-tls --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreatePoolFn:   createPoolOK,
+			},
+			WantOutput: "Created pool origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("pool list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsOK,
+			},
+			WantOutput: listPoolsShortOutput,
+		},
+		{
+			Args: args("pool list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsOK,
+			},
+			WantOutput: listPoolsVerboseOutput,
+		},
+		{
+			Args: args("pool list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestPoolDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("pool describe --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool describe --service-id 123 --version 1 --name origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+			},
+			WantOutput: describePoolOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestPoolUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool update --service-id 123 --version 3 --quorum 50"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("pool update --service-id 123 --version 1 --name origin --type client --tls-check-cert --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdatePoolFn:   updatePoolError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool update --service-id 123 --version 1 --name origin --type client --tls-check-cert --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdatePoolFn:   updatePoolOK,
+			},
+			WantOutput: "Updated pool origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("pool delete --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeletePoolFn:   deletePoolError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool delete --service-id 123 --version 1 --name origin --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeletePoolFn:   deletePoolOK,
+			},
+			WantOutput: "Deleted pool origin (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createPoolOK(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	if i.Type != fastly.PoolTypeHash || i.Quorum != 50 || i.Healthcheck != "check" || !i.UseTLS || i.TLSCheckCert {
+		return nil, errTest
+	}
+	return &fastly.Pool{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createPoolError(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	return nil, errTest
+}
+
+func listPoolsOK(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return []*fastly.Pool{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			ID:             "abc",
+			Name:           "origin",
+			Type:           fastly.PoolTypeRandom,
+			Quorum:         75,
+			Healthcheck:    "check",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			ID:             "def",
+			Name:           "sticky",
+			Type:           fastly.PoolTypeClient,
+			Quorum:         50,
+			Healthcheck:    "deep-check",
+			UseTLS:         true,
+			TLSCheckCert:   true,
+			TLSSNIHostname: "origin.example.com",
+		},
+	}, nil
+}
+
+func listPoolsError(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return nil, errTest
+}
+
+var listPoolsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME    ID   TYPE    QUORUM  HEALTHCHECK
+123      1        origin  abc  random  75      check
+123      1        sticky  def  client  50      deep-check
+`) + "\n"
+
+var listPoolsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Pool 1/2",
+	"		ID: abc",
+	"		Name: origin",
+	"		Comment: ",
+	"		Type: random",
+	"		Quorum: 75",
+	"		Healthcheck: check",
+	"		Shield: ",
+	"		Request condition: ",
+	"		Override host: ",
+	"		Max connections default: 0",
+	"		Connect timeout: 0",
+	"		First byte timeout: 0",
+	"		Use TLS: false",
+	"		TLS check cert: false",
+	"		TLS CA cert: ",
+	"		TLS client cert: ",
+	"		TLS client key: ",
+	"		TLS cert hostname: ",
+	"		TLS SNI hostname: ",
+	"		Min TLS version: ",
+	"		Max TLS version: ",
+	"		TLS ciphers: ",
+	"	Pool 2/2",
+	"		ID: def",
+	"		Name: sticky",
+	"		Comment: ",
+	"		Type: client",
+	"		Quorum: 50",
+	"		Healthcheck: deep-check",
+	"		Shield: ",
+	"		Request condition: ",
+	"		Override host: ",
+	"		Max connections default: 0",
+	"		Connect timeout: 0",
+	"		First byte timeout: 0",
+	"		Use TLS: true",
+	"		TLS check cert: true",
+	"		TLS CA cert: ",
+	"		TLS client cert: ",
+	"		TLS client key: ",
+	"		TLS cert hostname: ",
+	"		TLS SNI hostname: origin.example.com",
+	"		Min TLS version: ",
+	"		Max TLS version: ",
+	"		TLS ciphers: ",
+}, "\n") + "\n\n"
+
+func getPoolOK(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return &fastly.Pool{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		ID:               "abc",
+		Name:             i.Name,
+		Type:             fastly.PoolTypeHash,
+		Quorum:           75,
+		Healthcheck:      "check",
+		Comment:          "primary pool",
+		MaxConnDefault:   200,
+		ConnectTimeout:   1000,
+		FirstByteTimeout: 15000,
+	}, nil
+}
+
+func getPoolError(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return nil, errTest
+}
+
+var describePoolOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"ID: abc",
+	"Name: origin",
+	"Comment: primary pool",
+	"Type: hash",
+	"Quorum: 75",
+	"Healthcheck: check",
+	"Shield: ",
+	"Request condition: ",
+	"Override host: ",
+	"Max connections default: 200",
+	"Connect timeout: 1000",
+	"First byte timeout: 15000",
+	"Use TLS: false",
+	"TLS check cert: false",
+	"TLS CA cert: ",
+	"TLS client cert: ",
+	"TLS client key: ",
+	"TLS cert hostname: ",
+	"TLS SNI hostname: ",
+	"Min TLS version: ",
+	"Max TLS version: ",
+	"TLS ciphers: ",
+}, "\n") + "\n"
+
+func updatePoolOK(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	if i.Type == nil || *i.Type != fastly.PoolTypeClient || i.TLSCheckCert == nil || !*i.TLSCheckCert || i.NewName != nil || i.Quorum != nil || i.UseTLS != nil {
+		return nil, errTest
+	}
+	return &fastly.Pool{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Type:           *i.Type,
+	}, nil
+}
+
+func updatePoolError(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	return nil, errTest
+}
+
+func deletePoolOK(i *fastly.DeletePoolInput) error {
+	return nil
+}
+
+func deletePoolError(i *fastly.DeletePoolInput) error {
+	return errTest
+}
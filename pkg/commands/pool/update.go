@@ -0,0 +1,201 @@
+package pool
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update pools.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdatePoolInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName          cmd.OptionalString
+	Type             cmd.OptionalString
+	Comment          cmd.OptionalString
+	Quorum           cmd.OptionalUint
+	Healthcheck      cmd.OptionalString
+	Shield           cmd.OptionalString
+	RequestCondition cmd.OptionalString
+	OverrideHost     cmd.OptionalString
+	MaxConnDefault   cmd.OptionalUint
+	ConnectTimeout   cmd.OptionalUint
+	FirstByteTimeout cmd.OptionalUint
+	UseTLS           cmd.OptionalBool
+	TLSCheckCert     cmd.OptionalBool
+	TLSCACert        cmd.OptionalString
+	TLSClientCert    cmd.OptionalString
+	TLSClientKey     cmd.OptionalString
+	TLSCertHostname  cmd.OptionalString
+	TLSSNIHostname   cmd.OptionalString
+	MinTLSVersion    cmd.OptionalString
+	MaxTLSVersion    cmd.OptionalString
+	TLSCiphers       cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a load-balancing pool on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Pool name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New pool name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("type", "How the pool selects a server (random, hash, client)").Action(c.Type.Set).EnumVar(&c.Type.Value, poolTypes...)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	c.CmdClause.Flag("quorum", "Percentage of capacity that needs to be up for the pool to be considered up").Action(c.Quorum.Set).UintVar(&c.Quorum.Value)
+	c.CmdClause.Flag("healthcheck", "The name of the healthcheck to use with this pool").Action(c.Healthcheck.Set).StringVar(&c.Healthcheck.Value)
+	c.CmdClause.Flag("shield", "The shield POP designated to reduce inbound load on this pool by serving the cached data to the rest of the network").Action(c.Shield.Set).StringVar(&c.Shield.Value)
+	c.CmdClause.Flag("request-condition", "Condition, which if met, will select this pool during a request").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.OverrideHost.Set).StringVar(&c.OverrideHost.Value)
+	c.CmdClause.Flag("max-conn-default", "Maximum number of connections for servers that don't set their own").Action(c.MaxConnDefault.Set).UintVar(&c.MaxConnDefault.Value)
+	c.CmdClause.Flag("connect-timeout", "How long to wait for a timeout in milliseconds").Action(c.ConnectTimeout.Set).UintVar(&c.ConnectTimeout.Value)
+	c.CmdClause.Flag("first-byte-timeout", "How long to wait for the first bytes in milliseconds").Action(c.FirstByteTimeout.Set).UintVar(&c.FirstByteTimeout.Value)
+	c.CmdClause.Flag("use-tls", "Whether or not to use TLS to reach the servers").Action(c.UseTLS.Set).BoolVar(&c.UseTLS.Value)
+	c.CmdClause.Flag("tls-check-cert", "Be strict on checking TLS certs").Action(c.TLSCheckCert.Set).BoolVar(&c.TLSCheckCert.Value)
+	c.CmdClause.Flag("tls-ca-cert", "CA certificate attached to the servers").Action(c.TLSCACert.Set).StringVar(&c.TLSCACert.Value)
+	c.CmdClause.Flag("tls-client-cert", "Client certificate attached to the servers").Action(c.TLSClientCert.Set).StringVar(&c.TLSClientCert.Value)
+	c.CmdClause.Flag("tls-client-key", "Client key attached to the servers").Action(c.TLSClientKey.Set).StringVar(&c.TLSClientKey.Value)
+	c.CmdClause.Flag("tls-cert-hostname", "The hostname used to verify a server's certificate").Action(c.TLSCertHostname.Set).StringVar(&c.TLSCertHostname.Value)
+	c.CmdClause.Flag("tls-sni-hostname", "The hostname sent in the SNI portion of the TLS handshake").Action(c.TLSSNIHostname.Set).StringVar(&c.TLSSNIHostname.Value)
+	c.CmdClause.Flag("min-tls-version", "Minimum allowed TLS version on connections to this pool").Action(c.MinTLSVersion.Set).StringVar(&c.MinTLSVersion.Value)
+	c.CmdClause.Flag("max-tls-version", "Maximum allowed TLS version on connections to this pool").Action(c.MaxTLSVersion.Set).StringVar(&c.MaxTLSVersion.Value)
+	c.CmdClause.Flag("tls-ciphers", "List of OpenSSL ciphers (see https://www.openssl.org/docs/man1.0.2/man1/ciphers for details)").Action(c.TLSCiphers.Set).StringVar(&c.TLSCiphers.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Type.WasSet {
+		c.input.Type = fastly.PPoolType(fastly.PoolType(c.Type.Value))
+	}
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	if c.Quorum.WasSet {
+		c.input.Quorum = fastly.Uint(c.Quorum.Value)
+	}
+
+	if c.Healthcheck.WasSet {
+		c.input.Healthcheck = fastly.String(c.Healthcheck.Value)
+	}
+
+	if c.Shield.WasSet {
+		c.input.Shield = fastly.String(c.Shield.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	if c.OverrideHost.WasSet {
+		c.input.OverrideHost = fastly.String(c.OverrideHost.Value)
+	}
+
+	if c.MaxConnDefault.WasSet {
+		c.input.MaxConnDefault = fastly.Uint(c.MaxConnDefault.Value)
+	}
+
+	if c.ConnectTimeout.WasSet {
+		c.input.ConnectTimeout = fastly.Uint(c.ConnectTimeout.Value)
+	}
+
+	if c.FirstByteTimeout.WasSet {
+		c.input.FirstByteTimeout = fastly.Uint(c.FirstByteTimeout.Value)
+	}
+
+	if c.UseTLS.WasSet {
+		c.input.UseTLS = fastly.CBool(c.UseTLS.Value)
+	}
+
+	if c.TLSCheckCert.WasSet {
+		c.input.TLSCheckCert = fastly.CBool(c.TLSCheckCert.Value)
+	}
+
+	if c.TLSCACert.WasSet {
+		c.input.TLSCACert = fastly.String(c.TLSCACert.Value)
+	}
+
+	if c.TLSClientCert.WasSet {
+		c.input.TLSClientCert = fastly.String(c.TLSClientCert.Value)
+	}
+
+	if c.TLSClientKey.WasSet {
+		c.input.TLSClientKey = fastly.String(c.TLSClientKey.Value)
+	}
+
+	if c.TLSCertHostname.WasSet {
+		c.input.TLSCertHostname = fastly.String(c.TLSCertHostname.Value)
+	}
+
+	if c.TLSSNIHostname.WasSet {
+		c.input.TLSSNIHostname = fastly.String(c.TLSSNIHostname.Value)
+	}
+
+	if c.MinTLSVersion.WasSet {
+		c.input.MinTLSVersion = fastly.String(c.MinTLSVersion.Value)
+	}
+
+	if c.MaxTLSVersion.WasSet {
+		c.input.MaxTLSVersion = fastly.String(c.MaxTLSVersion.Value)
+	}
+
+	if c.TLSCiphers.WasSet {
+		c.input.TLSCiphers = fastly.String(c.TLSCiphers.Value)
+	}
+
+	p, err := c.Globals.Client.UpdatePool(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated pool %s (service %s version %d)", p.Name, p.ServiceID, p.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,56 @@
+package poolserver
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// AddCommand calls the Fastly API to add a server to a pool.
+type AddCommand struct {
+	cmd.Base
+	poolFlags
+	Input fastly.CreateServerInput
+}
+
+// NewAddCommand returns a usable command registered under the parent.
+func NewAddCommand(parent cmd.Registerer, globals *config.Data) *AddCommand {
+	var c AddCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("add", "Add a server to a load-balancing pool").Alias("create")
+	c.poolFlags.register(&c.Base)
+	c.CmdClause.Flag("address", "A hostname, IPv4, or IPv6 address for the server").Required().StringVar(&c.Input.Address)
+	c.CmdClause.Flag("port", "Port number of the address").UintVar(&c.Input.Port)
+	c.CmdClause.Flag("weight", "Weight (1-100) used to load balance this server against others").UintVar(&c.Input.Weight)
+	c.CmdClause.Flag("max-conn", "Maximum number of connections. Uses the pool's default if unset").UintVar(&c.Input.MaxConn)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").StringVar(&c.Input.OverrideHost)
+	c.CmdClause.Flag("disabled", "Add the server without sending it any traffic").BoolVar(&c.Input.Disabled)
+	c.CmdClause.Flag("comment", "A descriptive note").StringVar(&c.Input.Comment)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *AddCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, pool, err := c.resolve(c.Globals, out)
+	if err != nil {
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.PoolID = pool.ID
+
+	s, err := c.Globals.Client.CreateServer(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Pool ID":    pool.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Added server %s (ID %s) to pool %s (service %s)", s.Address, s.ID, pool.Name, s.ServiceID)
+	return nil
+}
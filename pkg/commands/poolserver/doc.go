@@ -0,0 +1,7 @@
+// Package poolserver contains commands to inspect and manipulate the servers
+// that belong to Fastly service load-balancing pools.
+//
+// Unlike pools, servers aren't versioned: they're attached to a pool's ID and
+// changes to them take effect immediately. The --version flag accepted by the
+// commands in this package is therefore only used to look up the pool by name.
+package poolserver
@@ -0,0 +1,70 @@
+package poolserver
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list the servers in a pool.
+type ListCommand struct {
+	cmd.Base
+	poolFlags
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List the servers in a load-balancing pool")
+	c.poolFlags.register(&c.Base)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, pool, err := c.resolve(c.Globals, out)
+	if err != nil {
+		return err
+	}
+
+	servers, err := c.Globals.Client.ListServers(&fastly.ListServersInput{
+		ServiceID: serviceID,
+		PoolID:    pool.ID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Pool ID":    pool.ID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, servers)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "ADDRESS", "PORT", "WEIGHT", "MAX CONN", "DISABLED")
+		for _, server := range servers {
+			tw.AddLine(server.ID, server.Address, server.Port, server.Weight, server.MaxConn, server.Disabled)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", serviceID)
+	fmt.Fprintf(out, "Pool: %s (ID %s)\n", pool.Name, pool.ID)
+	for i, server := range servers {
+		fmt.Fprintf(out, "\tServer %d/%d\n", i+1, len(servers))
+		text.PrintServer(out, "\t\t", server)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package poolserver
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// poolFlags are the flags shared by every command in this package which
+// identify the pool whose servers are being manipulated.
+type poolFlags struct {
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	pool           string
+}
+
+// register defines the --service-id, --version and --pool flags on the given
+// command. The --version flag is optional and defaults to the latest version.
+func (p *poolFlags) register(c *cmd.Base) {
+	p.manifest.File.SetOutput(c.Globals.Output)
+	p.manifest.File.Read(manifest.Filename)
+	c.RegisterServiceIDFlag(&p.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst:      &p.serviceVersion.Value,
+		Optional: true,
+		Action:   p.serviceVersion.Set,
+	})
+	c.CmdClause.Flag("pool", "Name of the pool").Short('p').Required().StringVar(&p.pool)
+}
+
+// resolve returns the Service ID and the ID of the named pool.
+//
+// Pools are versioned but servers are not, so there's no need for the service
+// version to be editable and it's never cloned.
+func (p *poolFlags) resolve(globals *config.Data, out io.Writer) (serviceID string, pool *fastly.Pool, err error) {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             globals.Client,
+		Manifest:           p.manifest,
+		Out:                out,
+		ServiceVersionFlag: p.serviceVersion,
+		VerboseMode:        globals.Flag.Verbose,
+	})
+	if err != nil {
+		globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return serviceID, nil, err
+	}
+
+	pool, err = globals.Client.GetPool(&fastly.GetPoolInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+		Name:           p.pool,
+	})
+	if err != nil {
+		globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+			"Pool":            p.pool,
+		})
+		return serviceID, nil, err
+	}
+
+	return serviceID, pool, nil
+}
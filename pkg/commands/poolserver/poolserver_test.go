@@ -0,0 +1,295 @@
+package poolserver_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestPoolServerAdd(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool server add --service-id 123 --address 10.0.0.1"),
+			WantError: "error parsing arguments: required flag --pool not provided",
+		},
+		{
+			Args: args("pool server add --service-id 123 --pool origin --address 10.0.0.1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool server add --service-id 123 --pool origin --address 10.0.0.1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				CreateServerFn: createServerError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			// The active version is locked, but servers aren't versioned so
+			// there's no need for it to be editable.
+			Args: args("pool server add --service-id 123 --version active --pool origin --address 10.0.0.1 --weight 50 --max-conn 100"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				CreateServerFn: createServerOK,
+			},
+			WantOutput: "Added server 10.0.0.1 (ID srv1) to pool origin (service 123)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolServerList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("pool server list --service-id 123 --pool origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				ListServersFn:  listServersOK,
+			},
+			WantOutput: listServersShortOutput,
+		},
+		{
+			Args: args("pool server list --service-id 123 --pool origin --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				ListServersFn:  listServersOK,
+			},
+			WantOutput: listServersVerboseOutput,
+		},
+		{
+			Args: args("pool server list --service-id 123 --pool origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				ListServersFn:  listServersError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestPoolServerUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool server update --service-id 123 --pool origin --weight 10"),
+			WantError: "error parsing arguments: required flag --server not provided",
+		},
+		{
+			Args: args("pool server update --service-id 123 --pool origin --server srv1 --weight 10 --disabled"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				UpdateServerFn: updateServerError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool server update --service-id 123 --pool origin --server srv1 --weight 10 --disabled"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				UpdateServerFn: updateServerOK,
+			},
+			WantOutput: "Updated server srv1 in pool origin (service 123)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolServerRemove(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool server remove --service-id 123 --pool origin"),
+			WantError: "error parsing arguments: required flag --server not provided",
+		},
+		{
+			Args: args("pool server remove --service-id 123 --pool origin --server srv1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				DeleteServerFn: deleteServerError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool server remove --service-id 123 --pool origin --server srv1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn:      getPoolOK,
+				DeleteServerFn: deleteServerOK,
+			},
+			WantOutput: "Removed server srv1 from pool origin (service 123)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func getPoolOK(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return &fastly.Pool{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		ID:             "pool1",
+		Name:           i.Name,
+	}, nil
+}
+
+func getPoolError(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return nil, errTest
+}
+
+func createServerOK(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	if i.PoolID != "pool1" || i.Weight != 50 || i.MaxConn != 100 {
+		return nil, errTest
+	}
+	return &fastly.Server{
+		ServiceID: i.ServiceID,
+		PoolID:    i.PoolID,
+		ID:        "srv1",
+		Address:   i.Address,
+	}, nil
+}
+
+func createServerError(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	return nil, errTest
+}
+
+func listServersOK(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return []*fastly.Server{
+		{
+			ServiceID: i.ServiceID,
+			PoolID:    i.PoolID,
+			ID:        "srv1",
+			Address:   "10.0.0.1",
+			Port:      80,
+			Weight:    100,
+			MaxConn:   200,
+		},
+		{
+			ServiceID:    i.ServiceID,
+			PoolID:       i.PoolID,
+			ID:           "srv2",
+			Address:      "10.0.0.2",
+			Port:         443,
+			Weight:       50,
+			MaxConn:      100,
+			OverrideHost: "origin.example.com",
+			Disabled:     true,
+			Comment:      "draining",
+		},
+	}, nil
+}
+
+func listServersError(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return nil, errTest
+}
+
+var listServersShortOutput = strings.TrimSpace(`
+ID    ADDRESS   PORT  WEIGHT  MAX CONN  DISABLED
+srv1  10.0.0.1  80    100     200       false
+srv2  10.0.0.2  443   50      100       true
+`) + "\n"
+
+var listServersVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Pool: origin (ID pool1)",
+	"	Server 1/2",
+	"		ID: srv1",
+	"		Address: 10.0.0.1",
+	"		Port: 80",
+	"		Weight: 100",
+	"		Max connections: 200",
+	"		Override host: ",
+	"		Disabled: false",
+	"		Comment: ",
+	"	Server 2/2",
+	"		ID: srv2",
+	"		Address: 10.0.0.2",
+	"		Port: 443",
+	"		Weight: 50",
+	"		Max connections: 100",
+	"		Override host: origin.example.com",
+	"		Disabled: true",
+	"		Comment: draining",
+}, "\n") + "\n\n"
+
+func updateServerOK(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	if i.PoolID != "pool1" || i.Weight == nil || *i.Weight != 10 || i.Disabled == nil || !*i.Disabled || i.Address != nil {
+		return nil, errTest
+	}
+	return &fastly.Server{
+		ServiceID: i.ServiceID,
+		PoolID:    i.PoolID,
+		ID:        i.Server,
+	}, nil
+}
+
+func updateServerError(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	return nil, errTest
+}
+
+func deleteServerOK(i *fastly.DeleteServerInput) error {
+	return nil
+}
+
+func deleteServerError(i *fastly.DeleteServerInput) error {
+	return errTest
+}
@@ -0,0 +1,50 @@
+package poolserver
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// RemoveCommand calls the Fastly API to remove a server from a pool.
+type RemoveCommand struct {
+	cmd.Base
+	poolFlags
+	Input fastly.DeleteServerInput
+}
+
+// NewRemoveCommand returns a usable command registered under the parent.
+func NewRemoveCommand(parent cmd.Registerer, globals *config.Data) *RemoveCommand {
+	var c RemoveCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("remove", "Remove a server from a load-balancing pool").Alias("delete")
+	c.poolFlags.register(&c.Base)
+	c.CmdClause.Flag("server", "ID of the server").Required().StringVar(&c.Input.Server)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *RemoveCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, pool, err := c.resolve(c.Globals, out)
+	if err != nil {
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.PoolID = pool.ID
+
+	if err := c.Globals.Client.DeleteServer(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Pool ID":    pool.ID,
+			"Server ID":  c.Input.Server,
+		})
+		return err
+	}
+
+	text.Success(out, "Removed server %s from pool %s (service %s)", c.Input.Server, pool.Name, serviceID)
+	return nil
+}
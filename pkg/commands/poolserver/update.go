@@ -0,0 +1,94 @@
+package poolserver
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a server in a pool.
+type UpdateCommand struct {
+	cmd.Base
+	poolFlags
+	input fastly.UpdateServerInput
+
+	Address      cmd.OptionalString
+	Port         cmd.OptionalUint
+	Weight       cmd.OptionalUint
+	MaxConn      cmd.OptionalUint
+	OverrideHost cmd.OptionalString
+	Disabled     cmd.OptionalBool
+	Comment      cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update a server in a load-balancing pool")
+	c.poolFlags.register(&c.Base)
+	c.CmdClause.Flag("server", "ID of the server").Required().StringVar(&c.input.Server)
+	c.CmdClause.Flag("address", "A hostname, IPv4, or IPv6 address for the server").Action(c.Address.Set).StringVar(&c.Address.Value)
+	c.CmdClause.Flag("port", "Port number of the address").Action(c.Port.Set).UintVar(&c.Port.Value)
+	c.CmdClause.Flag("weight", "Weight (1-100) used to load balance this server against others").Action(c.Weight.Set).UintVar(&c.Weight.Value)
+	c.CmdClause.Flag("max-conn", "Maximum number of connections. Uses the pool's default if unset").Action(c.MaxConn.Set).UintVar(&c.MaxConn.Value)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.OverrideHost.Set).StringVar(&c.OverrideHost.Value)
+	c.CmdClause.Flag("disabled", "Stop sending the server any traffic").Action(c.Disabled.Set).BoolVar(&c.Disabled.Value)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, pool, err := c.resolve(c.Globals, out)
+	if err != nil {
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.PoolID = pool.ID
+
+	if c.Address.WasSet {
+		c.input.Address = fastly.String(c.Address.Value)
+	}
+
+	if c.Port.WasSet {
+		c.input.Port = fastly.Uint(c.Port.Value)
+	}
+
+	if c.Weight.WasSet {
+		c.input.Weight = fastly.Uint(c.Weight.Value)
+	}
+
+	if c.MaxConn.WasSet {
+		c.input.MaxConn = fastly.Uint(c.MaxConn.Value)
+	}
+
+	if c.OverrideHost.WasSet {
+		c.input.OverrideHost = fastly.String(c.OverrideHost.Value)
+	}
+
+	if c.Disabled.WasSet {
+		c.input.Disabled = fastly.Bool(c.Disabled.Value)
+	}
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	s, err := c.Globals.Client.UpdateServer(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Pool ID":    pool.ID,
+			"Server ID":  c.input.Server,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated server %s in pool %s (service %s)", s.ID, pool.Name, s.ServiceID)
+	return nil
+}
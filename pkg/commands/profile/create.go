@@ -0,0 +1,91 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CreateCommand creates a new credential profile.
+type CreateCommand struct {
+	cmd.Base
+
+	clientFactory  APIClientFactory
+	configFilePath string
+	def            bool
+	name           string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, configFilePath string, cf APIClientFactory, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.clientFactory = cf
+	c.CmdClause = parent.Command("create", "Create a credential profile").Alias("add")
+	c.CmdClause.Flag("name", "Profile name").Short('n').Required().StringVar(&c.name)
+	c.CmdClause.Flag("default", "Make the profile the default").BoolVar(&c.def)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if _, ok := c.Globals.File.Profiles[c.name]; ok {
+		err := fmt.Errorf("profile '%s' already exists", c.name)
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	token, err := promptToken(c.Globals, in, out)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	endpoint := config.DefaultEndpoint
+	if e, source := c.Globals.Endpoint(); source == config.SourceFlag || source == config.SourceEnvironment {
+		endpoint = e
+	}
+
+	progress := text.NewQuietProgress(out)
+	defer func() {
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			progress.Fail() // progress.Done is handled inline
+		}
+	}()
+
+	progress.Step("Validating token...")
+
+	email, err := validateToken(c.clientFactory, token, endpoint)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Persisting configuration...")
+
+	if c.Globals.File.Profiles == nil {
+		c.Globals.File.Profiles = make(map[string]*config.Profile)
+	}
+	c.Globals.File.Profiles[c.name] = &config.Profile{
+		APIEndpoint: endpoint,
+		Email:       email,
+		Token:       token,
+	}
+
+	// The first profile created is always made the default.
+	if c.def || c.Globals.File.DefaultProfile() == "" {
+		c.Globals.File.SetDefaultProfile(c.name)
+	}
+
+	if err := persist(&c.Globals.File, c.configFilePath); err != nil {
+		return err
+	}
+
+	progress.Done()
+	text.Success(out, "Created profile '%s' (%s)", c.name, email)
+	return nil
+}
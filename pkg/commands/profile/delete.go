@@ -0,0 +1,50 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DeleteCommand deletes a credential profile.
+type DeleteCommand struct {
+	cmd.Base
+
+	configFilePath string
+	name           string
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("delete", "Delete a credential profile").Alias("remove")
+	c.CmdClause.Flag("name", "Profile name").Short('n').Required().StringVar(&c.name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	p, ok := c.Globals.File.Profiles[c.name]
+	if !ok {
+		err := notFound(c.name)
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	delete(c.Globals.File.Profiles, c.name)
+
+	if err := persist(&c.Globals.File, c.configFilePath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Deleted profile '%s'", c.name)
+	if p.Default && len(c.Globals.File.Profiles) > 0 {
+		text.Warning(out, "The default profile was deleted. Run `fastly profile use --name <name>` to set a new default.")
+	}
+	return nil
+}
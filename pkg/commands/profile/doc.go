@@ -0,0 +1,3 @@
+// Package profile contains commands to manage named credential profiles
+// stored in the CLI application configuration file.
+package profile
@@ -0,0 +1,73 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ListCommand lists the available credential profiles.
+type ListCommand struct {
+	cmd.Base
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List credential profiles")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	if len(c.Globals.File.Profiles) == 0 {
+		text.Info(out, "No profiles defined. To create a profile, run `fastly profile create --name <name>`.")
+		return nil
+	}
+
+	names := make([]string, 0, len(c.Globals.File.Profiles))
+	for name := range c.Globals.File.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	selected, _ := c.Globals.Profile()
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("NAME", "DEFAULT", "SELECTED", "EMAIL", "ENDPOINT")
+		for _, name := range names {
+			p := c.Globals.File.Profiles[name]
+			tw.AddLine(name, p.Default, name == selected, p.Email, endpoint(p))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, name := range names {
+		p := c.Globals.File.Profiles[name]
+		fmt.Fprintf(out, "Profile %d/%d\n", i+1, len(names))
+		fmt.Fprintf(out, "\tName: %s\n", name)
+		fmt.Fprintf(out, "\tDefault: %t\n", p.Default)
+		fmt.Fprintf(out, "\tSelected: %t\n", name == selected)
+		fmt.Fprintf(out, "\tEmail: %s\n", p.Email)
+		fmt.Fprintf(out, "\tEndpoint: %s\n", endpoint(p))
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
+
+// endpoint returns the API endpoint of the profile, falling back to the
+// default endpoint when none was recorded.
+func endpoint(p *config.Profile) string {
+	if p.APIEndpoint == "" {
+		return config.DefaultEndpoint
+	}
+	return p.APIEndpoint
+}
@@ -0,0 +1,199 @@
+package profile_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
+)
+
+func TestProfile(t *testing.T) {
+	var (
+		goodToken = func() (*fastly.Token, error) { return &fastly.Token{}, nil }
+		badToken  = func() (*fastly.Token, error) { return nil, errors.New("bad token") }
+		goodUser  = func(*fastly.GetUserInput) (*fastly.User, error) {
+			return &fastly.User{
+				Login: "test@example.com",
+			}, nil
+		}
+		args = testutil.Args
+	)
+
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		file         config.File
+		api          mock.API
+		stdin        string
+		wantError    string
+		wantOutput   []string
+		wantProfiles map[string]*config.Profile
+	}{
+		{
+			name: "create first profile becomes default",
+			args: args("profile create --name prod --token abc"),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{
+				"Validating token...",
+				"Persisting configuration...",
+				"Created profile 'prod' (test@example.com)",
+			},
+			wantProfiles: map[string]*config.Profile{
+				"prod": {APIEndpoint: config.DefaultEndpoint, Default: true, Email: "test@example.com", Token: "abc"},
+			},
+		},
+		{
+			name:  "create profile with interactive token",
+			args:  args("profile create --name staging"),
+			stdin: "xyz\n",
+			file:  fileWithProfiles(),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{
+				"Fastly API token: ",
+				"Created profile 'staging' (test@example.com)",
+			},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {Email: "sandbox@example.com", Token: "456"},
+				"staging": {APIEndpoint: config.DefaultEndpoint, Email: "test@example.com", Token: "xyz"},
+			},
+		},
+		{
+			name:      "create existing profile",
+			args:      args("profile create --name prod --token abc"),
+			file:      fileWithProfiles(),
+			wantError: "profile 'prod' already exists",
+		},
+		{
+			name: "create profile with invalid token",
+			args: args("profile create --name prod --token abc"),
+			api: mock.API{
+				GetTokenSelfFn: badToken,
+			},
+			wantError: "error validating token: bad token",
+		},
+		{
+			name: "list profiles",
+			args: args("profile list"),
+			file: fileWithProfiles(),
+			wantOutput: []string{
+				"NAME     DEFAULT  SELECTED  EMAIL                ENDPOINT",
+				"prod     true     true      prod@example.com     https://api.fastly.com",
+				"sandbox  false    false     sandbox@example.com  https://api.fastly.com",
+			},
+		},
+		{
+			name:       "list profiles with none defined",
+			args:       args("profile list"),
+			wantOutput: []string{"No profiles defined."},
+		},
+		{
+			name:       "use profile",
+			args:       args("profile use --name sandbox"),
+			file:       fileWithProfiles(),
+			wantOutput: []string{"Profile 'sandbox' is now the default"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Email: "prod@example.com", Token: "123"},
+				"sandbox": {Default: true, Email: "sandbox@example.com", Token: "456"},
+			},
+		},
+		{
+			name:      "use unknown profile",
+			args:      args("profile use --name unknown"),
+			file:      fileWithProfiles(),
+			wantError: "profile 'unknown' does not exist",
+		},
+		{
+			name: "update profile",
+			args: args("profile update --name sandbox --token abc"),
+			file: fileWithProfiles(),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{"Updated profile 'sandbox' (test@example.com)"},
+			wantProfiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {APIEndpoint: config.DefaultEndpoint, Email: "test@example.com", Token: "abc"},
+			},
+		},
+		{
+			name: "update encrypted profile without passphrase",
+			args: args("profile update --name sandbox --token abc"),
+			file: config.File{Profiles: map[string]*config.Profile{
+				"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+				"sandbox": {Email: "sandbox@example.com", EncryptedToken: "v1:c2FuZGJveA"},
+			}},
+			wantError: "the stored API token is encrypted and no passphrase was provided",
+		},
+		{
+			name:       "delete default profile",
+			args:       args("profile delete --name prod"),
+			file:       fileWithProfiles(),
+			wantOutput: []string{"Deleted profile 'prod'", "The default profile was deleted."},
+			wantProfiles: map[string]*config.Profile{
+				"sandbox": {Email: "sandbox@example.com", Token: "456"},
+			},
+		},
+		{
+			name:      "delete unknown profile",
+			args:      args("profile delete --name unknown"),
+			file:      fileWithProfiles(),
+			wantError: "profile 'unknown' does not exist",
+		},
+		{
+			name:      "global flag selecting an unknown profile",
+			args:      args("service list --profile unknown"),
+			file:      fileWithProfiles(),
+			wantError: "profile 'unknown' does not exist",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantProfiles != nil {
+				p, err := os.ReadFile(configFilePath)
+				testutil.AssertNoError(t, err)
+				var f config.File
+				testutil.AssertNoError(t, toml.Unmarshal(p, &f))
+				testutil.AssertEqual(t, testcase.wantProfiles, f.Profiles)
+			}
+		})
+	}
+}
+
+func fileWithProfiles() config.File {
+	return config.File{
+		Profiles: map[string]*config.Profile{
+			"prod":    {Default: true, Email: "prod@example.com", Token: "123"},
+			"sandbox": {Email: "sandbox@example.com", Token: "456"},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/env"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ErrEmptyToken is returned when a user tries to supply an empty string as a
+// token for a profile.
+var ErrEmptyToken = errors.New("token cannot be empty")
+
+// promptToken returns the token explicitly provided by the user via --token or
+// the environment, otherwise the token is taken interactively.
+func promptToken(globals *config.Data, in io.Reader, out io.Writer) (string, error) {
+	token, source, _ := globals.Token()
+	switch source {
+	case config.SourceFlag:
+		text.Output(out, "Fastly API token provided via --token")
+		return token, nil
+	case config.SourceEnvironment:
+		text.Output(out, "Fastly API token provided via %s", env.Token)
+		return token, nil
+	}
+
+	text.Output(out, `
+		An API token is used to authenticate requests to the Fastly API.
+		To create a token, visit https://manage.fastly.com/account/personal/tokens
+	`)
+	text.Break(out)
+	token, err := text.InputSecure(out, "Fastly API token: ", in, validateTokenNotEmpty)
+	if err != nil {
+		return "", err
+	}
+	text.Break(out)
+	return token, nil
+}
+
+// validateToken ensures the token is valid and returns the email address of
+// the user the token belongs to.
+func validateToken(cf APIClientFactory, token, endpoint string) (string, error) {
+	client, err := cf(token, endpoint)
+	if err != nil {
+		return "", fmt.Errorf("error regenerating Fastly API client: %w", err)
+	}
+	t, err := client.GetTokenSelf()
+	if err != nil {
+		return "", fmt.Errorf("error validating token: %w", err)
+	}
+	user, err := client.GetUser(&fastly.GetUserInput{
+		ID: t.UserID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error fetching token user: %w", err)
+	}
+	return user.Login, nil
+}
+
+// persist writes the in-memory configuration back to disk, ensuring the config
+// file directory exists first.
+func persist(f *config.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), config.DirectoryPermissions); err != nil {
+		return fmt.Errorf("error creating config file directory: %w", err)
+	}
+	if err := f.Write(path); err != nil {
+		return fmt.Errorf("error saving config file: %w", err)
+	}
+	return nil
+}
+
+// notFound returns an error for a profile that doesn't exist.
+func notFound(name string) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("profile '%s' does not exist", name),
+		Remediation: fsterr.ProfileRemediation,
+	}
+}
+
+func validateTokenNotEmpty(s string) error {
+	if s == "" {
+		return ErrEmptyToken
+	}
+	return nil
+}
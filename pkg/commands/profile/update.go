@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand replaces the token of an existing credential profile. The
+// email stored with the profile is taken from the user the new token belongs
+// to, and the endpoint is only replaced when one is given explicitly.
+type UpdateCommand struct {
+	cmd.Base
+
+	clientFactory  APIClientFactory
+	configFilePath string
+	name           string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, configFilePath string, cf APIClientFactory, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.clientFactory = cf
+	c.CmdClause = parent.Command("update", "Replace the token of a credential profile")
+	c.CmdClause.Flag("name", "Profile name").Short('n').Required().StringVar(&c.name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	p, ok := c.Globals.File.Profiles[c.name]
+	if !ok {
+		err := notFound(c.name)
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	passphrase, err := c.Globals.Passphrase(p.EncryptedToken)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	token, err := promptToken(c.Globals, in, out)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	// Only replace the endpoint stored with the profile if one was explicitly
+	// provided by the user.
+	endpoint := endpoint(p)
+	if e, source := c.Globals.Endpoint(); source == config.SourceFlag || source == config.SourceEnvironment {
+		endpoint = e
+	}
+
+	progress := text.NewQuietProgress(out)
+	defer func() {
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			progress.Fail() // progress.Done is handled inline
+		}
+	}()
+
+	progress.Step("Validating token...")
+
+	email, err := validateToken(c.clientFactory, token, endpoint)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Persisting configuration...")
+
+	p.APIEndpoint = endpoint
+	p.Email = email
+	p.Token, p.EncryptedToken, err = config.SealToken(token, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := persist(&c.Globals.File, c.configFilePath); err != nil {
+		return err
+	}
+
+	progress.Done()
+	text.Success(out, "Updated profile '%s' (%s)", c.name, email)
+	return nil
+}
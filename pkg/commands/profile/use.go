@@ -0,0 +1,46 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UseCommand sets the default credential profile.
+type UseCommand struct {
+	cmd.Base
+
+	configFilePath string
+	name           string
+}
+
+// NewUseCommand returns a usable command registered under the parent.
+func NewUseCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *UseCommand {
+	var c UseCommand
+	c.Globals = globals
+	c.configFilePath = configFilePath
+	c.CmdClause = parent.Command("use", "Set the default credential profile").Alias("switch")
+	c.CmdClause.Flag("name", "Profile name").Short('n').Required().StringVar(&c.name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UseCommand) Exec(in io.Reader, out io.Writer) error {
+	if _, ok := c.Globals.File.Profiles[c.name]; !ok {
+		err := notFound(c.name)
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	c.Globals.File.SetDefaultProfile(c.name)
+
+	if err := persist(&c.Globals.File, c.configFilePath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Profile '%s' is now the default", c.name)
+	return nil
+}
@@ -0,0 +1,358 @@
+package requestsetting_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestRequestSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("request-setting create --service-id 123 --version 3 --force-miss"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("request-setting create --service-id 123 --version 3 --name foo --xff replace"),
+			WantError: "error parsing arguments: enum value must be one of clear,leave,append,append_all,overwrite, got 'replace'",
+		},
+		{
+			Args: args("request-setting create --service-id 123 --version 1 --name foo"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("request-setting create --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("request-setting create --service-id 123 --version 1 --name foo --force-miss --xff append --hash-keys req.url,req.http.host --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingOK,
+			},
+			WantOutput: "Created request setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("request-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			WantOutput: listRequestSettingsShortOutput,
+		},
+		{
+			Args: args("request-setting list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			WantOutput: listRequestSettingsVerboseOutput,
+		},
+		{
+			Args: args("request-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRequestSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("request-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("request-setting describe --service-id 123 --version 1 --name default"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("request-setting describe --service-id 123 --version 1 --name default"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingOK,
+			},
+			WantOutput: describeRequestSettingOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRequestSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("request-setting update --service-id 123 --version 3 --force-miss"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("request-setting update --service-id 123 --version 1 --name foo --bypass-busy-wait --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("request-setting update --service-id 123 --version 1 --name foo --bypass-busy-wait --default-host example.com --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingOK,
+			},
+			WantOutput: "Updated request setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("request-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("request-setting delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("request-setting delete --service-id 123 --version 1 --name foo --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingOK,
+			},
+			WantOutput: "Deleted request setting foo (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createRequestSettingOK(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if !bool(i.ForceMiss) || bool(i.ForceSSL) || i.XForwardedFor != fastly.RequestSettingXFFAppend || i.HashKeys != "req.url,req.http.host" {
+		return nil, errTest
+	}
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createRequestSettingError(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func listRequestSettingsOK(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return []*fastly.RequestSetting{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "api",
+			Action:           fastly.RequestSettingActionPass,
+			XForwardedFor:    fastly.RequestSettingXFFAppend,
+			DefaultHost:      "api.example.com",
+			RequestCondition: "api-request",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "default",
+			Action:           fastly.RequestSettingActionLookup,
+			ForceMiss:        true,
+			XForwardedFor:    fastly.RequestSettingXFFLeave,
+			DefaultHost:      "www.example.com",
+			RequestCondition: "always",
+		},
+	}, nil
+}
+
+func listRequestSettingsError(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+var listRequestSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME     ACTION  FORCE MISS  XFF     DEFAULT HOST     REQUEST CONDITION
+123      1        api      pass    false       append  api.example.com  api-request
+123      1        default  lookup  true        leave   www.example.com  always
+`) + "\n"
+
+var listRequestSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Request setting 1/2",
+	"		Name: api",
+	"		Action: pass",
+	"		Force miss: false",
+	"		Force SSL: false",
+	"		Bypass busy wait: false",
+	"		Max stale age: 0",
+	"		Hash keys: ",
+	"		X-Forwarded-For: append",
+	"		Timer support: false",
+	"		Geo headers: false",
+	"		Default host: api.example.com",
+	"		Request condition: api-request",
+	"	Request setting 2/2",
+	"		Name: default",
+	"		Action: lookup",
+	"		Force miss: true",
+	"		Force SSL: false",
+	"		Bypass busy wait: false",
+	"		Max stale age: 0",
+	"		Hash keys: ",
+	"		X-Forwarded-For: leave",
+	"		Timer support: false",
+	"		Geo headers: false",
+	"		Default host: www.example.com",
+	"		Request condition: always",
+}, "\n") + "\n\n"
+
+func getRequestSettingOK(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         fastly.RequestSettingActionLookup,
+		ForceSSL:       true,
+		MaxStaleAge:    60,
+		HashKeys:       "req.url,req.http.host",
+		XForwardedFor:  fastly.RequestSettingXFFOverwrite,
+		GeoHeaders:     true,
+	}, nil
+}
+
+func getRequestSettingError(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+var describeRequestSettingOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: default",
+	"Action: lookup",
+	"Force miss: false",
+	"Force SSL: true",
+	"Bypass busy wait: false",
+	"Max stale age: 60",
+	"Hash keys: req.url,req.http.host",
+	"X-Forwarded-For: overwrite",
+	"Timer support: false",
+	"Geo headers: true",
+	"Default host: ",
+	"Request condition: ",
+}, "\n") + "\n"
+
+func updateRequestSettingOK(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if i.ForceMiss != nil || i.Action != "" || i.XForwardedFor != "" {
+		return nil, errTest
+	}
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		BypassBusyWait: bool(*i.BypassBusyWait),
+		DefaultHost:    *i.DefaultHost,
+	}, nil
+}
+
+func updateRequestSettingError(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func deleteRequestSettingOK(i *fastly.DeleteRequestSettingInput) error {
+	return nil
+}
+
+func deleteRequestSettingError(i *fastly.DeleteRequestSettingInput) error {
+	return errTest
+}
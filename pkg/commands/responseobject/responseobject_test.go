@@ -0,0 +1,344 @@
+package responseobject_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestResponseObjectCreate(t *testing.T) {
+	var content string
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("response-object create --service-id 123 --version 3 --status 503"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("response-object create --service-id 123 --version 1 --name maintenance"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("response-object create --service-id 123 --version 1 --name maintenance --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: createResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("response-object create --service-id 123 --version 1 --name maintenance --status 503 --response Unavailable --content ./testdata/maintenance.html --content-type text/html --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: func(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+					// Track the contents parsed
+					content = i.Content
+
+					return &fastly.ResponseObject{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           i.Name,
+						Status:         i.Status,
+					}, nil
+				},
+			},
+			WantOutput: "Created response object maintenance (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+	testutil.AssertString(t, "<html><body>Down for maintenance</body></html>\n", content)
+}
+
+func TestResponseObjectList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("response-object list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			WantOutput: listResponseObjectsShortOutput,
+		},
+		{
+			Args: args("response-object list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			WantOutput: listResponseObjectsVerboseOutput,
+		},
+		{
+			Args: args("response-object list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestResponseObjectDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("response-object describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("response-object describe --service-id 123 --version 1 --name robots"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("response-object describe --service-id 123 --version 1 --name robots"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectOK,
+			},
+			WantOutput: describeResponseObjectOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestResponseObjectUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("response-object update --service-id 123 --version 3 --status 200"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("response-object update --service-id 123 --version 1 --name robots --status 200 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("response-object update --service-id 123 --version 1 --name robots --content User-agent:* --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectOK,
+			},
+			WantOutput: "Updated response object robots (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("response-object delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("response-object delete --service-id 123 --version 1 --name robots --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("response-object delete --service-id 123 --version 1 --name robots --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectOK,
+			},
+			WantOutput: "Deleted response object robots (service 123 version 4)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createResponseObjectError(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func listResponseObjectsOK(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return []*fastly.ResponseObject{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "maintenance",
+			Status:           503,
+			Response:         "Service Unavailable",
+			Content:          "<html></html>",
+			ContentType:      "text/html",
+			RequestCondition: "maintenance-mode",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "robots",
+			Status:           200,
+			Response:         "OK",
+			Content:          "User-agent: *\nDisallow: /",
+			ContentType:      "text/plain",
+			RequestCondition: "robots-txt",
+		},
+	}, nil
+}
+
+func listResponseObjectsError(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+var listResponseObjectsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME         STATUS  RESPONSE             CONTENT TYPE  REQUEST CONDITION
+123      1        maintenance  503     Service Unavailable  text/html     maintenance-mode
+123      1        robots       200     OK                   text/plain    robots-txt
+`) + "\n"
+
+var listResponseObjectsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Response object 1/2",
+	"		Name: maintenance",
+	"		Status: 503",
+	"		Response: Service Unavailable",
+	"		Content type: text/html",
+	"		Request condition: maintenance-mode",
+	"		Cache condition: ",
+	"		Content: ",
+	"		<html></html>",
+	"	Response object 2/2",
+	"		Name: robots",
+	"		Status: 200",
+	"		Response: OK",
+	"		Content type: text/plain",
+	"		Request condition: robots-txt",
+	"		Cache condition: ",
+	"		Content: ",
+	"		User-agent: *",
+	"		Disallow: /",
+}, "\n") + "\n\n"
+
+func getResponseObjectOK(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		Name:             i.Name,
+		Status:           200,
+		Response:         "OK",
+		Content:          "User-agent: *\nDisallow: /",
+		ContentType:      "text/plain",
+		RequestCondition: "robots-txt",
+	}, nil
+}
+
+func getResponseObjectError(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+var describeResponseObjectOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: robots",
+	"Status: 200",
+	"Response: OK",
+	"Content type: text/plain",
+	"Request condition: robots-txt",
+	"Cache condition: ",
+	"Content: ",
+	"User-agent: *",
+	"Disallow: /",
+}, "\n") + "\n"
+
+func updateResponseObjectOK(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	if i.Status != nil || i.Response != nil || *i.Content != "User-agent:*" {
+		return nil, errTest
+	}
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Content:        *i.Content,
+	}, nil
+}
+
+func updateResponseObjectError(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func deleteResponseObjectOK(i *fastly.DeleteResponseObjectInput) error {
+	return nil
+}
+
+func deleteResponseObjectError(i *fastly.DeleteResponseObjectInput) error {
+	return errTest
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/undo"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ApplyCommand reconciles a service with a service definition file by cloning
+// a service version and making the required changes to the clone.
+type ApplyCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	file           string
+	activate       bool
+	guard          cmd.ActiveVersionGuard
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewApplyCommand returns a usable command registered under the parent.
+func NewApplyCommand(parent cmd.Registerer, globals *config.Data) *ApplyCommand {
+	var c ApplyCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("apply", "Clone a service version and apply the changes described by a service definition file")
+
+	// Required flags
+	c.CmdClause.Flag("file", "Path to a service definition file, as written by 'fastly service export'").Short('f').Required().StringVar(&c.file)
+
+	// Optional flags
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Action:   c.serviceVersion.Set,
+		Dst:      &c.serviceVersion.Value,
+		Optional: true,
+	})
+	c.CmdClause.Flag("activate", "Activate the new service version once the changes are applied").BoolVar(&c.activate)
+	c.CmdClause.Flag("force", "With --activate, activate even if another version was activated while the command was running").BoolVar(&c.guard.Force)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ApplyCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	desired, err := readDefinition(c.file, &c.manifest, &c.serviceVersion)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	current, err := definition.Fetch(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	plan := definition.NewPlan(current, desired)
+	if plan.Empty() {
+		text.Info(out, "No changes to apply. Service %s version %d matches %s.", serviceID, serviceVersion.Number, c.file)
+		return nil
+	}
+	if err := plan.Validate(); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	printPlan(out, serviceID, serviceVersion.Number, plan)
+	text.Break(out)
+
+	var progress text.Progress
+	if c.Globals.Verbose() {
+		progress = text.NewVerboseProgress(out)
+	} else {
+		progress = text.NewQuietProgress(out)
+	}
+
+	undoStack := undo.NewStack()
+	defer func() {
+		if err != nil {
+			progress.Fail()
+		}
+		undoStack.RunIfError(out, err)
+	}()
+
+	progress.Step(fmt.Sprintf("Cloning service version %d...", serviceVersion.Number))
+	if c.activate {
+		if err := c.guard.RecordBase(c.Globals.Client, serviceID, serviceVersion); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return err
+		}
+	}
+	version, err := c.Globals.Client.CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return fmt.Errorf("error cloning service version: %w", err)
+	}
+
+	err = plan.Apply(c.Globals.Client, serviceID, version.Number, progress, undoStack)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File":            c.file,
+			"Service ID":      serviceID,
+			"Service Version": version.Number,
+		})
+		return err
+	}
+
+	if c.activate {
+		progress.Step(fmt.Sprintf("Activating service version %d...", version.Number))
+		if err = c.guard.Check(c.Globals.Client); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": version.Number,
+			})
+			return err
+		}
+		_, err = c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version.Number,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": version.Number,
+			})
+			return fmt.Errorf("error activating service version: %w", err)
+		}
+	}
+
+	progress.Done()
+
+	if c.activate {
+		text.Success(out, "Applied %d changes to service %s and activated version %d", len(plan.Changes), serviceID, version.Number)
+	} else {
+		text.Success(out, "Applied %d changes to service %s version %d", len(plan.Changes), serviceID, version.Number)
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/definition"
+)
+
+// deepService is the full resource tree of a service version, as displayed
+// by `fastly service describe --deep`.
+type deepService struct {
+	ID              string                   `json:"id"`
+	Name            string                   `json:"name"`
+	Type            string                   `json:"type"`
+	Version         int                      `json:"version"`
+	Active          bool                     `json:"active"`
+	Domains         []string                 `json:"domains"`
+	Conditions      []deepCondition          `json:"conditions"`
+	Backends        []deepBackend            `json:"backends"`
+	Healthchecks    []deepHealthcheck        `json:"unattached_healthchecks"`
+	Directors       []deepDirector           `json:"directors"`
+	Pools           []deepPool               `json:"pools"`
+	Dictionaries    []deepDictionary         `json:"dictionaries"`
+	ACLs            []deepACL                `json:"acls"`
+	VCLs            []deepVCL                `json:"vcls"`
+	Snippets        map[string][]deepSnippet `json:"snippets"`
+	Headers         []string                 `json:"headers"`
+	CacheSettings   []string                 `json:"cache_settings"`
+	RequestSettings []string                 `json:"request_settings"`
+	ResponseObjects []string                 `json:"response_objects"`
+	Gzips           []string                 `json:"gzips"`
+	Logging         map[string][]string      `json:"logging"`
+}
+
+type deepCondition struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+type deepBackend struct {
+	Name        string           `json:"name"`
+	Address     string           `json:"address,omitempty"`
+	Port        int64            `json:"port,omitempty"`
+	Healthcheck *deepHealthcheck `json:"healthcheck,omitempty"`
+}
+
+type deepHealthcheck struct {
+	Name   string `json:"name"`
+	Method string `json:"method,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+type deepDirector struct {
+	Name     string   `json:"name"`
+	Backends []string `json:"backends"`
+}
+
+type deepPool struct {
+	Name    string `json:"name"`
+	Servers int    `json:"servers"`
+}
+
+type deepDictionary struct {
+	Name      string `json:"name"`
+	Items     int    `json:"items"`
+	WriteOnly bool   `json:"write_only,omitempty"`
+}
+
+type deepACL struct {
+	Name    string `json:"name"`
+	Entries int    `json:"entries"`
+}
+
+type deepVCL struct {
+	Name string `json:"name"`
+	Main bool   `json:"main,omitempty"`
+}
+
+type deepSnippet struct {
+	Name    string `json:"name"`
+	Dynamic bool   `json:"dynamic,omitempty"`
+}
+
+// newDeepService summarises the resources of a service version. Backends are
+// shown with the healthcheck they use, so only the healthchecks which no
+// backend uses are listed separately.
+func newDeepService(s *definition.Service) *deepService {
+	d := &deepService{
+		ID:              s.ServiceID,
+		Version:         s.Version,
+		Domains:         names(s.Domains),
+		Conditions:      []deepCondition{},
+		Backends:        []deepBackend{},
+		Healthchecks:    []deepHealthcheck{},
+		Directors:       []deepDirector{},
+		Pools:           []deepPool{},
+		Dictionaries:    []deepDictionary{},
+		ACLs:            []deepACL{},
+		VCLs:            []deepVCL{},
+		Snippets:        make(map[string][]deepSnippet),
+		Headers:         names(s.Headers),
+		CacheSettings:   names(s.CacheSettings),
+		RequestSettings: names(s.RequestSettings),
+		ResponseObjects: names(s.ResponseObjects),
+		Gzips:           names(s.Gzips),
+		Logging:         make(map[string][]string),
+	}
+
+	for _, r := range s.Conditions {
+		d.Conditions = append(d.Conditions, deepCondition{Name: r.Name(), Type: str(r, "type")})
+	}
+
+	healthchecks := make(map[string]deepHealthcheck)
+	for _, r := range s.Healthchecks {
+		healthchecks[r.Name()] = deepHealthcheck{Name: r.Name(), Method: str(r, "method"), Host: str(r, "host"), Path: str(r, "path")}
+	}
+	attached := make(map[string]bool)
+	for _, r := range s.Backends {
+		b := deepBackend{Name: r.Name(), Address: str(r, "address")}
+		b.Port, _ = r["port"].(int64)
+		if name := str(r, "healthcheck"); name != "" {
+			h, ok := healthchecks[name]
+			if !ok {
+				h = deepHealthcheck{Name: name}
+			}
+			b.Healthcheck = &h
+			attached[name] = true
+		}
+		d.Backends = append(d.Backends, b)
+	}
+	for _, r := range s.Healthchecks {
+		if !attached[r.Name()] {
+			d.Healthchecks = append(d.Healthchecks, healthchecks[r.Name()])
+		}
+	}
+
+	for _, r := range s.Directors {
+		backends := s.DirectorBackends[r.Name()]
+		if backends == nil {
+			backends = []string{}
+		}
+		d.Directors = append(d.Directors, deepDirector{Name: r.Name(), Backends: backends})
+	}
+	for _, r := range s.Pools {
+		d.Pools = append(d.Pools, deepPool{Name: r.Name(), Servers: len(s.PoolServers[r.Name()])})
+	}
+
+	for _, dict := range s.Dictionaries {
+		d.Dictionaries = append(d.Dictionaries, deepDictionary{Name: dict.Name, Items: len(dict.Items), WriteOnly: dict.WriteOnly})
+	}
+	for _, a := range s.ACLs {
+		d.ACLs = append(d.ACLs, deepACL{Name: a.Name, Entries: len(a.Entries)})
+	}
+	for _, r := range s.VCLs {
+		d.VCLs = append(d.VCLs, deepVCL{Name: r.Name(), Main: r["main"] == true})
+	}
+	for _, r := range s.Snippets {
+		t := str(r, "type")
+		dynamic, _ := r["dynamic"].(int64)
+		d.Snippets[t] = append(d.Snippets[t], deepSnippet{Name: r.Name(), Dynamic: dynamic == 1})
+	}
+	for _, k := range definition.LoggingKinds {
+		for _, r := range s.Logging[k.Name] {
+			d.Logging[k.Name] = append(d.Logging[k.Name], r.Name())
+		}
+	}
+	return d
+}
+
+// tree arranges the resources into nodes for display. Groups of the resource
+// types which only VCL services use are omitted when they're empty.
+func (d *deepService) tree() []*node {
+	domains := group("Domains", len(d.Domains))
+	for _, name := range d.Domains {
+		domains.add(name)
+	}
+
+	var nodes []*node
+	nodes = append(nodes, domains)
+	if len(d.Conditions) > 0 {
+		conditions := group("Conditions", len(d.Conditions))
+		for _, c := range d.Conditions {
+			label := c.Name
+			if c.Type != "" {
+				label += fmt.Sprintf(" (%s)", strings.ToLower(c.Type))
+			}
+			conditions.add(label)
+		}
+		nodes = append(nodes, conditions)
+	}
+
+	backends := group("Backends", len(d.Backends))
+	for _, b := range d.Backends {
+		n := backends.add(b.Name)
+		if b.Address != "" {
+			n.label += fmt.Sprintf(" (%s)", joinHostPort(b.Address, b.Port))
+		}
+		if b.Healthcheck != nil {
+			n.add("healthcheck " + b.Healthcheck.describe())
+		}
+	}
+
+	nodes = append(nodes, backends)
+	if len(d.Healthchecks) > 0 {
+		healthchecks := group("Unattached healthchecks", len(d.Healthchecks))
+		for _, h := range d.Healthchecks {
+			healthchecks.add(h.describe())
+		}
+		nodes = append(nodes, healthchecks)
+	}
+	if len(d.Directors) > 0 {
+		directors := group("Directors", len(d.Directors))
+		for _, dir := range d.Directors {
+			n := directors.add(fmt.Sprintf("%s (%s)", dir.Name, plural(len(dir.Backends), "backend")))
+			for _, b := range dir.Backends {
+				n.add(b)
+			}
+		}
+		nodes = append(nodes, directors)
+	}
+	if len(d.Pools) > 0 {
+		pools := group("Pools", len(d.Pools))
+		for _, p := range d.Pools {
+			pools.add(fmt.Sprintf("%s (%s)", p.Name, plural(p.Servers, "server")))
+		}
+		nodes = append(nodes, pools)
+	}
+
+	dictionaries := group("Dictionaries", len(d.Dictionaries))
+	for _, dict := range d.Dictionaries {
+		label := fmt.Sprintf("%s (%s)", dict.Name, plural(dict.Items, "item"))
+		if dict.WriteOnly {
+			label = fmt.Sprintf("%s (%s, write-only)", dict.Name, plural(dict.Items, "item"))
+		}
+		dictionaries.add(label)
+	}
+
+	acls := group("ACLs", len(d.ACLs))
+	for _, a := range d.ACLs {
+		acls.add(fmt.Sprintf("%s (%s)", a.Name, plural(a.Entries, "entry")))
+	}
+
+	vcls := group("VCLs", len(d.VCLs))
+	for _, v := range d.VCLs {
+		label := v.Name
+		if v.Main {
+			label += " (main)"
+		}
+		vcls.add(label)
+	}
+
+	var snippetCount int
+	for _, ss := range d.Snippets {
+		snippetCount += len(ss)
+	}
+	snippets := group("Snippets", snippetCount)
+	for _, t := range sortedKeys(d.Snippets) {
+		n := group(t, len(d.Snippets[t]))
+		for _, s := range d.Snippets[t] {
+			label := s.Name
+			if s.Dynamic {
+				label += " (dynamic)"
+			}
+			n.add(label)
+		}
+		snippets.children = append(snippets.children, n)
+	}
+
+	var loggingCount int
+	for _, names := range d.Logging {
+		loggingCount += len(names)
+	}
+	logging := group("Logging endpoints", loggingCount)
+	for _, k := range definition.LoggingKinds {
+		names, ok := d.Logging[k.Name]
+		if !ok {
+			continue
+		}
+		n := group(k.Name, len(names))
+		for _, name := range names {
+			n.add(name)
+		}
+		logging.children = append(logging.children, n)
+	}
+
+	nodes = append(nodes, dictionaries, acls, vcls, snippets)
+	for _, g := range []struct {
+		label string
+		names []string
+	}{
+		{"Headers", d.Headers},
+		{"Cache settings", d.CacheSettings},
+		{"Request settings", d.RequestSettings},
+		{"Response objects", d.ResponseObjects},
+		{"Gzip configurations", d.Gzips},
+	} {
+		if len(g.names) == 0 {
+			continue
+		}
+		n := group(g.label, len(g.names))
+		for _, name := range g.names {
+			n.add(name)
+		}
+		nodes = append(nodes, n)
+	}
+	return append(nodes, logging)
+}
+
+func (h deepHealthcheck) describe() string {
+	var parts []string
+	for _, p := range []string{h.Method, h.Host + h.Path} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if len(parts) == 0 {
+		return h.Name
+	}
+	return fmt.Sprintf("%s (%s)", h.Name, strings.Join(parts, " "))
+}
+
+// node is an entry in a tree, rendered with box drawing characters.
+type node struct {
+	label    string
+	children []*node
+}
+
+func group(label string, count int) *node {
+	return &node{label: fmt.Sprintf("%s (%d)", label, count)}
+}
+
+func (n *node) add(label string) *node {
+	child := &node{label: label}
+	n.children = append(n.children, child)
+	return child
+}
+
+// printTree writes the nodes and their descendants, each line starting with
+// prefix.
+func printTree(out io.Writer, nodes []*node, prefix string) {
+	for i, n := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, n.label)
+		printTree(out, n.children, prefix+indent)
+	}
+}
+
+func names(rs []definition.Resource) []string {
+	names := make([]string, 0, len(rs))
+	for _, r := range rs {
+		names = append(names, r.Name())
+	}
+	return names
+}
+
+func str(r definition.Resource, key string) string {
+	s, _ := r[key].(string)
+	return s
+}
+
+func joinHostPort(host string, port int64) string {
+	if port == 0 {
+		return host
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	if strings.HasSuffix(noun, "y") {
+		return fmt.Sprintf("%d %sies", n, strings.TrimSuffix(noun, "y"))
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func sortedKeys(m map[string][]deepSnippet) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
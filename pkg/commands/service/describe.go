@@ -1,11 +1,13 @@
 package service
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/compute/manifest"
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v3/fastly"
@@ -14,8 +16,10 @@ import (
 // DescribeCommand calls the Fastly API to describe a service.
 type DescribeCommand struct {
 	cmd.Base
-	manifest manifest.Data
-	Input    fastly.GetServiceInput
+	manifest       manifest.Data
+	Input          fastly.GetServiceInput
+	deep           bool
+	serviceVersion cmd.OptionalServiceVersion
 }
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -26,6 +30,12 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCo
 	c.manifest.File.Read(manifest.Filename)
 	c.CmdClause = parent.Command("describe", "Show detailed information about a Fastly service").Alias("get")
 	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("deep", "Show every resource attached to a service version, defaulting to the active version").BoolVar(&c.deep)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Action:   c.serviceVersion.Set,
+		Dst:      &c.serviceVersion.Value,
+		Optional: true,
+	})
 	return &c
 }
 
@@ -37,6 +47,16 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 	}
 	c.Input.ID = serviceID
 
+	if c.deep {
+		return c.describeDeep(serviceID, out)
+	}
+	if c.serviceVersion.WasSet {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("--version can only be used with --deep"),
+			Remediation: "Run `fastly service describe --deep --version <version>` to show the resources of a version.",
+		}
+	}
+
 	service, err := c.Globals.Client.GetServiceDetails(&c.Input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
@@ -45,6 +65,108 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, service)
+	}
+
 	text.PrintServiceDetail(out, "", service)
 	return nil
 }
+
+// describeDeep displays the resources of a service version as a tree. The
+// service details are fetched alongside the resources when the version is
+// known up front.
+func (c *DescribeCommand) describeDeep(serviceID string, out io.Writer) error {
+	type detailResult struct {
+		service *fastly.ServiceDetail
+		err     error
+	}
+	details := make(chan detailResult, 1)
+	getDetails := func() {
+		service, err := c.Globals.Client.GetServiceDetails(&c.Input)
+		details <- detailResult{service, err}
+	}
+
+	var (
+		version int
+		service *fastly.ServiceDetail
+	)
+	if c.serviceVersion.WasSet {
+		go getDetails()
+		v, err := c.serviceVersion.Parse(serviceID, c.Globals.Client)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": c.serviceVersion.Value,
+			})
+			return err
+		}
+		version = v.Number
+	} else {
+		getDetails()
+		r := <-details
+		if r.err != nil {
+			c.Globals.ErrLog.AddWithContext(r.err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return r.err
+		}
+		service = r.service
+		version = latestVersion(service)
+		if service.ActiveVersion.Number > 0 {
+			version = service.ActiveVersion.Number
+		}
+	}
+
+	def, err := definition.Fetch(c.Globals.Client, serviceID, version)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	if service == nil {
+		r := <-details
+		if r.err != nil {
+			c.Globals.ErrLog.AddWithContext(r.err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return r.err
+		}
+		service = r.service
+	}
+
+	d := newDeepService(def)
+	d.Name = service.Name
+	d.Type = service.Type
+	d.Active = service.ActiveVersion.Number == version
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, d)
+	}
+
+	fmt.Fprintf(out, "Service: %s (%s)\n", d.Name, d.ID)
+	if d.Type != "" {
+		fmt.Fprintf(out, "Type: %s\n", d.Type)
+	}
+	if d.Active {
+		fmt.Fprintf(out, "Version: %d (active)\n", d.Version)
+	} else {
+		fmt.Fprintf(out, "Version: %d\n", d.Version)
+	}
+	printTree(out, d.tree(), "")
+	return nil
+}
+
+// latestVersion returns the number of the service's most recent version.
+func latestVersion(s *fastly.ServiceDetail) int {
+	version := s.Version.Number
+	for _, v := range s.Versions {
+		if v.Number > version {
+			version = v.Number
+		}
+	}
+	return version
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ExportCommand calls the Fastly API to export every resource attached to a
+// service version as a single declarative document.
+type ExportCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	output         string
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent cmd.Registerer, globals *config.Data) *ExportCommand {
+	var c ExportCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("export", "Export the configuration of a Fastly service version to a TOML or JSON file")
+
+	// Required flags
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("output", "Path of the file to write (format is inferred from a .toml or .json extension, defaults to stdout)").Short('o').StringVar(&c.output)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	svc, err := definition.Fetch(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+	svc.Redact()
+
+	format := definition.FormatTOML
+	if c.output != "" {
+		format = definition.FormatFromPath(c.output)
+	} else if c.Globals.JSON() {
+		format = definition.FormatJSON
+	}
+
+	var buf bytes.Buffer
+	if err := svc.Encode(&buf, format); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encoding service definition: %w", err)
+	}
+
+	if c.output == "" {
+		_, err := buf.WriteTo(out)
+		return err
+	}
+
+	if err := os.WriteFile(c.output, buf.Bytes(), 0644); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error writing service definition: %w", err)
+	}
+
+	text.Success(out, "Exported service %s version %d to %s", serviceID, serviceVersion.Number, c.output)
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/lint"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// LintCommand checks the configuration of a service version for common
+// problems.
+type LintCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	disable        []string
+	listRules      bool
+}
+
+// NewLintCommand returns a usable command registered under the parent.
+func NewLintCommand(parent cmd.Registerer, globals *config.Data) *LintCommand {
+	var c LintCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("lint", "Check the configuration of a Fastly service version for common problems, failing if any errors are found")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Action:   c.serviceVersion.Set,
+		Dst:      &c.serviceVersion.Value,
+		Optional: true,
+	})
+	c.CmdClause.Flag("disable", "Name of a rule to skip. Can be given multiple times").StringsVar(&c.disable)
+	c.CmdClause.Flag("list-rules", "List the available rules instead of checking a service").BoolVar(&c.listRules)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *LintCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.listRules {
+		return c.printRules(out)
+	}
+
+	rules, err := lint.Select(lint.Rules, c.disable)
+	if err != nil {
+		return errors.RemediationError{
+			Inner:       err,
+			Remediation: "Run `fastly service lint --list-rules` to list the available rules.",
+		}
+	}
+
+	// Lint the version serving traffic unless told otherwise.
+	if !c.serviceVersion.WasSet {
+		c.serviceVersion.Value = "active"
+	}
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	svc, err := definition.Fetch(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	findings := lint.Run(svc, rules)
+
+	if c.Globals.JSON() {
+		if findings == nil {
+			findings = []lint.Finding{}
+		}
+		if err := c.WriteJSON(out, struct {
+			ServiceID string         `json:"service_id"`
+			Version   int            `json:"version"`
+			Findings  []lint.Finding `json:"findings"`
+		}{serviceID, serviceVersion.Number, findings}); err != nil {
+			return err
+		}
+	} else {
+		c.printFindings(out, serviceID, serviceVersion.Number, findings)
+	}
+
+	if n := lint.Count(findings, lint.Error); n > 0 {
+		return fmt.Errorf("service %s version %d has %d lint error(s)", serviceID, serviceVersion.Number, n)
+	}
+	return nil
+}
+
+func (c *LintCommand) printFindings(out io.Writer, serviceID string, version int, findings []lint.Finding) {
+	if len(findings) == 0 {
+		text.Success(out, "No problems found in service %s version %d", serviceID, version)
+		return
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("SEVERITY", "RULE", "RESOURCE", "MESSAGE")
+	for _, f := range findings {
+		tw.AddLine(strings.ToUpper(string(f.Severity)), f.Rule, f.Resource, f.Message)
+	}
+	tw.Print()
+	text.Break(out)
+	text.Output(out, "%d error(s), %d warning(s), %d info.", lint.Count(findings, lint.Error), lint.Count(findings, lint.Warning), lint.Count(findings, lint.Info))
+}
+
+func (c *LintCommand) printRules(out io.Writer) error {
+	if c.Globals.JSON() {
+		type rule struct {
+			Name        string        `json:"name"`
+			Severity    lint.Severity `json:"severity"`
+			Description string        `json:"description"`
+		}
+		rules := make([]rule, 0, len(lint.Rules))
+		for _, r := range lint.Rules {
+			rules = append(rules, rule{r.Name, r.Severity, r.Description})
+		}
+		return c.WriteJSON(out, rules)
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("RULE", "SEVERITY", "DESCRIPTION")
+	for _, r := range lint.Rules {
+		tw.AddLine(r.Name, strings.ToUpper(string(r.Severity)), r.Description)
+	}
+	tw.Print()
+	return nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// PlanCommand compares a service definition file with a service version and
+// displays the changes needed to reconcile them.
+type PlanCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	file           string
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewPlanCommand returns a usable command registered under the parent.
+func NewPlanCommand(parent cmd.Registerer, globals *config.Data) *PlanCommand {
+	var c PlanCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("plan", "Show the changes required to bring a service version in line with a service definition file")
+
+	// Required flags
+	c.CmdClause.Flag("file", "Path to a service definition file, as written by 'fastly service export'").Short('f').Required().StringVar(&c.file)
+
+	// Optional flags
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Action:   c.serviceVersion.Set,
+		Dst:      &c.serviceVersion.Value,
+		Optional: true,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *PlanCommand) Exec(in io.Reader, out io.Writer) error {
+	desired, err := readDefinition(c.file, &c.manifest, &c.serviceVersion)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	current, err := definition.Fetch(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	plan := definition.NewPlan(current, desired)
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, plan)
+	}
+	if plan.Empty() {
+		text.Info(out, "No changes. Service %s version %d matches %s.", serviceID, serviceVersion.Number, c.file)
+		return nil
+	}
+
+	printPlan(out, serviceID, serviceVersion.Number, plan)
+
+	if err := plan.Validate(); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	return nil
+}
+
+// readDefinition reads the service definition file. The service ID recorded in
+// the file is only used when no other source provides one, so that a single
+// file can be applied to several services. The version defaults to the active
+// version.
+func readDefinition(path string, m *manifest.Data, sv *cmd.OptionalServiceVersion) (*definition.Service, error) {
+	s, err := definition.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, source := m.ServiceID(); source == manifest.SourceUndefined {
+		m.Flag.ServiceID = s.ServiceID
+	}
+	if !sv.WasSet {
+		sv.Value = "active"
+	}
+	return s, nil
+}
+
+// printPlan displays the changes in a plan grouped by resource type.
+func printPlan(out io.Writer, serviceID string, version int, plan *definition.Plan) {
+	text.Output(out, "Changes to service %s (compared with version %d):", serviceID, version)
+
+	var kind string
+	var unversioned bool
+	for _, ch := range plan.Changes {
+		if ch.Kind != kind {
+			kind = ch.Kind
+			text.Break(out)
+			text.Output(out, "%s", text.Bold(kind))
+		}
+
+		var notes []string
+		if len(ch.Fields) > 0 {
+			notes = append(notes, strings.Join(ch.Fields, ", "))
+		}
+		if ch.Unversioned {
+			notes = append(notes, "takes effect immediately")
+			unversioned = true
+		}
+
+		line := fmt.Sprintf("\t%s %s", ch.Symbol(), ch.Subject())
+		if len(notes) > 0 {
+			line = fmt.Sprintf("%s (%s)", line, strings.Join(notes, "; "))
+		}
+		fmt.Fprintln(out, line)
+	}
+
+	text.Break(out)
+	text.Output(out, "Plan: %d to create, %d to update, %d to delete.", plan.Count(definition.Create), plan.Count(definition.Update), plan.Count(definition.Delete))
+
+	if unversioned {
+		text.Break(out)
+		text.Warning(out, "Items of existing dictionaries, entries of existing ACLs and servers of existing pools are not versioned. Changes to them take effect immediately, before the new version is activated.")
+	}
+}
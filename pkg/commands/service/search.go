@@ -38,6 +38,10 @@ func (c *SearchCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, service)
+	}
+
 	text.PrintService(out, "", service)
 	return nil
 }
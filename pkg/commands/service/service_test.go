@@ -2,7 +2,9 @@ package service_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -121,6 +123,27 @@ func TestServiceList(t *testing.T) {
 	}
 }
 
+func TestServiceListJSON(t *testing.T) {
+	// Verbose notices, such as where the token came from, mustn't corrupt the
+	// JSON output.
+	for _, args := range []string{"service list --json", "service list --json --verbose --token 123"} {
+		t.Run(args, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args(args), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{ListServicesFn: listServicesOK})
+			err := app.Run(opts)
+			testutil.AssertNoError(t, err)
+
+			var have []*fastly.Service
+			if err := json.Unmarshal(stdout.Bytes(), &have); err != nil {
+				t.Fatalf("unexpected JSON output: %v\n%s", err, stdout.String())
+			}
+			want, _ := listServicesOK(&fastly.ListServicesInput{})
+			testutil.AssertEqual(t, want, have)
+		})
+	}
+}
+
 func TestServiceDescribe(t *testing.T) {
 	args := testutil.Args
 	for _, testcase := range []struct {
@@ -176,6 +199,136 @@ func TestServiceDescribe(t *testing.T) {
 	}
 }
 
+func TestServiceDescribeDeep(t *testing.T) {
+	args := testutil.Args
+	api := testutil.EmptyService(mock.API{
+		GetServiceDetailsFn: describeServiceOK,
+		ListVersionsFn:      testutil.ListVersions,
+		ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+			return []*fastly.Domain{{Name: "www.example.com"}, {Name: "example.com"}}, nil
+		},
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{
+				{Name: "origin", Address: "origin.example.com", Port: 443, HealthCheck: "check"},
+				{Name: "fallback", Address: "fallback.example.com"},
+			}, nil
+		},
+		ListHealthChecksFn: func(i *fastly.ListHealthChecksInput) ([]*fastly.HealthCheck, error) {
+			return []*fastly.HealthCheck{
+				{Name: "check", Method: "HEAD", Host: "origin.example.com", Path: "/health"},
+				{Name: "spare", Path: "/status"},
+			}, nil
+		},
+		ListDictionariesFn: func(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+			return []*fastly.Dictionary{{ID: "d1", Name: "config"}, {ID: "d2", Name: "secrets", WriteOnly: true}}, nil
+		},
+		ListDictionaryItemsFn: func(i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) {
+			if i.DictionaryID != "d1" {
+				return nil, nil
+			}
+			return []*fastly.DictionaryItem{{ItemKey: "a", ItemValue: "1"}, {ItemKey: "b", ItemValue: "2"}}, nil
+		},
+		ListACLsFn: func(i *fastly.ListACLsInput) ([]*fastly.ACL, error) {
+			return []*fastly.ACL{{ID: "a1", Name: "blocklist"}}, nil
+		},
+		ListACLEntriesFn: func(i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) {
+			return []*fastly.ACLEntry{{IP: "192.0.2.1"}}, nil
+		},
+		ListVCLsFn: func(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+			return []*fastly.VCL{{Name: "main", Main: true}}, nil
+		},
+		ListSnippetsFn: func(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error) {
+			return []*fastly.Snippet{
+				{Name: "redirects", Type: fastly.SnippetTypeRecv, Dynamic: 1},
+				{Name: "headers", Type: fastly.SnippetTypeDeliver},
+				{Name: "auth", Type: fastly.SnippetTypeRecv},
+			}, nil
+		},
+		ListS3sFn: func(i *fastly.ListS3sInput) ([]*fastly.S3, error) {
+			return []*fastly.S3{{Name: "archive"}}, nil
+		},
+		ListConditionsFn: func(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+			return []*fastly.Condition{{Name: "is_api", Type: "REQUEST"}}, nil
+		},
+		ListDirectorsFn: func(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+			return []*fastly.Director{{Name: "lb"}}, nil
+		},
+		GetDirectorBackendFn: func(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+			return &fastly.DirectorBackend{Director: i.Director, Backend: i.Backend}, nil
+		},
+		ListPoolsFn: func(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+			return []*fastly.Pool{{ID: "p1", Name: "fleet"}}, nil
+		},
+		ListServersFn: func(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+			return []*fastly.Server{{Address: "10.0.0.1"}, {Address: "10.0.0.2"}}, nil
+		},
+		ListHeadersFn: func(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+			return []*fastly.Header{{Name: "strip-cookies"}}, nil
+		},
+	})
+
+	var versions []int
+	tracked := api
+	tracked.ListBackendsFn = func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+		versions = append(versions, i.ServiceVersion)
+		return api.ListBackendsFn(i)
+	}
+
+	for _, testcase := range []struct {
+		args        []string
+		api         mock.API
+		wantError   string
+		wantOutput  string
+		wantVersion int
+	}{
+		{
+			args:        args("service describe --service-id 123 --deep"),
+			api:         tracked,
+			wantOutput:  describeDeepOutput,
+			wantVersion: 2,
+		},
+		{
+			args:        args("service describe --service-id 123 --deep --version 3"),
+			api:         tracked,
+			wantOutput:  "Service: Foo (123)\nType: wasm\nVersion: 3\n",
+			wantVersion: 3,
+		},
+		{
+			args:        args("service describe --service-id 123 --deep --json"),
+			api:         tracked,
+			wantOutput:  `"snippets": {` + "\n" + `    "deliver": [`,
+			wantVersion: 2,
+		},
+		{
+			args:      args("service describe --service-id 123 --version 3"),
+			wantError: "--version can only be used with --deep",
+		},
+		{
+			args:      args("service describe --service-id 123 --deep --version 9"),
+			api:       api,
+			wantError: "specified service version not found: 9",
+		},
+		{
+			args:      args("service describe --service-id 123 --deep"),
+			api:       mock.API{GetServiceDetailsFn: describeServiceError},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			versions = nil
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.wantVersion != 0 {
+				testutil.AssertEqual(t, []int{testcase.wantVersion}, versions)
+			}
+		})
+	}
+}
+
 func TestServiceSearch(t *testing.T) {
 	args := testutil.Args
 	for _, testcase := range []struct {
@@ -379,8 +532,364 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceLint(t *testing.T) {
+	args := testutil.Args
+	api := testutil.EmptyService(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{{Name: "origin", Address: "example.com", UseSSL: true, Shield: "london-uk"}}, nil
+		},
+	})
+	clean := testutil.EmptyService(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{{Name: "origin", Address: "example.com", HealthCheck: "check", Shield: "london-uk"}}, nil
+		},
+	})
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --service-id flag",
+			Args:      args("service lint"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Name:       "validate errors fail the command",
+			Args:       args("service lint --service-id 123"),
+			API:        api,
+			WantError:  "service 123 version 1 has 1 lint error(s)",
+			WantOutput: "1 error(s), 1 warning(s), 0 info.",
+		},
+		{
+			Name:       "validate findings are listed",
+			Args:       args("service lint --service-id 123"),
+			API:        api,
+			WantError:  "lint error(s)",
+			WantOutput: "WARNING   backend-healthcheck",
+		},
+		{
+			Name:       "validate disabled rules",
+			Args:       args("service lint --service-id 123 --disable backend-ssl-check-cert"),
+			API:        api,
+			WantOutput: "0 error(s), 1 warning(s), 0 info.",
+		},
+		{
+			Name:      "validate unknown rule",
+			Args:      args("service lint --service-id 123 --disable nonexistent"),
+			WantError: `unknown rule "nonexistent"`,
+		},
+		{
+			Name:       "validate clean service",
+			Args:       args("service lint --service-id 123 --version 3"),
+			API:        clean,
+			WantOutput: "No problems found in service 123 version 3",
+		},
+		{
+			Name:       "validate JSON",
+			Args:       args("service lint --service-id 123 --json"),
+			API:        api,
+			WantError:  "lint error(s)",
+			WantOutput: `"rule": "backend-ssl-check-cert",`,
+		},
+		{
+			Name:       "validate listing rules",
+			Args:       args("service lint --list-rules"),
+			WantOutput: "dictionary-item-limit",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServiceExport(t *testing.T) {
+	args := testutil.Args
+	api := testutil.EmptyService(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListDomainsFn:  listDomainsOK,
+		ListBackendsFn: listBackendsOK,
+	})
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --version flag",
+			Args:      args("service export --service-id 123"),
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Name: "validate API error",
+			Args: args("service export --service-id 123 --version 1"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn: func(*fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return nil, testutil.Err
+				},
+			}),
+			WantError: "error listing domain: test error",
+		},
+		{
+			Name:       "validate TOML written to stdout",
+			Args:       args("service export --service-id 123 --version 1"),
+			API:        api,
+			WantOutput: exportServiceTOML,
+		},
+		{
+			Name:       "validate JSON written to stdout",
+			Args:       args("service export --service-id 123 --version 1 --json"),
+			API:        api,
+			WantOutput: exportServiceJSON,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+
+	t.Run("validate output file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "service.json")
+		var stdout bytes.Buffer
+		opts := testutil.NewRunOpts(args("service export --service-id 123 --version 1 --output "+path), &stdout)
+		opts.APIClient = mock.APIClient(api)
+		err := app.Run(opts)
+		testutil.AssertNoError(t, err)
+		testutil.AssertStringContains(t, stdout.String(), "Exported service 123 version 1 to "+path)
+		b, err := os.ReadFile(path)
+		testutil.AssertNoError(t, err)
+		testutil.AssertString(t, exportServiceJSON, string(b))
+	})
+}
+
+func TestServiceExportRedactsLoggingSecrets(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		api  mock.API
+		want string
+	}{
+		{
+			name: "https header value",
+			api: mock.API{
+				ListHTTPSFn: func(i *fastly.ListHTTPSInput) ([]*fastly.HTTPS, error) {
+					return []*fastly.HTTPS{{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "web", URL: "https://example.com/logs", HeaderName: "Authorization", HeaderValue: "Bearer hunter2"}}, nil
+				},
+			},
+			want: `url = "https://example.com/logs"`,
+		},
+		{
+			name: "sumologic url",
+			api: mock.API{
+				ListSumologicsFn: func(i *fastly.ListSumologicsInput) ([]*fastly.Sumologic, error) {
+					return []*fastly.Sumologic{{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "sumo", URL: "https://collectors.sumologic.com/receiver/v1/http/hunter2"}}, nil
+				},
+			},
+			want: `url = "<redacted>"`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.api.ListVersionsFn = testutil.ListVersions
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("service export --service-id 123 --version 1"), &stdout)
+			opts.APIClient = mock.APIClient(testutil.EmptyService(testcase.api))
+			err := app.Run(opts)
+			testutil.AssertNoError(t, err)
+			if strings.Contains(stdout.String(), "hunter2") {
+				t.Fatalf("want secrets redacted, have:\n%s", stdout.String())
+			}
+			testutil.AssertStringContains(t, stdout.String(), testcase.want)
+		})
+	}
+}
+
+func TestServicePlan(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --file flag",
+			Args:      args("service plan --service-id 123"),
+			WantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			Name:      "validate missing file",
+			Args:      args("service plan --service-id 123 -f testdata/missing.toml"),
+			WantError: "no such file or directory",
+		},
+		{
+			Name: "validate changes against the active version",
+			Args: args("service plan -f testdata/service.toml"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn:  listDomainsOK,
+				ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return []*fastly.Backend{{Name: "origin", Address: "127.0.0.1", Port: 80}, {Name: "legacy", Address: "10.0.0.1"}}, nil
+				},
+			}),
+			WantOutput: planServiceOutput,
+		},
+		{
+			Name: "validate no changes",
+			Args: args("service plan -f testdata/service.toml --version 1"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return []*fastly.Domain{{Name: "www.example.com"}, {Name: "api.example.com"}}, nil
+				},
+				ListBackendsFn: listBackendsOK,
+			}),
+			WantOutput: "\nINFO: No changes. Service 123 version 1 matches testdata/service.toml.\n",
+		},
+		{
+			Name: "validate unversioned changes are marked",
+			Args: args("service plan -f testdata/dictionary.toml --version 1"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDictionariesFn: func(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+					return []*fastly.Dictionary{{ID: "d1", Name: "settings"}}, nil
+				},
+				ListDictionaryItemsFn: func(i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) {
+					return []*fastly.DictionaryItem{{ItemKey: "mode", ItemValue: "off"}}, nil
+				},
+			}),
+			WantOutput: planUnversionedOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestServiceApply(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate changes applied to a clone and activated",
+			Args: args("service apply -f testdata/service.toml --activate"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				ListDomainsFn:     listDomainsOK,
+				ListBackendsFn:    listBackendsOK,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateDomainFn:    createDomainOK,
+				ActivateVersionFn: activateVersionOK,
+			}),
+			WantOutputs: []string{
+				"+ \"api.example.com\"",
+				"Plan: 1 to create, 0 to update, 0 to delete.",
+				"SUCCESS: Applied 1 changes to service 123 and activated version 4",
+			},
+		},
+		{
+			Name: "validate activation refused after a concurrent activation",
+			Args: args("service apply -f testdata/service.toml --activate"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(1),
+				ListDomainsFn:     listDomainsOK,
+				ListBackendsFn:    listBackendsOK,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateDomainFn:    createDomainOK,
+				DeleteDomainFn:    func(i *fastly.DeleteDomainInput) error { return nil },
+				ActivateVersionFn: activateVersionOK,
+			}),
+			WantError: "the active version of service 123 changed from version 1 to version 3 while this command was running",
+		},
+		{
+			Name: "validate activation forced after a concurrent activation",
+			Args: args("service apply -f testdata/service.toml --activate --force"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(1),
+				ListDomainsFn:     listDomainsOK,
+				ListBackendsFn:    listBackendsOK,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateDomainFn:    createDomainOK,
+				ActivateVersionFn: activateVersionOK,
+			}),
+			WantOutputs: []string{
+				"SUCCESS: Applied 1 changes to service 123 and activated version 4",
+			},
+		},
+		{
+			Name: "validate changes rolled back on error",
+			Args: args("service apply -f testdata/service.toml"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return nil, nil
+				},
+				ListBackendsFn: listBackendsOK,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateDomainFn: func(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+					if i.Name == "www.example.com" {
+						return nil, testutil.Err
+					}
+					return &fastly.Domain{}, nil
+				},
+				DeleteDomainFn: func(i *fastly.DeleteDomainInput) error {
+					if i.Name != "api.example.com" || i.ServiceVersion != 4 {
+						return fmt.Errorf("unexpected rollback of %s version %d", i.Name, i.ServiceVersion)
+					}
+					return nil
+				},
+			}),
+			WantError: `error applying + domain "www.example.com": test error`,
+		},
+		{
+			Name: "validate redacted secrets cannot be created",
+			Args: args("service apply -f testdata/service.toml"),
+			API: testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			WantError: `cannot create backend "origin": the value of 'ssl_client_key' is redacted`,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
 var errTest = errors.New("fixture error")
 
+// listVersionsActivatedAfter returns a ListVersions function which reports
+// version 3 as active, instead of version 1, after n calls, as if someone else
+// activated it while the command was running.
+func listVersionsActivatedAfter(n int) func(*fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	var calls int
+	return func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+		vs, err := testutil.ListVersions(i)
+		calls++
+		if calls > n {
+			vs[0].Active = false
+			vs[2].Active = true
+		}
+		return vs, err
+	}
+}
+
 func createServiceOK(i *fastly.CreateServiceInput) (*fastly.Service, error) {
 	return &fastly.Service{
 		ID:      "12345",
@@ -758,3 +1267,124 @@ func deleteServiceOK(*fastly.DeleteServiceInput) error {
 func deleteServiceError(*fastly.DeleteServiceInput) error {
 	return errTest
 }
+
+func listDomainsOK(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	return []*fastly.Domain{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.com"},
+	}, nil
+}
+
+func listBackendsOK(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "127.0.0.1", Port: 443, SSLClientKey: "secret"},
+	}, nil
+}
+
+var exportServiceTOML = strings.TrimLeft(`
+service_id = "123"
+version = 1
+
+[[backend]]
+  address = "127.0.0.1"
+  name = "origin"
+  port = 443
+  ssl_client_key = "<redacted>"
+
+[[domain]]
+  name = "www.example.com"
+`, "\n")
+
+var exportServiceJSON = strings.TrimLeft(`
+{
+  "service_id": "123",
+  "version": 1,
+  "domains": [
+    {
+      "name": "www.example.com"
+    }
+  ],
+  "backends": [
+    {
+      "address": "127.0.0.1",
+      "name": "origin",
+      "port": 443,
+      "ssl_client_key": "<redacted>"
+    }
+  ]
+}
+`, "\n")
+
+func createDomainOK(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	return &fastly.Domain{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+}
+
+func activateVersionOK(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion, Active: true}, nil
+}
+
+var planServiceOutput = strings.TrimLeft(`
+Changes to service 123 (compared with version 1):
+
+domain
+	+ "api.example.com"
+
+backend
+	~ "origin" (port)
+	- "legacy"
+
+Plan: 1 to create, 1 to update, 1 to delete.
+`, "\n")
+
+var planUnversionedOutput = strings.TrimLeft(`
+Changes to service 123 (compared with version 1):
+
+dictionary item
+	~ "mode" in "settings" (item_value; takes effect immediately)
+	+ "region" in "settings" (takes effect immediately)
+
+Plan: 1 to create, 1 to update, 0 to delete.
+
+
+WARNING: Items of existing dictionaries, entries of existing ACLs and servers of existing pools are not versioned. Changes to them take effect immediately, before the new version is activated.
+`, "\n")
+
+var describeDeepOutput = strings.TrimSpace(`
+Service: Foo (123)
+Type: wasm
+Version: 2 (active)
+├── Domains (2)
+│   ├── example.com
+│   └── www.example.com
+├── Conditions (1)
+│   └── is_api (request)
+├── Backends (2)
+│   ├── fallback (fallback.example.com)
+│   └── origin (origin.example.com:443)
+│       └── healthcheck check (HEAD origin.example.com/health)
+├── Unattached healthchecks (1)
+│   └── spare (/status)
+├── Directors (1)
+│   └── lb (2 backends)
+│       ├── fallback
+│       └── origin
+├── Pools (1)
+│   └── fleet (2 servers)
+├── Dictionaries (2)
+│   ├── config (2 items)
+│   └── secrets (0 items, write-only)
+├── ACLs (1)
+│   └── blocklist (1 entry)
+├── VCLs (1)
+│   └── main (main)
+├── Snippets (3)
+│   ├── deliver (1)
+│   │   └── headers
+│   └── recv (2)
+│       ├── auth
+│       └── redirects (dynamic)
+├── Headers (1)
+│   └── strip-cookies
+└── Logging endpoints (1)
+    └── s3 (1)
+        └── archive
+`) + "\n"
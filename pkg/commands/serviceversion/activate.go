@@ -1,7 +1,9 @@
 package serviceversion
 
 import (
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/compute/manifest"
@@ -18,6 +20,10 @@ type ActivateCommand struct {
 	Input          fastly.ActivateVersionInput
 	serviceVersion cmd.OptionalServiceVersion
 	autoClone      cmd.OptionalAutoClone
+	guard          cmd.ActiveVersionGuard
+	watch          time.Duration
+	maxErrorRate   string
+	max5xxRate     string
 }
 
 // NewActivateCommand returns a usable command registered under the parent.
@@ -35,12 +41,28 @@ func NewActivateCommand(parent cmd.Registerer, globals *config.Data) *ActivateCo
 		Action: c.autoClone.Set,
 		Dst:    &c.autoClone.Value,
 	})
+	c.CmdClause.Flag("force", "Activate even if another version was activated while the command was running").BoolVar(&c.guard.Force)
+	c.CmdClause.Flag("watch", "Watch the realtime stats for this long after activating, e.g. 5m, and reactivate the previously active version if the error rate exceeds the maximum").DurationVar(&c.watch)
+	c.CmdClause.Flag("max-error-rate", "Maximum percentage of requests resulting in an error in any one second while watching").Default("2%").StringVar(&c.maxErrorRate)
+	c.CmdClause.Flag("max-5xx-rate", "Maximum percentage of requests resulting in a 5xx response in any one second while watching").StringVar(&c.max5xxRate)
 	return &c
 }
 
 // Exec invokes the application logic for the command.
 func (c *ActivateCommand) Exec(in io.Reader, out io.Writer) error {
+	var limits thresholds
+	if c.watch > 0 {
+		var err error
+		if limits.errorRate, err = parseRate(c.maxErrorRate); err != nil {
+			return errors.RemediationError{Inner: err, Remediation: "Specify a percentage, e.g. --max-error-rate 2%."}
+		}
+		if limits.status5xx, err = parseRate(c.max5xxRate); err != nil {
+			return errors.RemediationError{Inner: err, Remediation: "Specify a percentage, e.g. --max-5xx-rate 1%."}
+		}
+	}
+
 	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		ActiveVersionGuard: &c.guard,
 		AutoCloneFlag:      c.autoClone,
 		Client:             c.Globals.Client,
 		Manifest:           c.manifest,
@@ -59,6 +81,45 @@ func (c *ActivateCommand) Exec(in io.Reader, out io.Writer) error {
 	c.Input.ServiceID = serviceID
 	c.Input.ServiceVersion = serviceVersion.Number
 
+	// The draft version of an edit session was cloned when the session began,
+	// so any activation since then is a concurrent change.
+	if s := c.Globals.Session; s != nil && s.ServiceID == serviceID && s.Version == serviceVersion.Number {
+		c.guard.Set(s.ServiceID, s.ActiveVersion)
+	}
+
+	// The version to fall back to is captured as late as possible, so that
+	// the rollback doesn't undo someone else's activation.
+	var previous int
+	if c.watch > 0 {
+		vs, err := c.Globals.Client.ListVersions(&fastly.ListVersionsInput{
+			ServiceID: serviceID,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return fmt.Errorf("error listing service versions: %w", err)
+		}
+		if v, err := cmd.GetActiveVersion(vs); err == nil {
+			previous = v.Number
+		}
+		if previous == serviceVersion.Number {
+			return errors.RemediationError{
+				Inner:       fmt.Errorf("version %d is already active, so there's no previous version to roll back to", previous),
+				Remediation: "Activate a different version, or run the command without --watch.",
+			}
+		}
+	}
+
+	if err := c.guard.Check(c.Globals.Client); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	activated := time.Now()
 	ver, err := c.Globals.Client.ActivateVersion(&c.Input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
@@ -69,5 +130,60 @@ func (c *ActivateCommand) Exec(in io.Reader, out io.Writer) error {
 	}
 
 	text.Success(out, "Activated service %s version %d", ver.ServiceID, c.Input.ServiceVersion)
-	return nil
+
+	if c.watch <= 0 || c.Globals.DryRun() {
+		return nil
+	}
+	return c.watchActivation(serviceID, previous, activated, limits, out)
+}
+
+// watchActivation monitors the traffic of the newly activated version and
+// reactivates the previous version if it breaches the thresholds. If no
+// version was previously active, the new version is deactivated instead.
+func (c *ActivateCommand) watchActivation(serviceID string, previous int, since time.Time, limits thresholds, out io.Writer) error {
+	text.Info(out, "Watching the error rate of service %s for %s...", serviceID, c.watch)
+	text.Break(out)
+
+	b := watch(c.Globals.RTSClient, serviceID, since, c.watch, limits, c.Globals.Verbose(), out)
+	if b == nil {
+		text.Success(out, "Version %d stayed within the thresholds for %s", c.Input.ServiceVersion, c.watch)
+		return nil
+	}
+
+	var err error
+	if previous > 0 {
+		_, err = c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: previous,
+		})
+	} else {
+		_, err = c.Globals.Client.DeactivateVersion(&fastly.DeactivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: c.Input.ServiceVersion,
+		})
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":       serviceID,
+			"Service Version":  c.Input.ServiceVersion,
+			"Previous Version": previous,
+		})
+		remediation := fmt.Sprintf("Reactivate the previous version manually with `fastly service-version activate --service-id %s --version %d`.", serviceID, previous)
+		if previous == 0 {
+			remediation = fmt.Sprintf("Deactivate the version manually with `fastly service-version deactivate --service-id %s --version %d`.", serviceID, c.Input.ServiceVersion)
+		}
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("version %d breached the thresholds at %s: %s, and rolling back failed: %w", c.Input.ServiceVersion, b.recorded.Format(time.RFC3339), b, err),
+			Remediation: remediation,
+		}
+	}
+
+	rolledBack := fmt.Sprintf("reactivated version %d", previous)
+	if previous == 0 {
+		rolledBack = fmt.Sprintf("deactivated version %d", c.Input.ServiceVersion)
+	}
+	return errors.RemediationError{
+		Inner:       fmt.Errorf("version %d breached the thresholds at %s: %s; %s", c.Input.ServiceVersion, b.recorded.Format(time.RFC3339), b, rolledBack),
+		Remediation: fmt.Sprintf("Investigate the errors before activating version %d again.", c.Input.ServiceVersion),
+	}
 }
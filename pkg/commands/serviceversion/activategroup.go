@@ -0,0 +1,286 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/undo"
+	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
+)
+
+// ActivateGroupCommand activates versions of several services together,
+// restoring the previously active versions if any activation fails.
+type ActivateGroupCommand struct {
+	cmd.Base
+	file  string
+	force bool
+}
+
+// NewActivateGroupCommand returns a usable command registered under the parent.
+func NewActivateGroupCommand(parent cmd.Registerer, globals *config.Data) *ActivateGroupCommand {
+	var c ActivateGroupCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("activate-group", "Activate versions of several Fastly services together, rolling them all back if any activation fails")
+	c.CmdClause.Flag("file", "Path to a TOML file listing the services and versions to activate, in order").Short('f').Required().StringVar(&c.file)
+	c.CmdClause.Flag("force", "Activate even if another version of a service was activated while the command was running").BoolVar(&c.force)
+	return &c
+}
+
+// group is the format of the file listing the services to activate, e.g.
+//
+//	[[service]]
+//	id = "SU1Z0isxPaozGVKXdv0eY"
+//	version = "latest"
+//
+// The version is "latest", "active" or a version number, defaulting to
+// "latest".
+type group struct {
+	Services []struct {
+		ID      string          `toml:"id"`
+		Version versionSelector `toml:"version"`
+	} `toml:"service"`
+}
+
+// versionSelector accepts version numbers as well as strings, so that a
+// version can be written either as 3 or "3".
+type versionSelector string
+
+// UnmarshalTOML implements toml.Unmarshaler.
+func (s *versionSelector) UnmarshalTOML(v interface{}) error {
+	*s = versionSelector(fmt.Sprint(v))
+	return nil
+}
+
+// groupMember is a service in the group, along with the version to activate
+// and the version active beforehand, where 0 means none.
+type groupMember struct {
+	serviceID string
+	version   int
+	previous  int
+	guard     cmd.ActiveVersionGuard
+}
+
+// Exec invokes the application logic for the command.
+func (c *ActivateGroupCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	g, err := readGroup(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+
+	members, err := c.resolve(g)
+	if err != nil {
+		return err
+	}
+	if err := c.validate(members); err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		if m.version == m.previous {
+			fmt.Fprintf(out, "Service %s: version %d is already active\n", m.serviceID, m.version)
+			continue
+		}
+		fmt.Fprintf(out, "Service %s: %s -> version %d\n", m.serviceID, describeVersion(m.previous), m.version)
+	}
+	text.Break(out)
+
+	var progress text.Progress
+	if c.Globals.Verbose() {
+		progress = text.NewVerboseProgress(out)
+	} else {
+		progress = text.NewQuietProgress(out)
+	}
+
+	undoStack := undo.NewStack()
+	defer func() {
+		if err != nil {
+			progress.Fail()
+		}
+		undoStack.RunIfError(out, err)
+	}()
+
+	var activated int
+	for i := range members {
+		m := &members[i]
+		if m.version == m.previous {
+			continue
+		}
+
+		progress.Step(fmt.Sprintf("Activating service %s version %d...", m.serviceID, m.version))
+		if err = m.guard.Check(c.Globals.Client); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      m.serviceID,
+				"Service Version": m.version,
+			})
+			return c.rollbackError(err, activated)
+		}
+		if _, err = c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      m.serviceID,
+			ServiceVersion: m.version,
+		}); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      m.serviceID,
+				"Service Version": m.version,
+			})
+			return c.rollbackError(fmt.Errorf("error activating service %s version %d: %w", m.serviceID, m.version, err), activated)
+		}
+		activated++
+
+		undoStack.Push(func() error {
+			if m.previous == 0 {
+				_, err := c.Globals.Client.DeactivateVersion(&fastly.DeactivateVersionInput{
+					ServiceID:      m.serviceID,
+					ServiceVersion: m.version,
+				})
+				if err != nil {
+					return fmt.Errorf("error deactivating service %s version %d: %w", m.serviceID, m.version, err)
+				}
+				return nil
+			}
+			_, err := c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+				ServiceID:      m.serviceID,
+				ServiceVersion: m.previous,
+			})
+			if err != nil {
+				return fmt.Errorf("error reactivating service %s version %d: %w", m.serviceID, m.previous, err)
+			}
+			return nil
+		})
+	}
+
+	progress.Done()
+	text.Success(out, "Activated %d of %d services", activated, len(members))
+	return nil
+}
+
+// resolve looks up the version to activate and the active version of each
+// service in the group.
+func (c *ActivateGroupCommand) resolve(g *group) ([]groupMember, error) {
+	members := make([]groupMember, 0, len(g.Services))
+	for _, s := range g.Services {
+		selector := cmd.OptionalServiceVersion{}
+		selector.Value = string(s.Version)
+		v, err := selector.Parse(s.ID, c.Globals.Client)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      s.ID,
+				"Service Version": s.Version,
+			})
+			return nil, fmt.Errorf("error selecting version %q of service %s: %w", s.Version, s.ID, err)
+		}
+
+		m := groupMember{serviceID: s.ID, version: v.Number}
+		vs, err := c.Globals.Client.ListVersions(&fastly.ListVersionsInput{
+			ServiceID: s.ID,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": s.ID,
+			})
+			return nil, fmt.Errorf("error listing service versions: %w", err)
+		}
+		if active, err := cmd.GetActiveVersion(vs); err == nil {
+			m.previous = active.Number
+		}
+		m.guard.Force = c.force
+		m.guard.Set(s.ID, m.previous)
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+// validate checks every version before anything is activated, and reports
+// all of the invalid versions at once.
+func (c *ActivateGroupCommand) validate(members []groupMember) error {
+	var problems []string
+	for _, m := range members {
+		valid, msg, err := c.Globals.Client.ValidateVersion(&fastly.ValidateVersionInput{
+			ServiceID:      m.serviceID,
+			ServiceVersion: m.version,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      m.serviceID,
+				"Service Version": m.version,
+			})
+			return fmt.Errorf("error validating service %s version %d: %w", m.serviceID, m.version, err)
+		}
+		if !valid {
+			problems = append(problems, fmt.Sprintf("service %s version %d: %s", m.serviceID, m.version, msg))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("invalid service versions, nothing was activated:\n\t%s", strings.Join(problems, "\n\t")),
+			Remediation: "Fix the problems and run the command again.",
+		}
+	}
+	return nil
+}
+
+// rollbackError wraps the error which stopped the activation of the group.
+func (c *ActivateGroupCommand) rollbackError(err error, activated int) error {
+	remediation := "No services were activated."
+	if activated > 0 {
+		remediation = fmt.Sprintf("The %d service(s) already activated were rolled back to their previously active versions. Check the output above for any errors doing so.", activated)
+	}
+	return errors.RemediationError{
+		Inner:       err,
+		Remediation: remediation,
+	}
+}
+
+// readGroup reads and checks the group file at path.
+func readGroup(path string) (*group, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("error reading group file: %w", err),
+			Remediation: "Check the path given to --file.",
+		}
+	}
+	defer f.Close()
+
+	var g group
+	if err := toml.NewDecoder(f).Decode(&g); err != nil {
+		return nil, fmt.Errorf("error parsing group file: %w", err)
+	}
+	if len(g.Services) == 0 {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("group file %s lists no services", path),
+			Remediation: "Add a [[service]] table with an id and version for each service.",
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i, s := range g.Services {
+		if s.ID == "" {
+			return nil, fmt.Errorf("error parsing group file: service %d has no id", i+1)
+		}
+		if seen[s.ID] {
+			return nil, fmt.Errorf("error parsing group file: service %s is listed more than once", s.ID)
+		}
+		seen[s.ID] = true
+		if s.Version == "" {
+			g.Services[i].Version = "latest"
+		}
+	}
+	return &g, nil
+}
+
+func describeVersion(version int) string {
+	if version == 0 {
+		return "no active version"
+	}
+	return fmt.Sprintf("version %d", version)
+}
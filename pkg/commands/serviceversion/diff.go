@@ -0,0 +1,328 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// contextLines is the number of unchanged lines displayed around a change to
+// a multi-line value, such as VCL content.
+const contextLines = 3
+
+// DiffCommand compares the resources of two service versions.
+type DiffCommand struct {
+	cmd.Base
+	manifest manifest.Data
+	from     cmd.OptionalServiceVersion
+	to       cmd.OptionalServiceVersion
+}
+
+// NewDiffCommand returns a usable command registered under the parent.
+func NewDiffCommand(parent cmd.Registerer, globals *config.Data) *DiffCommand {
+	var c DiffCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("diff", "Show the differences between two Fastly service versions")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("from", "'latest', 'active', or the number of the version to compare from").Default("active").StringVar(&c.from.Value)
+	c.CmdClause.Flag("to", "'latest', 'active', or the number of the version to compare to").Default("latest").StringVar(&c.to.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DiffCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	from, err := c.from.Parse(serviceID, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"From":       c.from.Value,
+		})
+		return err
+	}
+	to, err := c.to.Parse(serviceID, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"To":         c.to.Value,
+		})
+		return err
+	}
+
+	fromDef, err := c.fetch(serviceID, from.Number)
+	if err != nil {
+		return err
+	}
+	toDef, err := c.fetch(serviceID, to.Number)
+	if err != nil {
+		return err
+	}
+
+	plan := definition.NewPlan(fromDef, toDef)
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, diffJSON(serviceID, from.Number, to.Number, plan))
+	}
+
+	if plan.Empty() {
+		text.Info(out, "No differences between service %s version %d and version %d.", serviceID, from.Number, to.Number)
+		return nil
+	}
+
+	fmt.Fprintf(out, "--- service %s version %d\n", serviceID, from.Number)
+	fmt.Fprintf(out, "+++ service %s version %d\n", serviceID, to.Number)
+	for _, ch := range plan.Changes {
+		fmt.Fprintf(out, "\n%s\n", ch)
+		switch ch.Action {
+		case definition.Create:
+			for _, k := range fieldNames(ch.To) {
+				printField(out, "+", k, ch.To[k])
+			}
+		case definition.Delete:
+			for _, k := range fieldNames(ch.From) {
+				printField(out, "-", k, ch.From[k])
+			}
+		case definition.Update:
+			for _, k := range ch.Fields {
+				printFieldDiff(out, k, ch.From[k], ch.To[k])
+			}
+		}
+	}
+	text.Break(out)
+	text.Output(out, "%d added, %d changed, %d removed.", plan.Count(definition.Create), plan.Count(definition.Update), plan.Count(definition.Delete))
+	return nil
+}
+
+// fetch returns the redacted definition of a service version.
+func (c *DiffCommand) fetch(serviceID string, version int) (*definition.Service, error) {
+	s, err := definition.Fetch(c.Globals.Client, serviceID, version)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return nil, err
+	}
+	s.Redact()
+	return s, nil
+}
+
+// diffChange is the JSON representation of a single change, including the
+// (redacted) values of the resource in both versions.
+type diffChange struct {
+	definition.Change
+	From definition.Resource `json:"from,omitempty"`
+	To   definition.Resource `json:"to,omitempty"`
+}
+
+func diffJSON(serviceID string, from, to int, plan *definition.Plan) interface{} {
+	changes := make([]diffChange, 0, len(plan.Changes))
+	for _, ch := range plan.Changes {
+		changes = append(changes, diffChange{Change: ch, From: ch.From, To: ch.To})
+	}
+	return struct {
+		ServiceID string       `json:"service_id"`
+		From      int          `json:"from"`
+		To        int          `json:"to"`
+		Changes   []diffChange `json:"changes"`
+	}{serviceID, from, to, changes}
+}
+
+// fieldNames returns the sorted field names of a resource, excluding those
+// which are already displayed as the resource's name.
+func fieldNames(r definition.Resource) []string {
+	var keys []string
+	for k := range r {
+		if k != "name" && k != "item_key" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// printField displays a single field, prefixing every line with symbol.
+func printField(out io.Writer, symbol, key string, value interface{}) {
+	if s, ok := value.(string); ok && strings.Contains(s, "\n") {
+		fmt.Fprintf(out, "%s\t%s:\n", symbol, key)
+		for _, line := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+			fmt.Fprintf(out, "%s\t\t%s\n", symbol, line)
+		}
+		return
+	}
+	fmt.Fprintf(out, "%s\t%s = %s\n", symbol, key, formatValue(value))
+}
+
+// printFieldDiff displays the change to a field. Multi-line values are
+// compared line by line.
+func printFieldDiff(out io.Writer, key string, from, to interface{}) {
+	a, aok := from.(string)
+	b, bok := to.(string)
+	if aok && bok && (strings.Contains(a, "\n") || strings.Contains(b, "\n")) {
+		fmt.Fprintf(out, " \t%s:\n", key)
+		for _, line := range diffLines(splitLines(a), splitLines(b)) {
+			fmt.Fprintf(out, "%s\n", line)
+		}
+		return
+	}
+	if from != nil {
+		printField(out, "-", key, from)
+	}
+	if to != nil {
+		printField(out, "+", key, to)
+	}
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// maxDiffCells bounds the size of the table used to compute the longest
+// common subsequence of two multi-line values, so that diffing very large
+// files, e.g. generated VCL, can't exhaust memory.
+const maxDiffCells = 4 << 20
+
+// diffLine is a single line of a diff, with the symbol denoting whether it
+// was added, removed or unchanged.
+type diffLine struct {
+	symbol string
+	text   string
+}
+
+// diffLines returns a line based diff of a and b, using the longest common
+// subsequence, with unchanged lines trimmed to contextLines either side of a
+// change.
+func diffLines(a, b []string) []string {
+	// Lines common to the start and end of both values needn't be compared.
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, l := range a[:prefix] {
+		lines = append(lines, diffLine{" ", l})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{" ", l})
+	}
+	return trimContext(lines)
+}
+
+// diffMiddle compares a and b line by line. If they're too large to compare
+// within maxDiffCells, every line of a is shown as removed and every line of
+// b as added.
+func diffMiddle(a, b []string) []diffLine {
+	var lines []diffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, l := range a {
+			lines = append(lines, diffLine{"-", l})
+		}
+		for _, l := range b {
+			lines = append(lines, diffLine{"+", l})
+		}
+		return lines
+	}
+
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{" ", a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{"-", a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{"+", b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// trimContext formats the diff, keeping only the unchanged lines within
+// contextLines of a change.
+func trimContext(lines []diffLine) []string {
+	keep := make([]bool, len(lines))
+	for n, l := range lines {
+		if l.symbol == " " {
+			continue
+		}
+		for m := n - contextLines; m <= n+contextLines; m++ {
+			if m >= 0 && m < len(lines) {
+				keep[m] = true
+			}
+		}
+	}
+
+	var out []string
+	skipped := false
+	for n, l := range lines {
+		if !keep[n] {
+			skipped = true
+			continue
+		}
+		if skipped && len(out) > 0 {
+			out = append(out, " \t\t...")
+		}
+		skipped = false
+		out = append(out, fmt.Sprintf("%s\t\t%s", l.symbol, l.text))
+	}
+	return out
+}
+
+// formatValue renders a normalised value in a TOML-like syntax.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, e := range v {
+			parts[i] = formatValue(e)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
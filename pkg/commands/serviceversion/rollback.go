@@ -0,0 +1,198 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// RollbackCommand reactivates a previously active service version.
+type RollbackCommand struct {
+	cmd.Base
+	manifest    manifest.Data
+	journalPath string
+	to          cmd.OptionalInt
+	steps       cmd.OptionalInt
+	autoYes     bool
+	guard       cmd.ActiveVersionGuard
+}
+
+// NewRollbackCommand returns a usable command registered under the parent.
+func NewRollbackCommand(parent cmd.Registerer, journalPath string, globals *config.Data) *RollbackCommand {
+	var c RollbackCommand
+	c.Globals = globals
+	c.journalPath = journalPath
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("rollback", "Reactivate a previously active Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("to", "Number of the version to roll back to").Action(c.to.Set).IntVar(&c.to.Value)
+	c.CmdClause.Flag("steps", "Number of previously active versions to go back (default 1)").Action(c.steps.Set).IntVar(&c.steps.Value)
+	c.CmdClause.Flag("auto-yes", "Roll back without asking for confirmation").Short('y').BoolVar(&c.autoYes)
+	c.CmdClause.Flag("force", "Roll back even if another version was activated while the command was running").BoolVar(&c.guard.Force)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *RollbackCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+	if c.to.WasSet && c.steps.WasSet {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("--to and --steps can't be used together"),
+			Remediation: "Specify either the version to roll back to or the number of versions to go back.",
+		}
+	}
+
+	vs, err := c.Globals.Client.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+		})
+		return fmt.Errorf("error listing service versions: %w", err)
+	}
+	active, err := cmd.GetActiveVersion(vs)
+	if err != nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("service %s has no active version to roll back from", serviceID),
+			Remediation: "Activate a version with `fastly service-version activate`.",
+		}
+	}
+	c.guard.Set(serviceID, active.Number)
+
+	target, err := c.target(serviceID, vs, active)
+	if err != nil {
+		return err
+	}
+
+	if err := c.summarise(serviceID, active.Number, target.Number, out); err != nil {
+		return err
+	}
+
+	if !c.autoYes {
+		answer, err := text.Input(out, fmt.Sprintf("Are you sure you want to activate version %d? [y/N] ", target.Number), in)
+		if err != nil {
+			return err
+		}
+		if answer != "y" && answer != "Y" {
+			text.Info(out, "Rollback cancelled.")
+			return nil
+		}
+	}
+
+	if err := c.guard.Check(c.Globals.Client); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": target.Number,
+		})
+		return err
+	}
+
+	if _, err := c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: target.Number,
+	}); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": target.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Rolled back service %s from version %d to version %d", serviceID, active.Number, target.Number)
+	return nil
+}
+
+// target returns the version selected by the --to or --steps flags. By
+// default it's the version which was active before the current one, as
+// recorded by the version metadata and the activations in the journal.
+func (c *RollbackCommand) target(serviceID string, vs []*fastly.Version, active *fastly.Version) (*fastly.Version, error) {
+	if c.to.WasSet {
+		for _, v := range vs {
+			if v.Number != c.to.Value {
+				continue
+			}
+			if v.Active {
+				return nil, fmt.Errorf("version %d of service %s is already active", v.Number, serviceID)
+			}
+			return v, nil
+		}
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("version %d of service %s not found", c.to.Value, serviceID),
+			Remediation: fmt.Sprintf("Run `fastly service-version list --service-id %s` to list the available versions.", serviceID),
+		}
+	}
+
+	steps := 1
+	if c.steps.WasSet {
+		steps = c.steps.Value
+	}
+	if steps < 1 {
+		return nil, fmt.Errorf("--steps must be at least 1")
+	}
+
+	entries, err := journal.Read(c.journalPath)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return nil, err
+	}
+	previous := cmd.GetPreviousVersions(vs, journal.LastActive(entries, serviceID))
+	if steps > len(previous) {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("only %d version(s) of service %s are known to have been active before version %d", len(previous), serviceID, active.Number),
+			Remediation: fmt.Sprintf("Use --to to roll back to a specific version. Run `fastly service-version list --service-id %s` to list the available versions.", serviceID),
+		}
+	}
+	return previous[steps-1], nil
+}
+
+// summarise displays the changes that reactivating the target version will
+// revert, relative to the active version.
+func (c *RollbackCommand) summarise(serviceID string, active, target int, out io.Writer) error {
+	from, err := definition.Fetch(c.Globals.Client, serviceID, active)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": active,
+		})
+		return err
+	}
+	to, err := definition.Fetch(c.Globals.Client, serviceID, target)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": target,
+		})
+		return err
+	}
+	from.Redact()
+	to.Redact()
+	plan := definition.NewPlan(from, to)
+
+	fmt.Fprintf(out, "Rolling back service %s from version %d to version %d.\n", serviceID, active, target)
+	if plan.Empty() {
+		text.Info(out, "The versions have no differences in configuration.")
+		text.Break(out)
+		return nil
+	}
+	text.Break(out)
+	for _, ch := range plan.Changes {
+		fmt.Fprintf(out, "\t%s\n", ch)
+	}
+	text.Break(out)
+	text.Output(out, "%d added, %d changed, %d removed.", plan.Count(definition.Create), plan.Count(definition.Update), plan.Count(definition.Delete))
+	text.Break(out)
+	return nil
+}
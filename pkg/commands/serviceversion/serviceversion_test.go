@@ -2,10 +2,17 @@ package serviceversion_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/journal"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/go-fastly/v3/fastly"
@@ -189,6 +196,24 @@ func TestVersionActivate(t *testing.T) {
 			},
 			wantOutput: "Activated service 123 version 3",
 		},
+		{
+			args: args("service-version activate --service-id 123 --version 1 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(1),
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				ActivateVersionFn: activateVersionOK,
+			},
+			wantError: "the active version of service 123 changed from version 1 to version 3 while this command was running",
+		},
+		{
+			args: args("service-version activate --service-id 123 --version 1 --autoclone --force"),
+			api: mock.API{
+				ListVersionsFn:    listVersionsActivatedAfter(1),
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				ActivateVersionFn: activateVersionOK,
+			},
+			wantOutput: "Activated service 123 version 4",
+		},
 	} {
 		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
 			var stdout bytes.Buffer
@@ -201,6 +226,459 @@ func TestVersionActivate(t *testing.T) {
 	}
 }
 
+// TestVersionDiffLargeVCL checks that very large VCL files are diffed without
+// comparing every pair of lines.
+func TestVersionDiffLargeVCL(t *testing.T) {
+	vcl := func(version, n int, changed func(i int) bool) string {
+		var b strings.Builder
+		for i := 0; i < n; i++ {
+			if changed(i) {
+				fmt.Fprintf(&b, "set req.http.X-%d = \"v%d\";\n", i, version)
+			} else {
+				fmt.Fprintf(&b, "set req.http.X-%d = \"same\";\n", i)
+			}
+		}
+		return b.String()
+	}
+
+	for _, testcase := range []struct {
+		name       string
+		changed    func(i int) bool
+		wantOutput []string
+	}{
+		{
+			name:    "single change",
+			changed: func(i int) bool { return i == 10000 },
+			wantOutput: []string{
+				" \t\tset req.http.X-9999 = \"same\";\n-\t\tset req.http.X-10000 = \"v1\";\n+\t\tset req.http.X-10000 = \"v3\";\n",
+			},
+		},
+		{
+			name:    "every line changed",
+			changed: func(i int) bool { return true },
+			wantOutput: []string{
+				"-\t\tset req.http.X-19999 = \"v1\";\n+\t\tset req.http.X-0 = \"v3\";\n",
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			api := testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListVCLsFn: func(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+					return []*fastly.VCL{{Name: "main", Main: true, Content: vcl(i.ServiceVersion, 20000, testcase.changed)}}, nil
+				},
+			})
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("service-version diff --service-id 123"), &stdout)
+			opts.APIClient = mock.APIClient(api)
+			err := app.Run(opts)
+			testutil.AssertNoError(t, err)
+			for _, want := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), want)
+			}
+		})
+	}
+}
+
+// listVersionsActivatedAfter returns a ListVersions function which reports
+// version 3 as active, instead of version 1, after n calls, as if someone else
+// activated it while a command was running.
+func listVersionsActivatedAfter(n int) func(*fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	var calls int
+	return func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+		vs, err := testutil.ListVersions(i)
+		calls++
+		if calls > n {
+			vs[0].Active = false
+			vs[2].Active = true
+		}
+		return vs, err
+	}
+}
+
+func TestVersionActivateWatch(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		stats        string
+		listVersions func(*fastly.ListVersionsInput) ([]*fastly.Version, error)
+		statsFn      func(*fastly.GetRealtimeStatsInput, interface{}) error
+		maxFetches   int
+		wantError    string
+		wantOutput   string
+		wantActivate []int
+	}{
+		{
+			name:         "healthy",
+			args:         args("service-version activate --service-id 123 --version 3 --watch 20ms"),
+			stats:        `{"requests": 100, "errors": 2, "status_5xx": 1}`,
+			wantOutput:   "Version 3 stayed within the thresholds for 20ms",
+			wantActivate: []int{3},
+		},
+		{
+			name:         "error rate exceeded",
+			args:         args("service-version activate --service-id 123 --version 3 --watch 1m --max-error-rate 2.5%"),
+			stats:        `{"requests": 200, "errors": 6, "status_5xx": 0}`,
+			wantError:    "error rate of 3% (6 of 200 requests) exceeded the maximum of 2.5%",
+			wantActivate: []int{3, 1},
+		},
+		{
+			name:         "5xx rate exceeded",
+			args:         args("service-version activate --service-id 123 --version 3 --watch 1m --max-5xx-rate 1"),
+			stats:        `{"requests": 100, "errors": 1, "status_5xx": 2}`,
+			wantError:    "5xx rate of 2% (2 of 100 requests) exceeded the maximum of 1%; reactivated version 1",
+			wantActivate: []int{3, 1},
+		},
+		{
+			name:         "idle seconds are ignored",
+			args:         args("service-version activate --service-id 123 --version 3 --watch 20ms"),
+			stats:        `{"requests": 0, "errors": 0, "status_5xx": 0}`,
+			wantOutput:   "Version 3 stayed within the thresholds",
+			wantActivate: []int{3},
+		},
+		{
+			name: "no new stats",
+			args: args("service-version activate --service-id 123 --version 3 --watch 50ms"),
+			statsFn: func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+				return json.Unmarshal([]byte(fmt.Sprintf(`{"Timestamp": %d, "Data": []}`, time.Now().Unix())), dst)
+			},
+			maxFetches:   2,
+			wantOutput:   "Version 3 stayed within the thresholds for 50ms",
+			wantActivate: []int{3},
+		},
+		{
+			name: "seconds after the deadline are ignored",
+			args: args("service-version activate --service-id 123 --version 3 --watch 20ms"),
+			statsFn: func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+				return json.Unmarshal([]byte(fmt.Sprintf(`{"Timestamp": %[1]d, "Data": [
+					{"recorded": %[1]d, "aggregated": {"requests": 10, "errors": 10, "status_5xx": 10}}
+				]}`, time.Now().Unix()+2)), dst)
+			},
+			wantOutput:   "Version 3 stayed within the thresholds for 20ms",
+			wantActivate: []int{3},
+		},
+		{
+			// Someone else activates the version once it's selected, and
+			// --force skips the check that would otherwise notice.
+			name:         "version already active",
+			args:         args("service-version activate --service-id 123 --version 3 --watch 1m --force"),
+			listVersions: listVersionsActivatedAfter(2),
+			wantError:    "version 3 is already active, so there's no previous version to roll back to",
+		},
+		{
+			name:      "invalid rate",
+			args:      args("service-version activate --service-id 123 --version 3 --watch 1m --max-error-rate 200%"),
+			wantError: `invalid rate "200%", must be a percentage between 0 and 100`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var activated []int
+			api := mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					activated = append(activated, i.ServiceVersion)
+					return activateVersionOK(i)
+				},
+			}
+			if testcase.listVersions != nil {
+				api.ListVersionsFn = testcase.listVersions
+			}
+
+			statsFn := testcase.statsFn
+			if statsFn == nil {
+				statsFn = realtimeStats(testcase.stats)
+			}
+			var fetches int
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(api)
+			opts.RTSClient = mock.RTSClient(mock.RealtimeStats{
+				GetRealtimeStatsJSONFn: func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+					fetches++
+					return statsFn(i, dst)
+				},
+			})
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			testutil.AssertEqual(t, testcase.wantActivate, activated)
+			if testcase.maxFetches > 0 && fetches > testcase.maxFetches {
+				t.Fatalf("want at most %d fetches of the stats, have %d", testcase.maxFetches, fetches)
+			}
+		})
+	}
+}
+
+// TestVersionActivateWatchTimeout checks that a request for the stats which is
+// still being held open by the API doesn't extend the watch past its deadline.
+func TestVersionActivateWatchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("service-version activate --service-id 123 --version 3 --watch 50ms"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{
+		ListVersionsFn:    testutil.ListVersions,
+		ActivateVersionFn: activateVersionOK,
+	})
+	opts.RTSClient = mock.RTSClient(mock.RealtimeStats{
+		GetRealtimeStatsJSONFn: func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+			select {
+			case <-release:
+			case <-time.After(10 * time.Second):
+			}
+			return nil
+		},
+	})
+
+	start := time.Now()
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Version 3 stayed within the thresholds for 50ms")
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("want the watch to stop at its deadline, have it take %s", elapsed)
+	}
+}
+
+// realtimeStats returns a GetRealtimeStatsJSON function which reports the
+// aggregated stats for the current second, preceded by a second recorded
+// before the activation which breaches every threshold.
+func realtimeStats(aggregated string) func(*fastly.GetRealtimeStatsInput, interface{}) error {
+	return func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+		now := time.Now().Unix()
+		return json.Unmarshal([]byte(fmt.Sprintf(`{"Timestamp": %d, "Data": [
+			{"recorded": %d, "aggregated": {"requests": 10, "errors": 10, "status_5xx": 10}},
+			{"recorded": %d, "aggregated": %s}
+		]}`, now, now-60, now, aggregated)), dst)
+	}
+}
+
+func TestVersionRollback(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		stdin        string
+		journal      []journal.Entry
+		wantError    string
+		wantOutput   string
+		wantActivate []int
+	}{
+		{
+			name:      "no service",
+			args:      args("service-version rollback"),
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			name:         "previously locked version",
+			args:         args("service-version rollback --service-id 123 --auto-yes"),
+			wantOutput:   rollbackOutput,
+			wantActivate: []int{2},
+		},
+		{
+			name:       "cancelled",
+			args:       args("service-version rollback --service-id 123"),
+			stdin:      "n\n",
+			wantOutput: "Are you sure you want to activate version 2? [y/N] \nINFO: Rollback cancelled.\n",
+		},
+		{
+			name:         "confirmed",
+			args:         args("service-version rollback --service-id 123"),
+			stdin:        "y\n",
+			wantOutput:   "Rolled back service 123 from version 1 to version 2",
+			wantActivate: []int{2},
+		},
+		{
+			name: "journaled activation",
+			args: args("service-version rollback --service-id 123 -y"),
+			journal: []journal.Entry{
+				activationEntry(time.Date(2000, 1, 5, 0, 0, 0, 0, time.UTC), 1, 3),
+			},
+			wantOutput:   "Rolled back service 123 from version 1 to version 3",
+			wantActivate: []int{3},
+		},
+		{
+			name: "steps",
+			args: args("service-version rollback --service-id 123 --steps 2 -y"),
+			journal: []journal.Entry{
+				activationEntry(time.Date(2000, 1, 5, 0, 0, 0, 0, time.UTC), 1, 3),
+			},
+			wantOutput:   "Rolled back service 123 from version 1 to version 2",
+			wantActivate: []int{2},
+		},
+		{
+			name:      "too many steps",
+			args:      args("service-version rollback --service-id 123 --steps 2 -y"),
+			wantError: "only 1 version(s) of service 123 are known to have been active before version 1",
+		},
+		{
+			name:         "to",
+			args:         args("service-version rollback --service-id 123 --to 3 -y"),
+			wantOutput:   "Rolled back service 123 from version 1 to version 3",
+			wantActivate: []int{3},
+		},
+		{
+			name:      "to the active version",
+			args:      args("service-version rollback --service-id 123 --to 1 -y"),
+			wantError: "version 1 of service 123 is already active",
+		},
+		{
+			name:      "to an unknown version",
+			args:      args("service-version rollback --service-id 123 --to 9 -y"),
+			wantError: "version 9 of service 123 not found",
+		},
+		{
+			name:      "to and steps",
+			args:      args("service-version rollback --service-id 123 --to 2 --steps 1"),
+			wantError: "--to and --steps can't be used together",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var activated []int
+			api := testutil.EmptyService(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					activated = append(activated, i.ServiceVersion)
+					return activateVersionOK(i)
+				},
+				GetServiceFn: func(i *fastly.GetServiceInput) (*fastly.Service, error) {
+					return &fastly.Service{ID: i.ID, ActiveVersion: 1}, nil
+				},
+				ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					if i.ServiceVersion != 1 {
+						return nil, nil
+					}
+					return []*fastly.Backend{{Name: "origin", Address: "127.0.0.1", Port: 80}}, nil
+				},
+			})
+
+			path := filepath.Join(t.TempDir(), "journal.json")
+			if err := journal.Write(path, testcase.journal); err != nil {
+				t.Fatal(err)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(api)
+			opts.JournalPath = path
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			testutil.AssertEqual(t, testcase.wantActivate, activated)
+		})
+	}
+}
+
+// activationEntry returns a journal entry recording the activation of a
+// version, replacing the previously active version.
+func activationEntry(at time.Time, version, previous int) journal.Entry {
+	input, _ := json.Marshal(fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: version})
+	before, _ := json.Marshal(fastly.Service{ID: "123", ActiveVersion: uint(previous)})
+	return journal.Entry{
+		ID:   1,
+		Time: at,
+		Args: []string{"service-version", "activate", "--service-id", "123", "--version", strconv.Itoa(version)},
+		Operations: []journal.Operation{
+			{Method: "ActivateVersion", Input: input, Before: before},
+		},
+	}
+}
+
+func TestVersionActivateGroup(t *testing.T) {
+	for _, testcase := range []struct {
+		name         string
+		group        string
+		invalid      string
+		fail         string
+		wantError    string
+		wantOutput   string
+		wantActivate []string
+	}{
+		{
+			name:         "success",
+			group:        "[[service]]\nid = \"a\"\nversion = \"latest\"\n[[service]]\nid = \"b\"\nversion = 2\n[[service]]\nid = \"c\"\n",
+			wantOutput:   activateGroupOutput,
+			wantActivate: []string{"a:3", "b:2", "c:3"},
+		},
+		{
+			name:         "already active",
+			group:        "[[service]]\nid = \"a\"\nversion = \"active\"\n[[service]]\nid = \"b\"\nversion = 3\n",
+			wantOutput:   "Service a: version 1 is already active\nService b: version 1 -> version 3\n",
+			wantActivate: []string{"b:3"},
+		},
+		{
+			name:         "activation fails",
+			group:        "[[service]]\nid = \"a\"\n[[service]]\nid = \"b\"\n[[service]]\nid = \"c\"\n",
+			fail:         "c",
+			wantError:    "error activating service c version 3: test error",
+			wantActivate: []string{"a:3", "b:3", "c:3", "b:1", "a:1"},
+		},
+		{
+			name:      "invalid versions",
+			group:     "[[service]]\nid = \"a\"\n[[service]]\nid = \"b\"\n",
+			invalid:   "b",
+			wantError: "invalid service versions, nothing was activated:\n\tservice b version 3: missing backend",
+		},
+		{
+			name:      "unknown version",
+			group:     "[[service]]\nid = \"a\"\nversion = 9\n",
+			wantError: `error selecting version "9" of service a: specified service version not found: 9`,
+		},
+		{
+			name:      "no services",
+			group:     "",
+			wantError: "lists no services",
+		},
+		{
+			name:      "duplicate service",
+			group:     "[[service]]\nid = \"a\"\n[[service]]\nid = \"a\"\n",
+			wantError: "error parsing group file: service a is listed more than once",
+		},
+		{
+			name:      "missing id",
+			group:     "[[service]]\nversion = 2\n",
+			wantError: "error parsing group file: service 1 has no id",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var activated []string
+			api := mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ValidateVersionFn: func(i *fastly.ValidateVersionInput) (bool, string, error) {
+					if i.ServiceID == testcase.invalid {
+						return false, "missing backend", nil
+					}
+					return true, "", nil
+				},
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					activated = append(activated, fmt.Sprintf("%s:%d", i.ServiceID, i.ServiceVersion))
+					if i.ServiceID == testcase.fail && i.ServiceVersion != 1 {
+						return nil, testutil.Err
+					}
+					return activateVersionOK(i)
+				},
+			}
+
+			path := filepath.Join(t.TempDir(), "group.toml")
+			if err := os.WriteFile(path, []byte(testcase.group), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("service-version activate-group -f "+path), &stdout)
+			opts.APIClient = mock.APIClient(api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			testutil.AssertEqual(t, testcase.wantActivate, activated)
+		})
+	}
+}
+
 func TestVersionDeactivate(t *testing.T) {
 	args := testutil.Args
 	for _, testcase := range []struct {
@@ -289,6 +767,69 @@ func TestVersionLock(t *testing.T) {
 	}
 }
 
+func TestVersionDiff(t *testing.T) {
+	args := testutil.Args
+	api := testutil.EmptyService(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			port := uint(80)
+			if i.ServiceVersion == 3 {
+				port = 443
+			}
+			return []*fastly.Backend{{Name: "origin", Address: "127.0.0.1", Port: port}}, nil
+		},
+		ListVCLsFn: func(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+			content := "sub vcl_recv {\n  set req.http.X = \"1\";\n}\n"
+			if i.ServiceVersion == 3 {
+				content = "sub vcl_recv {\n  set req.http.X = \"2\";\n}\n"
+			}
+			return []*fastly.VCL{{Name: "main", Main: true, Content: content}}, nil
+		},
+		ListS3sFn: func(i *fastly.ListS3sInput) ([]*fastly.S3, error) {
+			if i.ServiceVersion != 3 {
+				return nil, nil
+			}
+			return []*fastly.S3{{Name: "archive", BucketName: "logs", SecretKey: "secret"}}, nil
+		},
+	})
+
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("service-version diff"),
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			args:      args("service-version diff --service-id 123 --from 9"),
+			api:       api,
+			wantError: "specified service version not found: 9",
+		},
+		{
+			args:       args("service-version diff --service-id 123"),
+			api:        api,
+			wantOutput: diffVersionsOutput,
+		},
+		{
+			args:       args("service-version diff --service-id 123 --from 1 --to active"),
+			api:        api,
+			wantOutput: "\nINFO: No differences between service 123 version 1 and version 1.\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
 var listVersionsShortOutput = strings.TrimSpace(`
 NUMBER  ACTIVE  LAST EDITED (UTC)
 1       true    2000-01-01 01:00
@@ -389,3 +930,39 @@ func lockVersionOK(i *fastly.LockVersionInput) (*fastly.Version, error) {
 func lockVersionError(i *fastly.LockVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
+
+var diffVersionsOutput = strings.TrimLeft(`
+--- service 123 version 1
++++ service 123 version 3
+
+~ backend "origin"
+-	port = 80
++	port = 443
+
+~ vcl "main"
+ 	content:
+ 		sub vcl_recv {
+-		  set req.http.X = "1";
++		  set req.http.X = "2";
+ 		}
+
++ logging s3 "archive"
++	bucket_name = "logs"
++	secret_key = "<redacted>"
+
+1 added, 2 changed, 0 removed.
+`, "\n")
+
+var rollbackOutput = strings.TrimSpace(`
+Rolling back service 123 from version 1 to version 2.
+
+	- backend "origin"
+
+0 added, 0 changed, 1 removed.
+`) + "\n\n"
+
+var activateGroupOutput = strings.TrimSpace(`
+Service a: version 1 -> version 3
+Service b: version 1 -> version 2
+Service c: version 1 -> version 3
+`) + "\n"
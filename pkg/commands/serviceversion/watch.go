@@ -0,0 +1,172 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// retryInterval is how long to wait before fetching the stats again after an
+// error. The API usually holds successful requests open until new data is
+// available, but if a response has none the next second is waited for.
+var retryInterval = time.Second
+
+// thresholds are the maximum ratios of errors and 5xx responses to requests
+// tolerated in any one second while watching a newly activated version. A
+// zero value isn't checked.
+type thresholds struct {
+	errorRate float64
+	status5xx float64
+}
+
+// breach describes a second in which a threshold was exceeded.
+type breach struct {
+	recorded time.Time
+	metric   string
+	count    uint64
+	requests uint64
+	max      float64
+}
+
+func (b breach) String() string {
+	return fmt.Sprintf("%s of %s (%d of %d requests) exceeded the maximum of %s",
+		b.metric, formatRate(float64(b.count)/float64(b.requests)), b.count, b.requests, formatRate(b.max))
+}
+
+// realtimeStats is the subset of the realtime stats API response needed to
+// compute error rates.
+type realtimeStats struct {
+	Timestamp uint64 `json:"timestamp"`
+	Data      []struct {
+		Recorded   int64 `json:"recorded"`
+		Aggregated struct {
+			Requests  uint64 `json:"requests"`
+			Errors    uint64 `json:"errors"`
+			Status5xx uint64 `json:"status_5xx"`
+		} `json:"aggregated"`
+	} `json:"data"`
+}
+
+// watch polls the realtime stats of the service from since until the
+// duration elapses, and returns the first second in which the traffic
+// breached the thresholds, or nil if it didn't. Seconds recorded after the
+// deadline aren't checked. Errors fetching the stats are reported but don't
+// stop the watch.
+func watch(client api.RealtimeStatsInterface, serviceID string, since time.Time, d time.Duration, limits thresholds, verbose bool, out io.Writer) *breach {
+	deadline := since.Add(d)
+	var (
+		timestamp uint64
+		last      int64 // the most recent second checked
+	)
+	for time.Now().Before(deadline) {
+		envelope, ok, err := fetchStats(client, serviceID, timestamp, deadline)
+		if !ok {
+			return nil
+		}
+		if err != nil {
+			text.Warning(out, "Error fetching stats: %s", err)
+			sleepUntil(time.Now().Add(retryInterval), deadline)
+			continue
+		}
+		timestamp = envelope.Timestamp
+
+		var checked int
+		for _, block := range envelope.Data {
+			// The first response includes traffic from before the activation,
+			// and a second may be reported more than once.
+			if block.Recorded < since.Unix() || block.Recorded <= last {
+				continue
+			}
+			recorded := time.Unix(block.Recorded, 0).UTC()
+			if !recorded.Before(deadline) {
+				return nil
+			}
+			last = block.Recorded
+			checked++
+
+			agg := block.Aggregated
+			if verbose {
+				fmt.Fprintf(out, "%s  requests: %d  errors: %d  5xx: %d\n", recorded.Format(time.RFC3339), agg.Requests, agg.Errors, agg.Status5xx)
+			}
+			if agg.Requests == 0 {
+				continue
+			}
+			if exceeds(agg.Errors, agg.Requests, limits.errorRate) {
+				return &breach{recorded, "error rate", agg.Errors, agg.Requests, limits.errorRate}
+			}
+			if exceeds(agg.Status5xx, agg.Requests, limits.status5xx) {
+				return &breach{recorded, "5xx rate", agg.Status5xx, agg.Requests, limits.status5xx}
+			}
+		}
+
+		// Stats are recorded once a second, so there's nothing new to fetch
+		// before the next one.
+		if checked == 0 {
+			sleepUntil(time.Now().Truncate(time.Second).Add(time.Second), deadline)
+		}
+	}
+	return nil
+}
+
+// fetchStats fetches the realtime stats of the service recorded after
+// timestamp. The API holds the request open until new data is available, so
+// ok is false if it's still outstanding at the deadline, in which case it's
+// abandoned.
+func fetchStats(client api.RealtimeStatsInterface, serviceID string, timestamp uint64, deadline time.Time) (envelope realtimeStats, ok bool, err error) {
+	type result struct {
+		envelope realtimeStats
+		err      error
+	}
+	// The channel is buffered so that an abandoned request doesn't block
+	// forever once it completes.
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		r.err = client.GetRealtimeStatsJSON(&fastly.GetRealtimeStatsInput{
+			ServiceID: serviceID,
+			Timestamp: timestamp,
+		}, &r.envelope)
+		done <- r
+	}()
+
+	select {
+	case r := <-done:
+		return r.envelope, true, r.err
+	case <-time.After(time.Until(deadline)):
+		return envelope, false, nil
+	}
+}
+
+// sleepUntil sleeps until t, or until the deadline if that's sooner.
+func sleepUntil(t, deadline time.Time) {
+	if deadline.Before(t) {
+		t = deadline
+	}
+	time.Sleep(time.Until(t))
+}
+
+func exceeds(count, requests uint64, max float64) bool {
+	return max > 0 && float64(count)/float64(requests) > max
+}
+
+// parseRate converts a percentage, such as "2%" or "0.5", into a ratio.
+func parseRate(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	pct, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+	if err != nil || pct <= 0 || pct > 100 {
+		return 0, fmt.Errorf("invalid rate %q, must be a percentage between 0 and 100", s)
+	}
+	return pct / 100, nil
+}
+
+func formatRate(r float64) string {
+	return strconv.FormatFloat(r*100, 'f', -1, 64) + "%"
+}
@@ -0,0 +1,45 @@
+package session
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// AbortCommand ends the edit session without activating its draft version.
+type AbortCommand struct {
+	cmd.Base
+	sessionPath string
+}
+
+// NewAbortCommand returns a usable command registered under the parent.
+func NewAbortCommand(parent cmd.Registerer, sessionPath string, globals *config.Data) *AbortCommand {
+	var c AbortCommand
+	c.Globals = globals
+	c.sessionPath = sessionPath
+	c.CmdClause = parent.Command("abort", "Discard the draft version of the edit session and end the session")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *AbortCommand) Exec(in io.Reader, out io.Writer) error {
+	s := c.Globals.Session
+	if s == nil {
+		return errNoSession
+	}
+
+	if c.Globals.DryRun() {
+		return nil
+	}
+	if err := session.Remove(c.sessionPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Aborted edit session on service %s", s.ServiceID)
+	text.Info(out, "Version %d was left inactive. Service versions can't be deleted, but it has no effect unless it's activated.", s.Version)
+	return nil
+}
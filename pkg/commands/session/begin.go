@@ -0,0 +1,117 @@
+package session
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// BeginCommand clones a service version into a draft which is targeted by
+// subsequent commands.
+type BeginCommand struct {
+	cmd.Base
+	manifest    manifest.Data
+	sessionPath string
+}
+
+// NewBeginCommand returns a usable command registered under the parent.
+func NewBeginCommand(parent cmd.Registerer, sessionPath string, globals *config.Data) *BeginCommand {
+	var c BeginCommand
+	c.Globals = globals
+	c.sessionPath = sessionPath
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("begin", "Clone the active version of a service into a draft version, which subsequent commands target by default")
+	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *BeginCommand) Exec(in io.Reader, out io.Writer) error {
+	if s := c.Globals.Session; s != nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("an edit session is already in progress for service %s version %d", s.ServiceID, s.Version),
+			Remediation: "Run `fastly session commit` or `fastly session abort` to end it first.",
+		}
+	}
+
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	base, err := c.baseVersion(serviceID)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+		})
+		return err
+	}
+
+	draft, err := c.Globals.Client.CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: base.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": base.Number,
+		})
+		return fmt.Errorf("error cloning service version: %w", err)
+	}
+
+	if c.Globals.DryRun() {
+		return nil
+	}
+
+	s := &session.State{
+		ServiceID:   serviceID,
+		Version:     draft.Number,
+		BaseVersion: base.Number,
+		Started:     time.Now().UTC(),
+	}
+	if base.Active {
+		s.ActiveVersion = base.Number
+	}
+	if err := session.Write(c.sessionPath, s); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Began edit session on service %s: version %d cloned from version %d", serviceID, draft.Number, base.Number)
+	text.Info(out, "Commands now target version %d of the service unless --service-id or --version is given. Run `fastly session commit` to validate your changes.", draft.Number)
+	return nil
+}
+
+// baseVersion returns the active version of the service, or the latest
+// version if none is active.
+func (c *BeginCommand) baseVersion(serviceID string) (*fastly.Version, error) {
+	vs, err := c.Globals.Client.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing service versions: %w", err)
+	}
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("service %s has no versions", serviceID)
+	}
+
+	if v, err := cmd.GetActiveVersion(vs); err == nil {
+		return v, nil
+	}
+	latest := vs[0]
+	for _, v := range vs {
+		if v.Number > latest.Number {
+			latest = v
+		}
+	}
+	return latest, nil
+}
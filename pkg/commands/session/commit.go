@@ -0,0 +1,99 @@
+package session
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CommitCommand validates the draft version of the edit session, optionally
+// activates it, and ends the session.
+type CommitCommand struct {
+	cmd.Base
+	sessionPath string
+	activate    bool
+	force       bool
+}
+
+// NewCommitCommand returns a usable command registered under the parent.
+func NewCommitCommand(parent cmd.Registerer, sessionPath string, globals *config.Data) *CommitCommand {
+	var c CommitCommand
+	c.Globals = globals
+	c.sessionPath = sessionPath
+	c.CmdClause = parent.Command("commit", "Validate the draft version of the edit session and end the session")
+	c.CmdClause.Flag("activate", "Activate the draft version once it's validated").BoolVar(&c.activate)
+	c.CmdClause.Flag("force", "Activate even if another version was activated since the session began").BoolVar(&c.force)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CommitCommand) Exec(in io.Reader, out io.Writer) error {
+	s := c.Globals.Session
+	if s == nil {
+		return errNoSession
+	}
+
+	valid, msg, err := c.Globals.Client.ValidateVersion(&fastly.ValidateVersionInput{
+		ServiceID:      s.ServiceID,
+		ServiceVersion: s.Version,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      s.ServiceID,
+			"Service Version": s.Version,
+		})
+		return fmt.Errorf("error validating service version: %w", err)
+	}
+	if !valid {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("service version %d is invalid: %s", s.Version, msg),
+			Remediation: "Fix the problems and run `fastly session commit` again, or discard the draft with `fastly session abort`.",
+		}
+	}
+
+	if c.activate {
+		guard := cmd.ActiveVersionGuard{Force: c.force}
+		guard.Set(s.ServiceID, s.ActiveVersion)
+		if err := guard.Check(c.Globals.Client); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      s.ServiceID,
+				"Service Version": s.Version,
+			})
+			return err
+		}
+
+		_, err := c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      s.ServiceID,
+			ServiceVersion: s.Version,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      s.ServiceID,
+				"Service Version": s.Version,
+			})
+			return err
+		}
+	}
+
+	if c.Globals.DryRun() {
+		return nil
+	}
+	if err := session.Remove(c.sessionPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.activate {
+		text.Success(out, "Activated service %s version %d", s.ServiceID, s.Version)
+		return nil
+	}
+	text.Success(out, "Validated service %s version %d", s.ServiceID, s.Version)
+	text.Info(out, "The version isn't active yet. Run `fastly service-version activate --service-id %s --version %d` to activate it.", s.ServiceID, s.Version)
+	return nil
+}
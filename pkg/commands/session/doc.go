@@ -0,0 +1,3 @@
+// Package session contains commands to batch changes to a service into a
+// single cloned version, which is validated and activated as a whole.
+package session
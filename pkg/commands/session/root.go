@@ -0,0 +1,37 @@
+package session
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+)
+
+// RootCommand is the parent command for all subcommands in this package.
+// It should be installed under the primary root command.
+type RootCommand struct {
+	cmd.Base
+	// no flags
+}
+
+// NewRootCommand returns a new command registered in the parent.
+func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
+	var c RootCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("session", "Batch changes to a service into a single draft version")
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
+	panic("unreachable")
+}
+
+// errNoSession is returned by the commands which end an edit session when
+// there isn't one in progress.
+var errNoSession = errors.RemediationError{
+	Inner:       fmt.Errorf("no edit session in progress"),
+	Remediation: "Run `fastly session begin` to start one.",
+}
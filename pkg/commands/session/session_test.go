@@ -0,0 +1,580 @@
+package session_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+var errTest = errors.New("fixture error")
+
+var fixtureSession = &session.State{
+	ServiceID:     "123",
+	Version:       4,
+	BaseVersion:   1,
+	ActiveVersion: 1,
+	Started:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestBegin(t *testing.T) {
+	var stdout bytes.Buffer
+	path := filepath.Join(t.TempDir(), "session.json")
+	opts := testutil.NewRunOpts(testutil.Args("session begin --service-id 123"), &stdout)
+	opts.SessionPath = path
+	opts.APIClient = mock.APIClient(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		CloneVersionFn: testutil.CloneVersionResult(4),
+	})
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Began edit session on service 123: version 4 cloned from version 1")
+
+	s, err := session.Read(path)
+	testutil.AssertNoError(t, err)
+	if s == nil || s.ServiceID != "123" || s.Version != 4 || s.BaseVersion != 1 || s.ActiveVersion != 1 {
+		t.Fatalf("want session for service 123 version 4, have %+v", s)
+	}
+}
+
+func TestBeginErrors(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		session *session.State
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "session in progress",
+				Args:      args("session begin --service-id 123"),
+				WantError: "an edit session is already in progress for service 123 version 4",
+			},
+			session: fixtureSession,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate missing --service-id flag",
+				Args:      args("session begin"),
+				WantError: "error reading service: no service ID found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "service without versions",
+				Args: args("session begin --service-id 123"),
+				API: mock.API{
+					ListVersionsFn: func(*fastly.ListVersionsInput) ([]*fastly.Version, error) {
+						return []*fastly.Version{}, nil
+					},
+				},
+				WantError: "service 123 has no versions",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "clone error",
+				Args: args("session begin --service-id 123"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					CloneVersionFn: testutil.CloneVersionError,
+				},
+				WantError: "error cloning service version",
+			},
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			path := writeSession(t, testcase.session)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.SessionPath = path
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+
+			s, err := session.Read(path)
+			testutil.AssertNoError(t, err)
+			testutil.AssertEqual(t, testcase.session, s)
+		})
+	}
+}
+
+func TestSessionDefaults(t *testing.T) {
+	var created *fastly.CreateBackendInput
+	api := mock.API{
+		ListVersionsFn: listVersions,
+		CreateBackendFn: func(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+			created = i
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+	}
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:       "targets the draft version",
+			Args:       args("backend create --name www.test.com --address 127.0.0.1"),
+			API:        api,
+			WantOutput: "Created backend www.test.com (service 123 version 4)",
+		},
+		{
+			Name:       "explicit version",
+			Args:       args("backend create --version 3 --name www.test.com --address 127.0.0.1"),
+			API:        api,
+			WantOutput: "Created backend www.test.com (service 123 version 3)",
+		},
+		{
+			Name:       "other service with explicit version",
+			Args:       args("backend create --service-id 456 --version 3 --name www.test.com --address 127.0.0.1"),
+			API:        api,
+			WantOutput: "Created backend www.test.com (service 456 version 3)",
+		},
+		{
+			Name:      "other service without version",
+			Args:      args("backend create --service-id 456 --name www.test.com --address 127.0.0.1"),
+			API:       api,
+			WantError: "--version is required for service 456, as the edit session is for service 123",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			created = nil
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.SessionPath = writeSession(t, fixtureSession)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			if err == nil && created == nil {
+				t.Fatal("want backend created, have none")
+			}
+		})
+	}
+}
+
+// TestSessionServicePrecedence checks that the session's service is only used
+// when the service ID isn't provided by the flag, environment or manifest.
+func TestSessionServicePrecedence(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn: listVersions,
+		CreateBackendFn: func(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+	}
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		env        string
+		manifest   string
+		wantError  string
+		wantOutput []string
+		wantQuiet  bool
+	}{
+		{
+			name:       "session without verbose",
+			args:       args("backend create --name www.test.com --address 127.0.0.1"),
+			wantOutput: []string{"(service 123 version 4)"},
+			wantQuiet:  true,
+		},
+		{
+			name:       "session",
+			args:       args("backend create --verbose --name www.test.com --address 127.0.0.1"),
+			wantOutput: []string{"Service ID (via the edit session): 123", "(service 123 version 4)"},
+		},
+		{
+			name:      "environment without version",
+			args:      args("backend create --name www.test.com --address 127.0.0.1"),
+			env:       "456",
+			wantError: "--version is required for service 456, as the edit session is for service 123",
+		},
+		{
+			name:       "environment with version",
+			args:       args("backend create --verbose --version 3 --name www.test.com --address 127.0.0.1"),
+			env:        "456",
+			wantOutput: []string{"Service ID (via FASTLY_SERVICE_ID): 456", "(service 456 version 3)"},
+		},
+		{
+			name:       "manifest with version",
+			args:       args("backend create --verbose --version 3 --name www.test.com --address 127.0.0.1"),
+			manifest:   "manifest_version = 1\nservice_id = \"789\"\n",
+			wantOutput: []string{"Service ID (via fastly.toml): 789", "(service 789 version 3)"},
+		},
+		{
+			name:       "manifest matching the session",
+			args:       args("backend create --verbose --name www.test.com --address 127.0.0.1"),
+			manifest:   "manifest_version = 1\nservice_id = \"123\"\n",
+			wantOutput: []string{"Service ID (via fastly.toml): 123", "(service 123 version 4)"},
+		},
+		{
+			name:       "flag takes precedence",
+			args:       args("backend create --verbose --service-id 123 --name www.test.com --address 127.0.0.1"),
+			env:        "456",
+			wantOutput: []string{"Service ID (via --service-id): 123", "(service 123 version 4)"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if testcase.env != "" {
+				os.Setenv("FASTLY_SERVICE_ID", testcase.env)
+				defer os.Unsetenv("FASTLY_SERVICE_ID")
+			}
+			if testcase.manifest != "" {
+				wd, err := os.Getwd()
+				if err != nil {
+					t.Fatal(err)
+				}
+				dir := t.TempDir()
+				if err := os.WriteFile(filepath.Join(dir, "fastly.toml"), []byte(testcase.manifest), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chdir(dir); err != nil {
+					t.Fatal(err)
+				}
+				defer os.Chdir(wd)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.SessionPath = writeSession(t, fixtureSession)
+			opts.APIClient = mock.APIClient(api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantQuiet {
+				testutil.AssertStringDoesntContain(t, stdout.String(), "Service ID (via")
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		session *session.State
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "no session",
+				Args:       args("session status"),
+				WantOutput: "No edit session in progress.",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "no changes",
+				Args:       args("session status"),
+				API:        testutil.EmptyService(mock.API{}),
+				WantOutput: statusNoChangesOutput,
+			},
+			session: fixtureSession,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "pending changes",
+				Args: args("session status"),
+				API: testutil.EmptyService(mock.API{
+					ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+						if i.ServiceVersion != 4 {
+							return nil, nil
+						}
+						return []*fastly.Backend{{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "127.0.0.1"}}, nil
+					},
+				}),
+				WantOutput: statusChangesOutput,
+			},
+			session: fixtureSession,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "fetch error",
+				Args:      args("session status"),
+				API:       testutil.EmptyService(mock.API{ListDomainsFn: func(*fastly.ListDomainsInput) ([]*fastly.Domain, error) { return nil, errTest }}),
+				WantError: errTest.Error(),
+			},
+			session: fixtureSession,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.SessionPath = writeSession(t, testcase.session)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCommit(t *testing.T) {
+	var activated *fastly.ActivateVersionInput
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		session     *session.State
+		wantSession bool
+		wantActive  bool
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "no session",
+				Args:      args("session commit"),
+				WantError: "no edit session in progress",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "invalid version",
+				Args:      args("session commit --activate"),
+				API:       mock.API{ValidateVersionFn: validateVersion(false)},
+				WantError: "service version 4 is invalid: missing backend",
+			},
+			session:     fixtureSession,
+			wantSession: true,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate error",
+				Args:      args("session commit"),
+				API:       mock.API{ValidateVersionFn: func(*fastly.ValidateVersionInput) (bool, string, error) { return false, "", errTest }},
+				WantError: "error validating service version: fixture error",
+			},
+			session:     fixtureSession,
+			wantSession: true,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "activate error",
+				Args: args("session commit --activate"),
+				API: mock.API{
+					ListVersionsFn:    listVersions,
+					ValidateVersionFn: validateVersion(true),
+					ActivateVersionFn: func(*fastly.ActivateVersionInput) (*fastly.Version, error) { return nil, errTest },
+				},
+				WantError: errTest.Error(),
+			},
+			session:     fixtureSession,
+			wantSession: true,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "validate only",
+				Args:       args("session commit"),
+				API:        mock.API{ValidateVersionFn: validateVersion(true)},
+				WantOutput: "Validated service 123 version 4",
+			},
+			session: fixtureSession,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "activated concurrently",
+				Args: args("session commit --activate"),
+				API: mock.API{
+					ListVersionsFn:    listVersionsActivated,
+					ValidateVersionFn: validateVersion(true),
+				},
+				WantError: "the active version of service 123 changed from version 1 to version 3 while this command was running",
+			},
+			session:     fixtureSession,
+			wantSession: true,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "activated concurrently with --force",
+				Args: args("session commit --activate --force"),
+				API: mock.API{
+					ListVersionsFn:    listVersionsActivated,
+					ValidateVersionFn: validateVersion(true),
+					ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+						activated = i
+						return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion, Active: true}, nil
+					},
+				},
+				WantOutput: "Activated service 123 version 4",
+			},
+			session:    fixtureSession,
+			wantActive: true,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "activate",
+				Args: args("session commit --activate"),
+				API: mock.API{
+					ListVersionsFn:    listVersions,
+					ValidateVersionFn: validateVersion(true),
+					ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+						activated = i
+						return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion, Active: true}, nil
+					},
+				},
+				WantOutput: "Activated service 123 version 4",
+			},
+			session:    fixtureSession,
+			wantActive: true,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			activated = nil
+			path := writeSession(t, testcase.session)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.SessionPath = path
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+
+			s, err := session.Read(path)
+			testutil.AssertNoError(t, err)
+			if testcase.wantSession != (s != nil) {
+				t.Fatalf("want session in progress %t, have %+v", testcase.wantSession, s)
+			}
+			if testcase.wantActive {
+				testutil.AssertEqual(t, &fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 4}, activated)
+			}
+		})
+	}
+}
+
+// TestActivateDraft checks that activating the session's draft outside of
+// session commit detects activations made since the session began, even
+// though the active version wasn't looked up until the command ran.
+func TestActivateDraft(t *testing.T) {
+	activate := func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+		return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion}, nil
+	}
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "no activation since the session began",
+			Args: args("service-version activate"),
+			API: mock.API{
+				ListVersionsFn:    listVersions,
+				ActivateVersionFn: activate,
+			},
+			WantOutput: "Activated service 123 version 4",
+		},
+		{
+			Name: "activation since the session began",
+			Args: args("service-version activate"),
+			API: mock.API{
+				ListVersionsFn:    listVersionsActivated,
+				ActivateVersionFn: activate,
+			},
+			WantError: "the active version of service 123 changed from version 1 to version 3 while this command was running",
+		},
+		{
+			Name: "forced",
+			Args: args("service-version activate --force"),
+			API: mock.API{
+				ListVersionsFn:    listVersionsActivated,
+				ActivateVersionFn: activate,
+			},
+			WantOutput: "Activated service 123 version 4",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.SessionPath = writeSession(t, fixtureSession)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestAbort(t *testing.T) {
+	path := writeSession(t, fixtureSession)
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("session abort"), &stdout)
+	opts.SessionPath = path
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Aborted edit session on service 123")
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("want session removed, have %v", err)
+	}
+
+	stdout.Reset()
+	opts = testutil.NewRunOpts(testutil.Args("session abort"), &stdout)
+	opts.SessionPath = path
+	err = app.Run(opts)
+	testutil.AssertErrorContains(t, err, "no edit session in progress")
+}
+
+// writeSession stores s in a temporary session file, or leaves it absent if s
+// is nil, and returns its path.
+func writeSession(t *testing.T, s *session.State) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "session.json")
+	if s != nil {
+		if err := session.Write(path, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+// listVersions returns the versions of the fixture session's service, where
+// version 4 is the draft.
+func listVersions(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	vs, _ := testutil.ListVersions(i)
+	return append(vs, &fastly.Version{ServiceID: i.ServiceID, Number: 4}), nil
+}
+
+// listVersionsActivated returns the versions of the fixture session's service
+// after version 3 was activated by someone else.
+func listVersionsActivated(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	vs, _ := listVersions(i)
+	vs[0].Active = false
+	vs[2].Active = true
+	return vs, nil
+}
+
+func validateVersion(valid bool) func(*fastly.ValidateVersionInput) (bool, string, error) {
+	return func(*fastly.ValidateVersionInput) (bool, string, error) {
+		if valid {
+			return true, "", nil
+		}
+		return false, "missing backend", nil
+	}
+}
+
+var statusNoChangesOutput = `Service ID: 123
+Version: 4
+Cloned from version: 1
+Started: 2021-06-01T12:00:00Z
+
+INFO: No changes have been made to version 4.
+`
+
+var statusChangesOutput = `Service ID: 123
+Version: 4
+Cloned from version: 1
+Started: 2021-06-01T12:00:00Z
+
+Pending changes:
+	+ backend "origin"
+
+1 added, 0 changed, 0 removed.
+`
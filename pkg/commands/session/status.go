@@ -0,0 +1,87 @@
+package session
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// StatusCommand describes the edit session in progress, including the changes
+// made to the draft version.
+type StatusCommand struct {
+	cmd.Base
+}
+
+// NewStatusCommand returns a usable command registered under the parent.
+func NewStatusCommand(parent cmd.Registerer, globals *config.Data) *StatusCommand {
+	var c StatusCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("status", "Show the edit session in progress and the changes made to its draft version")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *StatusCommand) Exec(in io.Reader, out io.Writer) error {
+	s := c.Globals.Session
+	if s == nil {
+		if c.Globals.JSON() {
+			return c.WriteJSON(out, nil)
+		}
+		text.Info(out, "No edit session in progress.")
+		return nil
+	}
+
+	base, err := c.fetch(s.ServiceID, s.BaseVersion)
+	if err != nil {
+		return err
+	}
+	draft, err := c.fetch(s.ServiceID, s.Version)
+	if err != nil {
+		return err
+	}
+	plan := definition.NewPlan(base, draft)
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, struct {
+			*session.State
+			Changes []definition.Change `json:"changes"`
+		}{s, plan.Changes})
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", s.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", s.Version)
+	fmt.Fprintf(out, "Cloned from version: %d\n", s.BaseVersion)
+	fmt.Fprintf(out, "Started: %s\n", s.Started.Format(time.RFC3339))
+
+	if plan.Empty() {
+		text.Info(out, "No changes have been made to version %d.", s.Version)
+		return nil
+	}
+	fmt.Fprintf(out, "\nPending changes:\n")
+	for _, ch := range plan.Changes {
+		fmt.Fprintf(out, "\t%s\n", ch)
+	}
+	text.Break(out)
+	text.Output(out, "%d added, %d changed, %d removed.", plan.Count(definition.Create), plan.Count(definition.Update), plan.Count(definition.Delete))
+	return nil
+}
+
+// fetch returns the redacted definition of a service version.
+func (c *StatusCommand) fetch(serviceID string, version int) (*definition.Service, error) {
+	s, err := definition.Fetch(c.Globals.Client, serviceID, version)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return nil, err
+	}
+	s.Redact()
+	return s, nil
+}
@@ -0,0 +1,3 @@
+// Package tls contains commands that report on TLS across the whole account,
+// spanning both custom certificates and Fastly managed subscriptions.
+package tls
@@ -0,0 +1,377 @@
+package tls
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// pageSize is the number of records requested per page when enumerating
+// certificates and subscriptions.
+const pageSize = 100
+
+// ReportCommand enumerates every TLS certificate in the account and reports
+// when each expires and which service domains rely on it.
+type ReportCommand struct {
+	cmd.Base
+	expiringWithin string
+	certFiles      []string
+}
+
+// Certificate is a single line of the report.
+type Certificate struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Subscription string          `json:"subscription,omitempty"`
+	NotAfter     *time.Time      `json:"not_after"`
+	DaysLeft     int             `json:"days_left"`
+	Expiring     bool            `json:"expiring"`
+	Domains      []string        `json:"domains"`
+	Services     []ServiceDomain `json:"services"`
+}
+
+// ServiceDomain is a domain on a service's active version that is covered by
+// a certificate.
+type ServiceDomain struct {
+	ServiceID   string `json:"service_id"`
+	ServiceName string `json:"service_name"`
+	Version     int    `json:"version"`
+	Domain      string `json:"domain"`
+}
+
+// NewReportCommand returns a usable command registered under the parent.
+func NewReportCommand(parent cmd.Registerer, globals *config.Data) *ReportCommand {
+	var c ReportCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("report", "Report when each custom and subscription certificate expires, and the service domains that use it. Exits non-zero if any certificate expires within the threshold")
+	c.CmdClause.Flag("expiring-within", "Threshold for flagging a certificate as expiring, e.g. 30d or 72h").Default("30d").StringVar(&c.expiringWithin)
+	c.CmdClause.Flag("cert-file", "Path to the PEM encoded copy of a certificate in the account, whose SANs are used in place of the domains returned by the API. Can be given multiple times").StringsVar(&c.certFiles)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ReportCommand) Exec(in io.Reader, out io.Writer) error {
+	threshold, err := parseThreshold(c.expiringWithin)
+	if err != nil {
+		return err
+	}
+	pems, err := readCertFiles(c.certFiles)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	certs, err := c.certificates()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	domains, err := c.serviceDomains()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	report := buildReport(certs, pems, domains, time.Now(), threshold)
+
+	var expiring int
+	for _, cert := range report {
+		if cert.Expiring {
+			expiring++
+		}
+	}
+
+	if c.Globals.JSON() {
+		if err := c.WriteJSON(out, report); err != nil {
+			return err
+		}
+	} else {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "SUBSCRIPTION", "SERVICES", "NOT AFTER", "DAYS LEFT")
+		for _, cert := range report {
+			tw.AddLine(cert.ID, cert.Name, cert.Subscription, serviceIDs(cert.Services), formatTime(cert.NotAfter), daysLeft(cert))
+		}
+		tw.Print()
+	}
+
+	if expiring > 0 {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("%d certificate(s) expire within %s", expiring, c.expiringWithin),
+			Remediation: "Renew or replace the certificates reported above. Use `fastly tls-custom certificate update` for custom certificates.",
+		}
+	}
+	return nil
+}
+
+// subscriptionCertificate is a certificate along with the ID of the TLS
+// subscription that manages it, if any.
+type subscriptionCertificate struct {
+	*fastly.CustomTLSCertificate
+	subscription string
+}
+
+// certificates fetches every custom certificate and every certificate issued
+// for a TLS subscription. Subscription certificates are fetched individually
+// unless the custom certificate listing already included them.
+func (c *ReportCommand) certificates() ([]subscriptionCertificate, error) {
+	var (
+		result []subscriptionCertificate
+		byID   = make(map[string]int)
+	)
+
+	for page := 1; ; page++ {
+		certs, err := c.Globals.Client.ListCustomTLSCertificates(&fastly.ListCustomTLSCertificatesInput{
+			PageNumber: page,
+			PageSize:   pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			byID[cert.ID] = len(result)
+			result = append(result, subscriptionCertificate{CustomTLSCertificate: cert})
+		}
+		if len(certs) < pageSize {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		subscriptions, err := c.Globals.Client.ListTLSSubscriptions(&fastly.ListTLSSubscriptionsInput{
+			PageNumber: page,
+			PageSize:   pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range subscriptions {
+			for _, sc := range s.Certificates {
+				if i, ok := byID[sc.ID]; ok {
+					result[i].subscription = s.ID
+					continue
+				}
+				cert, err := c.Globals.Client.GetCustomTLSCertificate(&fastly.GetCustomTLSCertificateInput{ID: sc.ID})
+				if err != nil {
+					return nil, err
+				}
+				byID[cert.ID] = len(result)
+				result = append(result, subscriptionCertificate{CustomTLSCertificate: cert, subscription: s.ID})
+			}
+		}
+		if len(subscriptions) < pageSize {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// serviceDomains fetches the domains of the active version of every service
+// in the account. Services without an active version are skipped, as their
+// domains aren't serving traffic.
+func (c *ReportCommand) serviceDomains() ([]ServiceDomain, error) {
+	services, err := c.Globals.Client.ListServices(&fastly.ListServicesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ServiceDomain
+	for _, s := range services {
+		if s.ActiveVersion == 0 {
+			continue
+		}
+		domains, err := c.Globals.Client.ListDomains(&fastly.ListDomainsInput{
+			ServiceID:      s.ID,
+			ServiceVersion: int(s.ActiveVersion),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range domains {
+			result = append(result, ServiceDomain{
+				ServiceID:   s.ID,
+				ServiceName: s.Name,
+				Version:     int(s.ActiveVersion),
+				Domain:      d.Name,
+			})
+		}
+	}
+	return result, nil
+}
+
+// buildReport cross-references certificates with the service domains their
+// SANs cover, and sorts the result so the soonest to expire come first.
+// Certificates without an expiry date are sorted last.
+//
+// The API doesn't return the PEM of a certificate, so the SANs are taken from
+// the parsed copy in pems with the same serial number, and otherwise from the
+// domains the API associates with the certificate.
+func buildReport(certs []subscriptionCertificate, pems map[string]*x509.Certificate, domains []ServiceDomain, now time.Time, threshold time.Duration) []Certificate {
+	report := make([]Certificate, 0, len(certs))
+	for _, cert := range certs {
+		r := Certificate{
+			ID:           cert.ID,
+			Name:         cert.Name,
+			Subscription: cert.subscription,
+			NotAfter:     cert.NotAfter,
+		}
+		if parsed, ok := pems[serialNumber(cert.SerialNumber)]; ok {
+			r.Domains = parsed.DNSNames
+		} else {
+			for _, d := range cert.Domains {
+				r.Domains = append(r.Domains, d.ID)
+			}
+		}
+		for _, sd := range domains {
+			if covers(r.Domains, sd.Domain) {
+				r.Services = append(r.Services, sd)
+			}
+		}
+		if cert.NotAfter != nil {
+			r.DaysLeft = int(cert.NotAfter.Sub(now).Hours() / 24)
+			r.Expiring = cert.NotAfter.Before(now.Add(threshold))
+		}
+		report = append(report, r)
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		a, b := report[i].NotAfter, report[j].NotAfter
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return a.Before(*b)
+		}
+	})
+	return report
+}
+
+// readCertFiles parses the PEM encoded certificates at the given paths, keyed
+// by both the decimal and hex form of their serial number (see serialNumber),
+// as either may be returned by the API.
+func readCertFiles(paths []string) (map[string]*x509.Certificate, error) {
+	result := make(map[string]*x509.Certificate)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := parseCertificate(data)
+		if err != nil {
+			return nil, errors.RemediationError{
+				Inner:       fmt.Errorf("error parsing %s: %w", path, err),
+				Remediation: "Provide the PEM encoded certificate, as uploaded with `fastly tls-custom certificate create`.",
+			}
+		}
+		result[serialNumber(cert.SerialNumber.String())] = cert
+		result[serialNumber(hex.EncodeToString(cert.SerialNumber.Bytes()))] = cert
+	}
+	return result, nil
+}
+
+// parseCertificate parses the first certificate in a PEM encoded blob, which
+// is the leaf when the blob is a chain.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// serialNumber normalises a serial number so that the API's representation
+// and that of a parsed certificate compare equal, ignoring case, colon
+// separators and leading zeros.
+func serialNumber(s string) string {
+	s = strings.ToLower(strings.ReplaceAll(s, ":", ""))
+	return strings.TrimLeft(s, "0")
+}
+
+// covers reports whether any of the SANs matches the domain. A wildcard SAN
+// matches exactly one leftmost label, as it does when validating a
+// certificate.
+func covers(sans []string, domain string) bool {
+	domain = strings.ToLower(domain)
+	for _, san := range sans {
+		san = strings.ToLower(san)
+		if san == domain {
+			return true
+		}
+		if strings.HasPrefix(san, "*.") {
+			i := strings.IndexByte(domain, '.')
+			if i > 0 && domain[i:] == san[1:] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseThreshold parses a duration that, in addition to the units understood
+// by time.ParseDuration, may be given in whole days, e.g. 30d.
+func parseThreshold(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err == nil && days >= 0 {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	} else if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return d, nil
+	}
+	return 0, errors.RemediationError{
+		Inner:       fmt.Errorf("invalid --expiring-within value %q", s),
+		Remediation: "Provide a number of days, e.g. 30d, or a duration such as 72h.",
+	}
+}
+
+// serviceIDs renders the unique IDs of the services using a certificate.
+func serviceIDs(domains []ServiceDomain) string {
+	var (
+		ids  []string
+		seen = make(map[string]bool)
+	)
+	for _, d := range domains {
+		if !seen[d.ServiceID] {
+			seen[d.ServiceID] = true
+			ids = append(ids, d.ServiceID)
+		}
+	}
+	return strings.Join(ids, ", ")
+}
+
+// daysLeft renders the days until a certificate expires, or "unknown" when
+// the API didn't return an expiry date.
+func daysLeft(c Certificate) string {
+	if c.NotAfter == nil {
+		return "unknown"
+	}
+	return strconv.Itoa(c.DaysLeft)
+}
+
+// formatTime renders an optional API timestamp, leaving it blank when unset.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,224 @@
+package tls_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestReport(t *testing.T) {
+	args := testutil.Args
+	api := mock.API{
+		ListCustomTLSCertificatesFn: listCertificatesOK,
+		ListTLSSubscriptionsFn:      listSubscriptionsOK,
+		GetCustomTLSCertificateFn:   getCertificateOK,
+		ListServicesFn:              listServicesOK,
+		ListDomainsFn:               listDomainsOK,
+	}
+	certFile := writeCertificate(t, 0x1234, "*.example.org")
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls report --expiring-within soon"),
+			WantError: "invalid --expiring-within value \"soon\"",
+		},
+		{
+			Args: args("tls report"),
+			API: mock.API{
+				ListCustomTLSCertificatesFn: listCertificatesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("tls report"),
+			API: mock.API{
+				ListCustomTLSCertificatesFn: listCertificatesOK,
+				ListTLSSubscriptionsFn:      listSubscriptionsOK,
+				GetCustomTLSCertificateFn:   getCertificateOK,
+				ListServicesFn:              listServicesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("tls report --expiring-within 7d"),
+			API:  api,
+			WantOutputs: []string{
+				"ID      NAME     SUBSCRIPTION  SERVICES  NOT AFTER             DAYS LEFT",
+				"cert-1  example                123, 456",
+				"cert-3  managed  sub-1         123",
+				"cert-2  spare    sub-1",
+				"10\n",
+			},
+		},
+		{
+			Args:      args("tls report --expiring-within 30d"),
+			API:       api,
+			WantError: "1 certificate(s) expire within 30d",
+			WantOutputs: []string{
+				"cert-1  example                123, 456",
+			},
+		},
+		{
+			Args:      args("tls report --expiring-within 2400h --json"),
+			API:       api,
+			WantError: "2 certificate(s) expire within 2400h",
+			WantOutputs: []string{
+				`"id": "cert-3"`,
+				`"subscription": "sub-1"`,
+				`"service_id": "123"`,
+				`"domain": "api.example.net"`,
+				`"expiring": true`,
+			},
+		},
+		{
+			Args: args("tls report --expiring-within 7d --cert-file " + certFile),
+			API:  api,
+			WantOutputs: []string{
+				"cert-1  example                123, 456",
+				"cert-2  spare    sub-1         456",
+			},
+		},
+		{
+			Args:      args("tls report --cert-file " + filepath.Join(filepath.Dir(certFile), "missing.pem")),
+			API:       api,
+			WantError: "no such file or directory",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+// writeCertificate writes a self-signed PEM encoded certificate with the
+// given serial number and SANs to a temporary file, returning its path.
+func writeCertificate(t *testing.T, serial int64, sans ...string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		DNSNames:     sans,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(200 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// expiresIn returns a time the given number of days from now, padded by an
+// hour so the whole number of days left is stable while the test runs.
+func expiresIn(days int) *time.Time {
+	t := time.Now().Add(time.Duration(days)*24*time.Hour + time.Hour)
+	return &t
+}
+
+func listCertificatesOK(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	if i.PageNumber > 1 {
+		return nil, nil
+	}
+	return []*fastly.CustomTLSCertificate{
+		{
+			ID:           "cert-2",
+			Name:         "spare",
+			NotAfter:     expiresIn(200),
+			SerialNumber: "12:34",
+			Domains:      []*fastly.TLSDomain{{ID: "spare.example.org"}},
+		},
+		{
+			ID:       "cert-1",
+			Name:     "example",
+			NotAfter: expiresIn(10),
+			Domains:  []*fastly.TLSDomain{{ID: "www.example.com"}, {ID: "example.com"}},
+		},
+	}, nil
+}
+
+func listCertificatesError(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return nil, errTest
+}
+
+func listSubscriptionsOK(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return []*fastly.TLSSubscription{
+		{
+			ID: "sub-1",
+			Certificates: []*fastly.TLSSubscriptionCertificate{
+				{ID: "cert-2"},
+				{ID: "cert-3"},
+			},
+		},
+	}, nil
+}
+
+func getCertificateOK(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if i.ID != "cert-3" {
+		return nil, errTest
+	}
+	return &fastly.CustomTLSCertificate{
+		ID:       "cert-3",
+		Name:     "managed",
+		NotAfter: expiresIn(60),
+		Domains:  []*fastly.TLSDomain{{ID: "*.example.net"}},
+	}, nil
+}
+
+func listServicesOK(i *fastly.ListServicesInput) ([]*fastly.Service, error) {
+	return []*fastly.Service{
+		{ID: "123", Name: "Foo", ActiveVersion: 2},
+		{ID: "456", Name: "Bar", ActiveVersion: 1},
+		{ID: "789", Name: "Baz"},
+	}, nil
+}
+
+func listServicesError(i *fastly.ListServicesInput) ([]*fastly.Service, error) {
+	return nil, errTest
+}
+
+func listDomainsOK(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	switch i.ServiceID {
+	case "123":
+		return []*fastly.Domain{
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.com"},
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "api.example.net"},
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "a.b.example.net"},
+		}, nil
+	case "456":
+		return []*fastly.Domain{
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "EXAMPLE.com"},
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.org"},
+		}, nil
+	}
+	return nil, errTest
+}
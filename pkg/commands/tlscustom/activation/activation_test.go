@@ -0,0 +1,259 @@
+package activation_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestActivationCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom activation create --cert-id cert-1"),
+			WantError: "error parsing arguments: required flag --domain not provided",
+		},
+		{
+			Args:      args("tls-custom activation create --cert-id cert-1 --domain www.example.com"),
+			API:       mock.API{CreateTLSActivationFn: createActivationError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom activation enable --cert-id cert-1 --domain www.example.com --config-id conf-1"),
+			API:        mock.API{CreateTLSActivationFn: createActivationOK},
+			WantOutput: "Enabled TLS for domain www.example.com using certificate cert-1 (activation ID act-1)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestActivationList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:       args("tls-custom activation list"),
+			API:        mock.API{ListTLSActivationsFn: listActivationsOK},
+			WantOutput: listActivationsShortOutput,
+		},
+		{
+			Args:       args("tls-custom activation list --verbose"),
+			API:        mock.API{ListTLSActivationsFn: listActivationsOK},
+			WantOutput: listActivationsVerboseOutput,
+		},
+		{
+			Args:      args("tls-custom activation list"),
+			API:       mock.API{ListTLSActivationsFn: listActivationsError},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestActivationDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom activation describe"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-custom activation describe --id act-1"),
+			API:       mock.API{GetTLSActivationFn: getActivationError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom activation describe --id act-1"),
+			API:        mock.API{GetTLSActivationFn: getActivationOK},
+			WantOutput: describeActivationOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestActivationUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom activation update --id act-1"),
+			WantError: "error parsing arguments: required flag --cert-id not provided",
+		},
+		{
+			Args:      args("tls-custom activation update --id act-1 --cert-id cert-2"),
+			API:       mock.API{UpdateTLSActivationFn: updateActivationError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom activation update --id act-1 --cert-id cert-2"),
+			API:        mock.API{UpdateTLSActivationFn: updateActivationOK},
+			WantOutput: "Updated TLS activation act-1 to use certificate cert-2",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestActivationDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom activation delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-custom activation delete --id act-1"),
+			API:       mock.API{DeleteTLSActivationFn: deleteActivationError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom activation disable --id act-1"),
+			API:        mock.API{DeleteTLSActivationFn: deleteActivationOK},
+			WantOutput: "Deleted TLS activation act-1",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createActivationOK(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	if i.Certificate.ID != "cert-1" || i.Domain.ID != "www.example.com" || i.Configuration == nil || i.Configuration.ID != "conf-1" {
+		return nil, errTest
+	}
+	return &fastly.TLSActivation{ID: "act-1"}, nil
+}
+
+func createActivationError(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return nil, errTest
+}
+
+func listActivationsOK(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return []*fastly.TLSActivation{
+		{
+			ID:            "act-1",
+			Certificate:   &fastly.CustomTLSCertificate{ID: "cert-1"},
+			Configuration: &fastly.TLSConfiguration{ID: "conf-1"},
+			Domain:        &fastly.TLSDomain{ID: "www.example.com"},
+		},
+		{
+			ID:            "act-2",
+			Certificate:   &fastly.CustomTLSCertificate{ID: "cert-1"},
+			Configuration: &fastly.TLSConfiguration{ID: "conf-1"},
+			Domain:        &fastly.TLSDomain{ID: "example.com"},
+		},
+	}, nil
+}
+
+func listActivationsError(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return nil, errTest
+}
+
+var listActivationsShortOutput = strings.TrimSpace(`
+ID     DOMAIN           CERTIFICATE ID
+act-1  www.example.com  cert-1
+act-2  example.com      cert-1
+`) + "\n"
+
+var listActivationsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Activation 1/2",
+	"	ID: act-1",
+	"	Domain: www.example.com",
+	"	Certificate ID: cert-1",
+	"	Configuration ID: conf-1",
+	"Activation 2/2",
+	"	ID: act-2",
+	"	Domain: example.com",
+	"	Certificate ID: cert-1",
+	"	Configuration ID: conf-1",
+}, "\n") + "\n\n"
+
+func getActivationOK(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	return &fastly.TLSActivation{
+		ID:            i.ID,
+		Certificate:   &fastly.CustomTLSCertificate{ID: "cert-1"},
+		Configuration: &fastly.TLSConfiguration{ID: "conf-1"},
+		Domain:        &fastly.TLSDomain{ID: "www.example.com"},
+		CreatedAt:     &created,
+	}, nil
+}
+
+func getActivationError(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	return nil, errTest
+}
+
+var describeActivationOutput = strings.Join([]string{
+	"ID: act-1",
+	"Domain: www.example.com",
+	"Certificate ID: cert-1",
+	"Configuration ID: conf-1",
+	"Created at: 2021-06-01T12:00:00Z",
+}, "\n") + "\n"
+
+func updateActivationOK(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return &fastly.TLSActivation{ID: i.ID, Certificate: i.Certificate}, nil
+}
+
+func updateActivationError(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return nil, errTest
+}
+
+func deleteActivationOK(i *fastly.DeleteTLSActivationInput) error {
+	return nil
+}
+
+func deleteActivationError(i *fastly.DeleteTLSActivationInput) error {
+	return errTest
+}
@@ -0,0 +1,76 @@
+package activation
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// include lists the related objects fetched alongside activations so that
+// their IDs can be displayed.
+const include = "tls_certificate,tls_configuration,tls_domain"
+
+// DescribeCommand calls the Fastly API to describe a TLS activation.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetTLSActivationInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a TLS activation").Alias("get")
+	c.CmdClause.Flag("id", "ID of the activation").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.Include = fastly.String(include)
+
+	a, err := c.Globals.Client.GetTLSActivation(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Activation ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, a)
+	}
+
+	printActivation(out, "", a)
+	return nil
+}
+
+// printActivation pretty prints a fastly.TLSActivation structure in verbose
+// format to the given io.Writer, with each line prefixed by prefix.
+func printActivation(out io.Writer, prefix string, a *fastly.TLSActivation) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	var cert, config, domain string
+	if a.Certificate != nil {
+		cert = a.Certificate.ID
+	}
+	if a.Configuration != nil {
+		config = a.Configuration.ID
+	}
+	if a.Domain != nil {
+		domain = a.Domain.ID
+	}
+
+	fmt.Fprintf(out, "ID: %s\n", a.ID)
+	fmt.Fprintf(out, "Domain: %s\n", domain)
+	fmt.Fprintf(out, "Certificate ID: %s\n", cert)
+	fmt.Fprintf(out, "Configuration ID: %s\n", config)
+	if a.CreatedAt != nil {
+		fmt.Fprintf(out, "Created at: %s\n", a.CreatedAt.UTC().Format(time.RFC3339))
+	}
+}
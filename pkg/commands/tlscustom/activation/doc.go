@@ -0,0 +1,3 @@
+// Package activation contains commands to inspect and manipulate TLS
+// activations, which enable a custom certificate for a TLS domain.
+package activation
@@ -0,0 +1,70 @@
+package activation
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list TLS activations.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListTLSActivationsInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS activations")
+	c.CmdClause.Flag("filter-cert", "Limit the returned activations to those using the given TLS certificate ID").StringVar(&c.Input.FilterTLSCertificateID)
+	c.CmdClause.Flag("filter-config", "Limit the returned activations to those using the given TLS configuration ID").StringVar(&c.Input.FilterTLSConfigurationID)
+	c.CmdClause.Flag("filter-domain", "Limit the returned activations to the given TLS domain").StringVar(&c.Input.FilterTLSDomainID)
+	c.CmdClause.Flag("page", "Page number of the results to fetch").IntVar(&c.Input.PageNumber)
+	c.CmdClause.Flag("per-page", "Number of results per page").IntVar(&c.Input.PageSize)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.Include = include
+
+	activations, err := c.Globals.Client.ListTLSActivations(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, activations)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "DOMAIN", "CERTIFICATE ID")
+		for _, a := range activations {
+			var cert, domain string
+			if a.Certificate != nil {
+				cert = a.Certificate.ID
+			}
+			if a.Domain != nil {
+				domain = a.Domain.ID
+			}
+			tw.AddLine(a.ID, domain, cert)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, a := range activations {
+		fmt.Fprintf(out, "Activation %d/%d\n", i+1, len(activations))
+		printActivation(out, "\t", a)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
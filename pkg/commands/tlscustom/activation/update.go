@@ -0,0 +1,46 @@
+package activation
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to switch the certificate served by a TLS
+// activation.
+type UpdateCommand struct {
+	cmd.Base
+	id     string
+	certID string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Switch the custom certificate used by a TLS activation")
+	c.CmdClause.Flag("id", "ID of the activation").Required().StringVar(&c.id)
+	c.CmdClause.Flag("cert-id", "ID of the TLS certificate to serve").Required().StringVar(&c.certID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	a, err := c.Globals.Client.UpdateTLSActivation(&fastly.UpdateTLSActivationInput{
+		ID:          c.id,
+		Certificate: &fastly.CustomTLSCertificate{ID: c.certID},
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Activation ID":  c.id,
+			"Certificate ID": c.certID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated TLS activation %s to use certificate %s", a.ID, c.certID)
+	return nil
+}
@@ -0,0 +1,316 @@
+package certificate_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestCertificateCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom certificate create"),
+			WantError: "error parsing arguments: required flag --cert not provided",
+		},
+		{
+			Args:      args("tls-custom certificate create --cert ../testdata/example.key"),
+			WantError: "no PEM encoded certificate found",
+		},
+		{
+			Args:      args("tls-custom certificate create --cert ../testdata/expired.crt"),
+			WantError: "certificate expired on 2021-01-01T00:00:00Z",
+		},
+		{
+			Args:      args("tls-custom certificate create --cert ../testdata/nosan.crt"),
+			WantError: "certificate has no DNS Subject Alternative Names",
+		},
+		{
+			Args:      args("tls-custom certificate create --cert ../testdata/example.crt --key ../testdata/other.key"),
+			WantError: "certificate and private key don't match",
+		},
+		{
+			Args: args("tls-custom certificate create --cert ../testdata/example.crt --key ../testdata/example.key --name example"),
+			API: mock.API{
+				CreateCustomTLSCertificateFn: createCertificateError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("tls-custom certificate create --cert ../testdata/example.crt --key ../testdata/example.key --name example"),
+			API: mock.API{
+				CreateCustomTLSCertificateFn: createCertificateOK,
+			},
+			WantOutput: "Created TLS certificate example (ID cert-1)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCertificateList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:       args("tls-custom certificate list"),
+			API:        mock.API{ListCustomTLSCertificatesFn: listCertificatesOK},
+			WantOutput: listCertificatesShortOutput,
+		},
+		{
+			Args:       args("tls-custom certificate list --verbose"),
+			API:        mock.API{ListCustomTLSCertificatesFn: listCertificatesOK},
+			WantOutput: listCertificatesVerboseOutput,
+		},
+		{
+			Args:      args("tls-custom certificate list"),
+			API:       mock.API{ListCustomTLSCertificatesFn: listCertificatesError},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCertificateDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom certificate describe"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-custom certificate describe --id cert-1"),
+			API:       mock.API{GetCustomTLSCertificateFn: getCertificateError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom certificate describe --id cert-1"),
+			API:        mock.API{GetCustomTLSCertificateFn: getCertificateOK},
+			WantOutput: describeCertificateOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCertificateUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom certificate update --cert ../testdata/example.crt"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-custom certificate update --id cert-1 --cert ../testdata/expired.crt"),
+			WantError: "certificate expired on",
+		},
+		{
+			Args:      args("tls-custom certificate update --id cert-1 --cert ../testdata/example.crt"),
+			API:       mock.API{UpdateCustomTLSCertificateFn: updateCertificateError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom certificate update --id cert-1 --cert ../testdata/example.crt --key ../testdata/example.key --name renewed"),
+			API:        mock.API{UpdateCustomTLSCertificateFn: updateCertificateOK},
+			WantOutput: "Updated TLS certificate renewed (ID cert-1)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCertificateDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-custom certificate delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-custom certificate delete --id cert-1"),
+			API:       mock.API{DeleteCustomTLSCertificateFn: deleteCertificateError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-custom certificate delete --id cert-1"),
+			API:        mock.API{DeleteCustomTLSCertificateFn: deleteCertificateOK},
+			WantOutput: "Deleted TLS certificate cert-1",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+var (
+	notBefore = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter  = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func createCertificateOK(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if !strings.Contains(i.CertBlob, "-----BEGIN CERTIFICATE-----") {
+		return nil, errTest
+	}
+	return &fastly.CustomTLSCertificate{ID: "cert-1", Name: i.Name}, nil
+}
+
+func createCertificateError(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return nil, errTest
+}
+
+func listCertificatesOK(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return []*fastly.CustomTLSCertificate{
+		{
+			ID:        "cert-1",
+			Name:      "example",
+			IssuedTo:  "www.example.com",
+			NotBefore: &notBefore,
+			NotAfter:  &notAfter,
+		},
+		{
+			ID:        "cert-2",
+			Name:      "other",
+			IssuedTo:  "www.example.net",
+			NotBefore: &notBefore,
+			NotAfter:  &notAfter,
+		},
+	}, nil
+}
+
+func listCertificatesError(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return nil, errTest
+}
+
+var listCertificatesShortOutput = strings.TrimSpace(`
+ID      NAME     ISSUED TO        NOT AFTER
+cert-1  example  www.example.com  2022-01-01T00:00:00Z
+cert-2  other    www.example.net  2022-01-01T00:00:00Z
+`) + "\n"
+
+var listCertificatesVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Certificate 1/2",
+	"	ID: cert-1",
+	"	Name: example",
+	"	Issued to: www.example.com",
+	"	Issuer: ",
+	"	Serial number: ",
+	"	Signature algorithm: ",
+	"	Not before: 2021-01-01T00:00:00Z",
+	"	Not after: 2022-01-01T00:00:00Z",
+	"	Domains: ",
+	"	Replace: false",
+	"Certificate 2/2",
+	"	ID: cert-2",
+	"	Name: other",
+	"	Issued to: www.example.net",
+	"	Issuer: ",
+	"	Serial number: ",
+	"	Signature algorithm: ",
+	"	Not before: 2021-01-01T00:00:00Z",
+	"	Not after: 2022-01-01T00:00:00Z",
+	"	Domains: ",
+	"	Replace: false",
+}, "\n") + "\n\n"
+
+func getCertificateOK(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return &fastly.CustomTLSCertificate{
+		ID:                 i.ID,
+		Name:               "example",
+		IssuedTo:           "www.example.com",
+		Issuer:             "Example CA",
+		SerialNumber:       "1234",
+		SignatureAlgorithm: "ECDSA-SHA256",
+		NotBefore:          &notBefore,
+		NotAfter:           &notAfter,
+		Domains: []*fastly.TLSDomain{
+			{ID: "www.example.com"},
+			{ID: "example.com"},
+		},
+	}, nil
+}
+
+func getCertificateError(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return nil, errTest
+}
+
+var describeCertificateOutput = strings.Join([]string{
+	"ID: cert-1",
+	"Name: example",
+	"Issued to: www.example.com",
+	"Issuer: Example CA",
+	"Serial number: 1234",
+	"Signature algorithm: ECDSA-SHA256",
+	"Not before: 2021-01-01T00:00:00Z",
+	"Not after: 2022-01-01T00:00:00Z",
+	"Domains: www.example.com, example.com",
+	"Replace: false",
+}, "\n") + "\n"
+
+func updateCertificateOK(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if i.ID != "cert-1" || i.CertBlob == "" {
+		return nil, errTest
+	}
+	return &fastly.CustomTLSCertificate{ID: i.ID, Name: i.Name}, nil
+}
+
+func updateCertificateError(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return nil, errTest
+}
+
+func deleteCertificateOK(i *fastly.DeleteCustomTLSCertificateInput) error {
+	return nil
+}
+
+func deleteCertificateError(i *fastly.DeleteCustomTLSCertificateInput) error {
+	return errTest
+}
@@ -0,0 +1,76 @@
+package certificate
+
+import (
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/tlscustom"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to upload a custom TLS certificate.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreateCustomTLSCertificateInput
+	cert  string
+	key   string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Upload a custom TLS certificate").Alias("add")
+	c.CmdClause.Flag("cert", "Path to, or content of, the PEM encoded certificate chain").Required().StringVar(&c.cert)
+	c.CmdClause.Flag("key", "Path to, or content of, the PEM encoded private key, to check it matches the certificate. The key itself isn't uploaded").StringVar(&c.key)
+	c.CmdClause.Flag("name", "A customizable name for the certificate. Defaults to the certificate's common name").StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	blob, err := validate(c.cert, c.key, time.Now())
+	if err != nil {
+		return err
+	}
+	c.Input.CertBlob = blob
+
+	cert, err := c.Globals.Client.CreateCustomTLSCertificate(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Name": c.Input.Name,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS certificate %s (ID %s)", cert.Name, cert.ID)
+	return nil
+}
+
+// validate reads the certificate and, if given, the private key and checks
+// them locally so that common mistakes are reported before the API call. It
+// returns the certificate PEM data to upload.
+func validate(certFlag, keyFlag string, now time.Time) (string, error) {
+	blob, cert, err := tlscustom.ReadCertificate(certFlag)
+	if err != nil {
+		return "", err
+	}
+	if err := tlscustom.ValidateCertificate(cert, now); err != nil {
+		return "", err
+	}
+	if keyFlag == "" {
+		return blob, nil
+	}
+
+	key, err := tlscustom.ReadPrivateKey(keyFlag)
+	if err != nil {
+		return "", err
+	}
+	if err := tlscustom.ValidateKeyPair(blob, key); err != nil {
+		return "", err
+	}
+	return blob, nil
+}
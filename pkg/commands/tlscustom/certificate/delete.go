@@ -0,0 +1,38 @@
+package certificate
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to delete a custom TLS certificate.
+type DeleteCommand struct {
+	cmd.Base
+	Input fastly.DeleteCustomTLSCertificateInput
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Delete a custom TLS certificate").Alias("remove")
+	c.CmdClause.Flag("id", "ID of the certificate").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeleteCustomTLSCertificate(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Certificate ID": c.Input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted TLS certificate %s", c.Input.ID)
+	return nil
+}
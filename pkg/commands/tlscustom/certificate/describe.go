@@ -0,0 +1,76 @@
+package certificate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// DescribeCommand calls the Fastly API to describe a custom TLS certificate.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetCustomTLSCertificateInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a custom TLS certificate").Alias("get")
+	c.CmdClause.Flag("id", "ID of the certificate").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	cert, err := c.Globals.Client.GetCustomTLSCertificate(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Certificate ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, cert)
+	}
+
+	printCertificate(out, "", cert)
+	return nil
+}
+
+// printCertificate pretty prints a fastly.CustomTLSCertificate structure in
+// verbose format to the given io.Writer, with each line prefixed by prefix.
+func printCertificate(out io.Writer, prefix string, c *fastly.CustomTLSCertificate) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	domains := make([]string, len(c.Domains))
+	for i, d := range c.Domains {
+		domains[i] = d.ID
+	}
+
+	fmt.Fprintf(out, "ID: %s\n", c.ID)
+	fmt.Fprintf(out, "Name: %s\n", c.Name)
+	fmt.Fprintf(out, "Issued to: %s\n", c.IssuedTo)
+	fmt.Fprintf(out, "Issuer: %s\n", c.Issuer)
+	fmt.Fprintf(out, "Serial number: %s\n", c.SerialNumber)
+	fmt.Fprintf(out, "Signature algorithm: %s\n", c.SignatureAlgorithm)
+	fmt.Fprintf(out, "Not before: %s\n", formatTime(c.NotBefore))
+	fmt.Fprintf(out, "Not after: %s\n", formatTime(c.NotAfter))
+	fmt.Fprintf(out, "Domains: %s\n", strings.Join(domains, ", "))
+	fmt.Fprintf(out, "Replace: %t\n", c.Replace)
+}
+
+// formatTime renders an optional API timestamp, leaving it blank when unset.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
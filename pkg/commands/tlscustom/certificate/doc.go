@@ -0,0 +1,3 @@
+// Package certificate contains commands to inspect and manipulate custom TLS
+// certificates uploaded to Fastly.
+package certificate
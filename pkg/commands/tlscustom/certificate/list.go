@@ -0,0 +1,60 @@
+package certificate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list custom TLS certificates.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListCustomTLSCertificatesInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List custom TLS certificates")
+	c.CmdClause.Flag("filter-domain", "Limit the returned certificates to those that include the given TLS domain ID").StringVar(&c.Input.FilterTLSDomainsID)
+	c.CmdClause.Flag("sort", "Sort by created_at, not_before or not_after. Prefix with - for descending order").StringVar(&c.Input.Sort)
+	c.CmdClause.Flag("page", "Page number of the results to fetch").IntVar(&c.Input.PageNumber)
+	c.CmdClause.Flag("per-page", "Number of results per page").IntVar(&c.Input.PageSize)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	certs, err := c.Globals.Client.ListCustomTLSCertificates(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, certs)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "ISSUED TO", "NOT AFTER")
+		for _, cert := range certs {
+			tw.AddLine(cert.ID, cert.Name, cert.IssuedTo, formatTime(cert.NotAfter))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, cert := range certs {
+		fmt.Fprintf(out, "Certificate %d/%d\n", i+1, len(certs))
+		printCertificate(out, "\t", cert)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package certificate
+
+import (
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to replace a custom TLS certificate.
+type UpdateCommand struct {
+	cmd.Base
+	input fastly.UpdateCustomTLSCertificateInput
+	cert  string
+	key   string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Replace a custom TLS certificate, e.g. when renewing it")
+	c.CmdClause.Flag("id", "ID of the certificate").Required().StringVar(&c.input.ID)
+	c.CmdClause.Flag("cert", "Path to, or content of, the new PEM encoded certificate chain").Required().StringVar(&c.cert)
+	c.CmdClause.Flag("key", "Path to, or content of, the PEM encoded private key, to check it matches the certificate. The key itself isn't uploaded").StringVar(&c.key)
+	c.CmdClause.Flag("name", "A customizable name for the certificate").StringVar(&c.input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	blob, err := validate(c.cert, c.key, time.Now())
+	if err != nil {
+		return err
+	}
+	c.input.CertBlob = blob
+
+	cert, err := c.Globals.Client.UpdateCustomTLSCertificate(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Certificate ID": c.input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated TLS certificate %s (ID %s)", cert.Name, cert.ID)
+	return nil
+}
@@ -0,0 +1,5 @@
+// Package tlscustom contains commands to inspect and manipulate the custom
+// TLS certificates, private keys, domains and activations used by Fastly's
+// Platform TLS product. The commands themselves live in subpackages, while
+// this package provides the local PEM validation they share.
+package tlscustom
@@ -0,0 +1,3 @@
+// Package domain contains commands to inspect the TLS domains that Fastly
+// knows about from uploaded certificates and subscriptions.
+package domain
@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestDomainList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:       args("tls-custom domain list"),
+			API:        mock.API{ListTLSDomainsFn: listDomainsOK},
+			WantOutput: listDomainsOutput,
+		},
+		{
+			Args:       args("tls-custom domain list --filter-in-use --filter-cert cert-1"),
+			API:        mock.API{ListTLSDomainsFn: listDomainsFiltered},
+			WantOutput: listDomainsOutput,
+		},
+		{
+			Args:      args("tls-custom domain list"),
+			API:       mock.API{ListTLSDomainsFn: listDomainsError},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func listDomainsOK(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	if i.FilterInUse != nil || i.Include != "tls_certificates" {
+		return nil, errTest
+	}
+	return []*fastly.TLSDomain{
+		{
+			ID:   "www.example.com",
+			Type: "tls_domain",
+			Certificates: []*fastly.CustomTLSCertificate{
+				{ID: "cert-1"},
+				{ID: "cert-2"},
+			},
+		},
+		{
+			ID:   "example.com",
+			Type: "tls_domain",
+			Certificates: []*fastly.CustomTLSCertificate{
+				{ID: "cert-1"},
+			},
+		},
+	}, nil
+}
+
+func listDomainsFiltered(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	if i.FilterInUse == nil || !*i.FilterInUse || i.FilterTLSCertificateID != "cert-1" {
+		return nil, errTest
+	}
+	return listDomainsOK(&fastly.ListTLSDomainsInput{Include: i.Include})
+}
+
+func listDomainsError(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	return nil, errTest
+}
+
+var listDomainsOutput = strings.TrimSpace(`
+DOMAIN           TYPE        CERTIFICATES
+www.example.com  tls_domain  cert-1, cert-2
+example.com      tls_domain  cert-1
+`) + "\n"
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list TLS domains.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListTLSDomainsInput
+	inUse cmd.OptionalBool
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS domains")
+	c.CmdClause.Flag("filter-in-use", "Limit the returned domains to those in use (or, with --no-filter-in-use, not in use) by an activation").Action(c.inUse.Set).BoolVar(&c.inUse.Value)
+	c.CmdClause.Flag("filter-cert", "Limit the returned domains to those listed on the given TLS certificate ID").StringVar(&c.Input.FilterTLSCertificateID)
+	c.CmdClause.Flag("filter-subscription", "Limit the returned domains to those belonging to the given TLS subscription ID").StringVar(&c.Input.FilterTLSSubscriptionID)
+	c.CmdClause.Flag("page", "Page number of the results to fetch").IntVar(&c.Input.PageNumber)
+	c.CmdClause.Flag("per-page", "Number of results per page").IntVar(&c.Input.PageSize)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.inUse.WasSet {
+		c.Input.FilterInUse = fastly.Bool(c.inUse.Value)
+	}
+	c.Input.Include = "tls_certificates"
+
+	domains, err := c.Globals.Client.ListTLSDomains(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, domains)
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("DOMAIN", "TYPE", "CERTIFICATES")
+	for _, d := range domains {
+		certs := make([]string, len(d.Certificates))
+		for i, cert := range d.Certificates {
+			certs[i] = cert.ID
+		}
+		tw.AddLine(d.ID, d.Type, strings.Join(certs, ", "))
+	}
+	tw.Print()
+	return nil
+}
@@ -0,0 +1,111 @@
+package tlscustom
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/errors"
+)
+
+// certificateRemediation is shown alongside any certificate validation error.
+var certificateRemediation = "Check the --cert flag points at a PEM encoded X.509 certificate (or contains one) that is currently valid and lists the domains it secures as Subject Alternative Names."
+
+// keyRemediation is shown alongside any private key validation error.
+var keyRemediation = "Check the --key flag points at a PEM encoded RSA, ECDSA or Ed25519 private key (or contains one)."
+
+// ReadCertificate resolves the flag value as either a path to, or the content
+// of, a PEM encoded certificate chain. It returns the PEM data, ready to send
+// to the API, along with the parsed leaf certificate.
+func ReadCertificate(flagval string) (string, *x509.Certificate, error) {
+	data := strings.TrimSpace(cmd.Content(flagval))
+
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", nil, errors.RemediationError{
+			Inner:       fmt.Errorf("no PEM encoded certificate found in %s", flagval),
+			Remediation: certificateRemediation,
+		}
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", nil, errors.RemediationError{
+			Inner:       fmt.Errorf("error parsing certificate: %w", err),
+			Remediation: certificateRemediation,
+		}
+	}
+
+	return data, cert, nil
+}
+
+// ValidateCertificate checks the certificate is valid at the given time and
+// names at least one domain in its Subject Alternative Names, which Fastly
+// requires in order to match the certificate to a TLS domain.
+func ValidateCertificate(cert *x509.Certificate, now time.Time) error {
+	var problem error
+	switch {
+	case now.After(cert.NotAfter):
+		problem = fmt.Errorf("certificate expired on %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	case now.Before(cert.NotBefore):
+		problem = fmt.Errorf("certificate is not valid until %s", cert.NotBefore.UTC().Format(time.RFC3339))
+	case len(cert.DNSNames) == 0:
+		problem = fmt.Errorf("certificate has no DNS Subject Alternative Names")
+	}
+	if problem != nil {
+		return errors.RemediationError{
+			Inner:       problem,
+			Remediation: certificateRemediation,
+		}
+	}
+	return nil
+}
+
+// ReadPrivateKey resolves the flag value as either a path to, or the content
+// of, a PEM encoded private key and checks that it parses. It returns the PEM
+// data, ready to send to the API.
+func ReadPrivateKey(flagval string) (string, error) {
+	data := strings.TrimSpace(cmd.Content(flagval))
+
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return "", errors.RemediationError{
+			Inner:       fmt.Errorf("no PEM encoded private key found in %s", flagval),
+			Remediation: keyRemediation,
+		}
+	}
+
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		_, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return "", errors.RemediationError{
+			Inner:       fmt.Errorf("error parsing private key: %w", err),
+			Remediation: keyRemediation,
+		}
+	}
+
+	return data, nil
+}
+
+// ValidateKeyPair checks that the PEM encoded certificate and private key
+// belong together, catching mismatched uploads before the API does.
+func ValidateKeyPair(certPEM, keyPEM string) error {
+	if _, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM)); err != nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("certificate and private key don't match: %w", err),
+			Remediation: "Check the --key flag refers to the private key the certificate was issued for.",
+		}
+	}
+	return nil
+}
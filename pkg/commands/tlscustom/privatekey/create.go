@@ -0,0 +1,48 @@
+package privatekey
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/tlscustom"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to upload a TLS private key.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreatePrivateKeyInput
+	key   string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Upload a TLS private key").Alias("add")
+	c.CmdClause.Flag("key", "Path to, or content of, the PEM encoded private key").Required().StringVar(&c.key)
+	c.CmdClause.Flag("name", "A customizable name for the private key").Required().StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	key, err := tlscustom.ReadPrivateKey(c.key)
+	if err != nil {
+		return err
+	}
+	c.Input.Key = key
+
+	k, err := c.Globals.Client.CreatePrivateKey(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Name": c.Input.Name,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS private key %s (ID %s)", k.Name, k.ID)
+	return nil
+}
@@ -0,0 +1,38 @@
+package privatekey
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to delete a TLS private key.
+type DeleteCommand struct {
+	cmd.Base
+	Input fastly.DeletePrivateKeyInput
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Delete a TLS private key. Only keys not in use by a certificate can be deleted").Alias("remove")
+	c.CmdClause.Flag("id", "ID of the private key").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeletePrivateKey(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Private Key ID": c.Input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted TLS private key %s", c.Input.ID)
+	return nil
+}
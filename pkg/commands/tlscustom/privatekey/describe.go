@@ -0,0 +1,61 @@
+package privatekey
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// DescribeCommand calls the Fastly API to describe a TLS private key.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetPrivateKeyInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a TLS private key").Alias("get")
+	c.CmdClause.Flag("id", "ID of the private key").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	key, err := c.Globals.Client.GetPrivateKey(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Private Key ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, key)
+	}
+
+	printPrivateKey(out, "", key)
+	return nil
+}
+
+// printPrivateKey pretty prints a fastly.PrivateKey structure in verbose
+// format to the given io.Writer, with each line prefixed by prefix.
+func printPrivateKey(out io.Writer, prefix string, k *fastly.PrivateKey) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", k.ID)
+	fmt.Fprintf(out, "Name: %s\n", k.Name)
+	fmt.Fprintf(out, "Key type: %s\n", k.KeyType)
+	fmt.Fprintf(out, "Key length: %d\n", k.KeyLength)
+	fmt.Fprintf(out, "Public key SHA1: %s\n", k.PublicKeySHA1)
+	fmt.Fprintf(out, "Replace: %t\n", k.Replace)
+	if k.CreatedAt != nil {
+		fmt.Fprintf(out, "Created at: %s\n", k.CreatedAt.UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,3 @@
+// Package privatekey contains commands to inspect and manipulate the private
+// keys uploaded to Fastly for use with custom TLS certificates.
+package privatekey
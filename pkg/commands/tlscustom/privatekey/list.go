@@ -0,0 +1,59 @@
+package privatekey
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list TLS private keys.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListPrivateKeysInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS private keys")
+	c.CmdClause.Flag("filter-in-use", "Limit the returned keys to those without any matching TLS certificates (false)").StringVar(&c.Input.FilterInUse)
+	c.CmdClause.Flag("page", "Page number of the results to fetch").IntVar(&c.Input.PageNumber)
+	c.CmdClause.Flag("per-page", "Number of results per page").IntVar(&c.Input.PageSize)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	keys, err := c.Globals.Client.ListPrivateKeys(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, keys)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "KEY TYPE", "KEY LENGTH", "PUBLIC KEY SHA1")
+		for _, key := range keys {
+			tw.AddLine(key.ID, key.Name, key.KeyType, key.KeyLength, key.PublicKeySHA1)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, key := range keys {
+		fmt.Fprintf(out, "Private key %d/%d\n", i+1, len(keys))
+		printPrivateKey(out, "\t", key)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package tlssubscription
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// certificateAuthorities is the set of certificate authorities Fastly can
+// obtain certificates from.
+var certificateAuthorities = []string{"lets-encrypt", "globalsign"}
+
+// CreateCommand calls the Fastly API to create a TLS subscription.
+type CreateCommand struct {
+	cmd.Base
+	domains    []string
+	commonName string
+	ca         string
+	configID   string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Create a TLS subscription, having Fastly obtain a certificate for the given domains").Alias("add")
+	c.CmdClause.Flag("domain", "Domain to secure. Can be given multiple times").Required().StringsVar(&c.domains)
+	c.CmdClause.Flag("common-name", "The domain to use as the certificate's common name. Defaults to the first --domain").StringVar(&c.commonName)
+	c.CmdClause.Flag("cert-authority", "The certificate authority to obtain the certificate from (lets-encrypt, globalsign)").Default("lets-encrypt").EnumVar(&c.ca, certificateAuthorities...)
+	c.CmdClause.Flag("config-id", "ID of the TLS configuration to use. Defaults to the account's default configuration").StringVar(&c.configID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input := fastly.CreateTLSSubscriptionInput{
+		CertificateAuthority: c.ca,
+	}
+	for _, d := range c.domains {
+		input.Domains = append(input.Domains, &fastly.TLSDomain{ID: d})
+	}
+	if c.commonName != "" {
+		input.CommonName = &fastly.TLSDomain{ID: c.commonName}
+	}
+	if c.configID != "" {
+		input.Configuration = &fastly.TLSConfiguration{ID: c.configID}
+	}
+
+	s, err := c.Globals.Client.CreateTLSSubscription(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Domains": c.domains,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS subscription %s (state %s)", s.ID, s.State)
+	return nil
+}
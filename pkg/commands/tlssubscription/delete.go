@@ -0,0 +1,39 @@
+package tlssubscription
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to delete a TLS subscription.
+type DeleteCommand struct {
+	cmd.Base
+	Input fastly.DeleteTLSSubscriptionInput
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Delete a TLS subscription").Alias("remove")
+	c.CmdClause.Flag("id", "ID of the subscription").Required().StringVar(&c.Input.ID)
+	c.CmdClause.Flag("force", "Delete the subscription even if its certificate is in use by an activation").Short('f').BoolVar(&c.Input.Force)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeleteTLSSubscription(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Subscription ID": c.Input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted TLS subscription %s", c.Input.ID)
+	return nil
+}
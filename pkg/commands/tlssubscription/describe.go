@@ -0,0 +1,83 @@
+package tlssubscription
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// DescribeCommand calls the Fastly API to describe a TLS subscription.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetTLSSubscriptionInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a TLS subscription, including any outstanding DNS challenges").Alias("get")
+	c.CmdClause.Flag("id", "ID of the subscription").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.Include = fastly.String("tls_authorizations")
+
+	s, err := c.Globals.Client.GetTLSSubscription(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Subscription ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, s)
+	}
+
+	printSubscription(out, "", s)
+	for _, a := range s.Authorizations {
+		fmt.Fprintf(out, "Authorization %s (state %s)\n", a.ID, a.State)
+		for _, ch := range a.Challenges {
+			fmt.Fprintf(out, "\t%s: %s %s %s\n", ch.Type, ch.RecordType, ch.RecordName, strings.Join(ch.Values, " "))
+		}
+	}
+	return nil
+}
+
+// printSubscription pretty prints a fastly.TLSSubscription structure in
+// verbose format to the given io.Writer, with each line prefixed by prefix.
+func printSubscription(out io.Writer, prefix string, s *fastly.TLSSubscription) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	var commonName string
+	if s.CommonName != nil {
+		commonName = s.CommonName.ID
+	}
+
+	fmt.Fprintf(out, "ID: %s\n", s.ID)
+	fmt.Fprintf(out, "State: %s\n", s.State)
+	fmt.Fprintf(out, "Certificate authority: %s\n", s.CertificateAuthority)
+	fmt.Fprintf(out, "Common name: %s\n", commonName)
+	fmt.Fprintf(out, "Domains: %s\n", strings.Join(domainIDs(s), ", "))
+	if s.CreatedAt != nil {
+		fmt.Fprintf(out, "Created at: %s\n", s.CreatedAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// domainIDs returns the names of the domains covered by the subscription.
+func domainIDs(s *fastly.TLSSubscription) []string {
+	ids := make([]string, len(s.Domains))
+	for i, d := range s.Domains {
+		ids[i] = d.ID
+	}
+	return ids
+}
@@ -0,0 +1,4 @@
+// Package tlssubscription contains commands to inspect and manipulate TLS
+// subscriptions, for which Fastly obtains and renews certificates from a
+// certificate authority.
+package tlssubscription
@@ -0,0 +1,61 @@
+package tlssubscription
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list TLS subscriptions.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListTLSSubscriptionsInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS subscriptions")
+	c.CmdClause.Flag("filter-state", "Limit the returned subscriptions by state, e.g. pending, processing, issued or renewing").StringVar(&c.Input.FilterState)
+	c.CmdClause.Flag("filter-domain", "Limit the returned subscriptions to those covering the given TLS domain").StringVar(&c.Input.FilterTLSDomainsID)
+	c.CmdClause.Flag("page", "Page number of the results to fetch").IntVar(&c.Input.PageNumber)
+	c.CmdClause.Flag("per-page", "Number of results per page").IntVar(&c.Input.PageSize)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	subscriptions, err := c.Globals.Client.ListTLSSubscriptions(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, subscriptions)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "STATE", "CERTIFICATE AUTHORITY", "DOMAINS")
+		for _, s := range subscriptions {
+			tw.AddLine(s.ID, s.State, s.CertificateAuthority, strings.Join(domainIDs(s), ", "))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, s := range subscriptions {
+		fmt.Fprintf(out, "Subscription %d/%d\n", i+1, len(subscriptions))
+		printSubscription(out, "\t", s)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package tlssubscription_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestSubscriptionCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-subscription create"),
+			WantError: "error parsing arguments: required flag --domain not provided",
+		},
+		{
+			Args:      args("tls-subscription create --domain www.example.com --cert-authority digicert"),
+			WantError: "error parsing arguments: enum value must be one of lets-encrypt,globalsign, got 'digicert'",
+		},
+		{
+			Args:      args("tls-subscription create --domain www.example.com"),
+			API:       mock.API{CreateTLSSubscriptionFn: createSubscriptionError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-subscription create --domain www.example.com"),
+			API:        mock.API{CreateTLSSubscriptionFn: createSubscriptionDefaults},
+			WantOutput: "Created TLS subscription sub-1 (state pending)",
+		},
+		{
+			Args:       args("tls-subscription create --domain www.example.com --domain example.com --common-name example.com --cert-authority globalsign --config-id conf-1"),
+			API:        mock.API{CreateTLSSubscriptionFn: createSubscriptionOK},
+			WantOutput: "Created TLS subscription sub-1 (state pending)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestSubscriptionList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:       args("tls-subscription list"),
+			API:        mock.API{ListTLSSubscriptionsFn: listSubscriptionsOK},
+			WantOutput: listSubscriptionsShortOutput,
+		},
+		{
+			Args:       args("tls-subscription list --verbose"),
+			API:        mock.API{ListTLSSubscriptionsFn: listSubscriptionsOK},
+			WantOutput: listSubscriptionsVerboseOutput,
+		},
+		{
+			Args:      args("tls-subscription list"),
+			API:       mock.API{ListTLSSubscriptionsFn: listSubscriptionsError},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestSubscriptionDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-subscription describe"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-subscription describe --id sub-1"),
+			API:       mock.API{GetTLSSubscriptionFn: getSubscriptionError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-subscription describe --id sub-1"),
+			API:        mock.API{GetTLSSubscriptionFn: getSubscriptionOK},
+			WantOutput: describeSubscriptionOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestSubscriptionDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("tls-subscription delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("tls-subscription delete --id sub-1"),
+			API:       mock.API{DeleteTLSSubscriptionFn: deleteSubscriptionError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("tls-subscription delete --id sub-1 --force"),
+			API:        mock.API{DeleteTLSSubscriptionFn: deleteSubscriptionOK},
+			WantOutput: "Deleted TLS subscription sub-1",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createSubscriptionDefaults(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if i.CertificateAuthority != "lets-encrypt" || len(i.Domains) != 1 || i.CommonName != nil || i.Configuration != nil {
+		return nil, errTest
+	}
+	return &fastly.TLSSubscription{ID: "sub-1", State: "pending"}, nil
+}
+
+func createSubscriptionOK(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if i.CertificateAuthority != "globalsign" || len(i.Domains) != 2 || i.CommonName.ID != "example.com" || i.Configuration.ID != "conf-1" {
+		return nil, errTest
+	}
+	return &fastly.TLSSubscription{ID: "sub-1", State: "pending"}, nil
+}
+
+func createSubscriptionError(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return nil, errTest
+}
+
+func listSubscriptionsOK(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return []*fastly.TLSSubscription{
+		{
+			ID:                   "sub-1",
+			State:                "issued",
+			CertificateAuthority: "lets-encrypt",
+			CommonName:           &fastly.TLSDomain{ID: "www.example.com"},
+			Domains: []*fastly.TLSDomain{
+				{ID: "www.example.com"},
+				{ID: "example.com"},
+			},
+		},
+		{
+			ID:                   "sub-2",
+			State:                "pending",
+			CertificateAuthority: "globalsign",
+			CommonName:           &fastly.TLSDomain{ID: "www.example.net"},
+			Domains: []*fastly.TLSDomain{
+				{ID: "www.example.net"},
+			},
+		},
+	}, nil
+}
+
+func listSubscriptionsError(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return nil, errTest
+}
+
+var listSubscriptionsShortOutput = strings.TrimSpace(`
+ID     STATE    CERTIFICATE AUTHORITY  DOMAINS
+sub-1  issued   lets-encrypt           www.example.com, example.com
+sub-2  pending  globalsign             www.example.net
+`) + "\n"
+
+var listSubscriptionsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Subscription 1/2",
+	"	ID: sub-1",
+	"	State: issued",
+	"	Certificate authority: lets-encrypt",
+	"	Common name: www.example.com",
+	"	Domains: www.example.com, example.com",
+	"Subscription 2/2",
+	"	ID: sub-2",
+	"	State: pending",
+	"	Certificate authority: globalsign",
+	"	Common name: www.example.net",
+	"	Domains: www.example.net",
+}, "\n") + "\n\n"
+
+func getSubscriptionOK(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if i.Include == nil || *i.Include != "tls_authorizations" {
+		return nil, errTest
+	}
+	return &fastly.TLSSubscription{
+		ID:                   i.ID,
+		State:                "pending",
+		CertificateAuthority: "lets-encrypt",
+		CommonName:           &fastly.TLSDomain{ID: "www.example.com"},
+		Domains: []*fastly.TLSDomain{
+			{ID: "www.example.com"},
+		},
+		Authorizations: []*fastly.TLSAuthorizations{
+			{
+				ID:    "auth-1",
+				State: "pending",
+				Challenges: []fastly.TLSChallenge{
+					{
+						Type:       "managed-dns",
+						RecordType: "CNAME",
+						RecordName: "_acme-challenge.www.example.com",
+						Values:     []string{"abc.fastly-validations.com"},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func getSubscriptionError(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return nil, errTest
+}
+
+var describeSubscriptionOutput = strings.Join([]string{
+	"ID: sub-1",
+	"State: pending",
+	"Certificate authority: lets-encrypt",
+	"Common name: www.example.com",
+	"Domains: www.example.com",
+	"Authorization auth-1 (state pending)",
+	"	managed-dns: CNAME _acme-challenge.www.example.com abc.fastly-validations.com",
+}, "\n") + "\n"
+
+func deleteSubscriptionOK(i *fastly.DeleteTLSSubscriptionInput) error {
+	if !i.Force {
+		return errTest
+	}
+	return nil
+}
+
+func deleteSubscriptionError(i *fastly.DeleteTLSSubscriptionInput) error {
+	return errTest
+}
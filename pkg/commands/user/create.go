@@ -0,0 +1,45 @@
+package user
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// roles are the roles a user can be assigned.
+var roles = []string{"user", "billing", "engineer", "superuser"}
+
+// CreateCommand calls the Fastly API to create a user.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreateUserInput
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Invite a user to the Fastly account").Alias("add")
+	c.CmdClause.Flag("login", "The user's email address, used to log in").Required().StringVar(&c.Input.Login)
+	c.CmdClause.Flag("name", "The user's real name").Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("role", "The permissions role assigned to the user (user, billing, engineer, superuser)").HintOptions(roles...).EnumVar(&c.Input.Role, roles...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	u, err := c.Globals.Client.CreateUser(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Login": c.Input.Login,
+			"Role":  c.Input.Role,
+		})
+		return err
+	}
+
+	text.Success(out, "Created user %s (ID %s, role %s)", u.Login, u.ID, u.Role)
+	return nil
+}
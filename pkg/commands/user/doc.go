@@ -0,0 +1,3 @@
+// Package user contains commands to inspect and manipulate the users of a
+// Fastly account.
+package user
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list the users of an account.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListCustomerUsersInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List the users of a Fastly account")
+	c.CmdClause.Flag("customer-id", "Alphanumeric string identifying the customer. Defaults to the customer of the current user").StringVar(&c.Input.CustomerID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.Input.CustomerID == "" {
+		u, err := c.Globals.Client.GetCurrentUser()
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error fetching current user: %w", err)
+		}
+		c.Input.CustomerID = u.CustomerID
+	}
+
+	users, err := c.Globals.Client.ListCustomerUsers(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Customer ID": c.Input.CustomerID,
+		})
+		return err
+	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, users)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "LOGIN", "NAME", "ROLE")
+		for _, u := range users {
+			tw.AddLine(u.ID, u.Login, u.Name, u.Role)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Customer ID: %s\n", c.Input.CustomerID)
+	for i, u := range users {
+		fmt.Fprintf(out, "\tUser %d/%d\n", i+1, len(users))
+		text.PrintUser(out, "\t\t", u)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a user.
+type UpdateCommand struct {
+	cmd.Base
+	input fastly.UpdateUserInput
+
+	name cmd.OptionalString
+	role cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update a user's name or role")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the user").Required().StringVar(&c.input.ID)
+	c.CmdClause.Flag("name", "The user's real name").Action(c.name.Set).StringVar(&c.name.Value)
+	c.CmdClause.Flag("role", "The permissions role assigned to the user (user, billing, engineer, superuser)").HintOptions(roles...).Action(c.role.Set).EnumVar(&c.role.Value, roles...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	if !c.name.WasSet && !c.role.WasSet {
+		return errors.RemediationError{Inner: fmt.Errorf("error parsing arguments: required flag --name or --role not provided"), Remediation: "To fix this error, provide at least one of the aforementioned flags"}
+	}
+	if c.name.WasSet {
+		c.input.Name = fastly.String(c.name.Value)
+	}
+	if c.role.WasSet {
+		c.input.Role = fastly.String(c.role.Value)
+	}
+
+	u, err := c.Globals.Client.UpdateUser(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"User ID": c.input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated user %s (ID %s, role %s)", u.Login, u.ID, u.Role)
+	return nil
+}
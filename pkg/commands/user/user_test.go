@@ -0,0 +1,289 @@
+package user_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestUserCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("user create --login test@example.com"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("user create --login test@example.com --name Test --role admin"),
+			WantError: "error parsing arguments: enum value must be one of user,billing,engineer,superuser, got 'admin'",
+		},
+		{
+			Args:      args("user create --login test@example.com --name Test"),
+			API:       mock.API{CreateUserFn: createUserError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("user create --login test@example.com --name Test --role engineer"),
+			API:        mock.API{CreateUserFn: createUserOK},
+			WantOutput: "Created user test@example.com (ID user-1, role engineer)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestUserList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("user list"),
+			API: mock.API{
+				GetCurrentUserFn:    getCurrentUserOK,
+				ListCustomerUsersFn: listUsersOK,
+			},
+			WantOutput: listUsersShortOutput,
+		},
+		{
+			Args: args("user list --customer-id abc --verbose"),
+			API: mock.API{
+				ListCustomerUsersFn: listUsersOK,
+			},
+			WantOutput: listUsersVerboseOutput,
+		},
+		{
+			Args: args("user list"),
+			API: mock.API{
+				GetCurrentUserFn: getCurrentUserError,
+			},
+			WantError: "error fetching current user: " + errTest.Error(),
+		},
+		{
+			Args: args("user list --customer-id abc"),
+			API: mock.API{
+				ListCustomerUsersFn: listUsersError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestUserDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("user describe"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("user describe --id user-1"),
+			API:       mock.API{GetUserFn: getUserError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("user describe --id user-1"),
+			API:        mock.API{GetUserFn: getUserOK},
+			WantOutput: describeUserOutput,
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestUserUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("user update --id user-1"),
+			WantError: "error parsing arguments: required flag --name or --role not provided",
+		},
+		{
+			Args:      args("user update --id user-1 --role billing"),
+			API:       mock.API{UpdateUserFn: updateUserError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("user update --id user-1 --role billing"),
+			API:        mock.API{UpdateUserFn: updateUserOK},
+			WantOutput: "Updated user test@example.com (ID user-1, role billing)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestUserDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("user delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Args:      args("user delete --id user-1"),
+			API:       mock.API{DeleteUserFn: deleteUserError},
+			WantError: errTest.Error(),
+		},
+		{
+			Args:       args("user delete --id user-1"),
+			API:        mock.API{DeleteUserFn: deleteUserOK},
+			WantOutput: "Deleted user user-1",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createUserOK(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return &fastly.User{ID: "user-1", Login: i.Login, Name: i.Name, Role: i.Role}, nil
+}
+
+func createUserError(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return nil, errTest
+}
+
+func getCurrentUserOK() (*fastly.User, error) {
+	return &fastly.User{ID: "user-1", CustomerID: "abc"}, nil
+}
+
+func getCurrentUserError() (*fastly.User, error) {
+	return nil, errTest
+}
+
+func listUsersOK(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	if i.CustomerID != "abc" {
+		return nil, errTest
+	}
+	return []*fastly.User{
+		{ID: "user-1", Login: "test@example.com", Name: "Test", Role: "superuser", CustomerID: "abc"},
+		{ID: "user-2", Login: "dev@example.com", Name: "Dev", Role: "engineer", CustomerID: "abc"},
+	}, nil
+}
+
+func listUsersError(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	return nil, errTest
+}
+
+var listUsersShortOutput = strings.TrimSpace(`
+ID      LOGIN             NAME  ROLE
+user-1  test@example.com  Test  superuser
+user-2  dev@example.com   Dev   engineer
+`) + "\n"
+
+var listUsersVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Customer ID: abc",
+	"	User 1/2",
+	"		ID: user-1",
+	"		Login: test@example.com",
+	"		Name: Test",
+	"		Role: superuser",
+	"		Customer ID: abc",
+	"		Limit services: false",
+	"		Locked: false",
+	"		Two-factor auth enabled: false",
+	"	User 2/2",
+	"		ID: user-2",
+	"		Login: dev@example.com",
+	"		Name: Dev",
+	"		Role: engineer",
+	"		Customer ID: abc",
+	"		Limit services: false",
+	"		Locked: false",
+	"		Two-factor auth enabled: false",
+}, "\n") + "\n\n"
+
+func getUserOK(i *fastly.GetUserInput) (*fastly.User, error) {
+	return &fastly.User{
+		ID:                   i.ID,
+		Login:                "test@example.com",
+		Name:                 "Test",
+		Role:                 "superuser",
+		CustomerID:           "abc",
+		TwoFactorAuthEnabled: true,
+	}, nil
+}
+
+func getUserError(i *fastly.GetUserInput) (*fastly.User, error) {
+	return nil, errTest
+}
+
+var describeUserOutput = strings.Join([]string{
+	"ID: user-1",
+	"Login: test@example.com",
+	"Name: Test",
+	"Role: superuser",
+	"Customer ID: abc",
+	"Limit services: false",
+	"Locked: false",
+	"Two-factor auth enabled: true",
+}, "\n") + "\n"
+
+func updateUserOK(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	if i.Name != nil || i.Role == nil {
+		return nil, errTest
+	}
+	return &fastly.User{ID: i.ID, Login: "test@example.com", Role: *i.Role}, nil
+}
+
+func updateUserError(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	return nil, errTest
+}
+
+func deleteUserOK(i *fastly.DeleteUserInput) error {
+	return nil
+}
+
+func deleteUserError(i *fastly.DeleteUserInput) error {
+	return errTest
+}
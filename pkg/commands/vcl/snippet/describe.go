@@ -79,6 +79,9 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 			})
 			return err
 		}
+		if c.Globals.JSON() {
+			return c.WriteJSON(out, v)
+		}
 		c.printDynamic(out, v)
 		return nil
 	}
@@ -99,6 +102,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		})
 		return err
 	}
+
+	if c.Globals.JSON() {
+		return c.WriteJSON(out, v)
+	}
 	c.print(out, v)
 	return nil
 }
@@ -43,7 +43,10 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 		return fmt.Errorf("error constructing API request: %w", err)
 	}
 
-	token, source := c.Globals.Token()
+	token, source, err := c.Globals.Token()
+	if err != nil {
+		return err
+	}
 	if source == config.SourceUndefined {
 		return errors.ErrNoToken
 	}
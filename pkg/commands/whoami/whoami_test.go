@@ -90,6 +90,58 @@ func TestWhoami(t *testing.T) {
 	}
 }
 
+func TestWhoamiEncryptedToken(t *testing.T) {
+	encrypted, err := config.EncryptToken("x", "secret")
+	testutil.AssertNoError(t, err)
+	file := config.File{User: config.User{EncryptedToken: encrypted}}
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		env        config.Environment
+		stdin      string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:       "passphrase from environment",
+			args:       args("whoami"),
+			env:        config.Environment{TokenPassphrase: "secret"},
+			wantOutput: basicOutput,
+		},
+		{
+			name:       "passphrase prompted",
+			args:       args("whoami"),
+			stdin:      "secret\n",
+			wantOutput: "Passphrase for the encrypted API token: \n" + basicOutput,
+		},
+		{
+			name:      "incorrect passphrase",
+			args:      args("whoami"),
+			env:       config.Environment{TokenPassphrase: "wrong"},
+			wantError: "incorrect passphrase",
+		},
+		{
+			name:       "token flag doesn't need the passphrase",
+			args:       args("--token=x whoami"),
+			wantOutput: basicOutput,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.ConfigFile = file
+			opts.Env = testcase.env
+			opts.HTTPClient = keyClient{key: "x", client: verifyClient(basicResponse)}
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
 type verifyClient whoami.VerifyResponse
 
 func (c verifyClient) Do(*http.Request) (*http.Response, error) {
@@ -98,6 +150,19 @@ func (c verifyClient) Do(*http.Request) (*http.Response, error) {
 	return rec.Result(), nil
 }
 
+// keyClient fails any request that isn't authenticated with the key.
+type keyClient struct {
+	key    string
+	client api.HTTPClient
+}
+
+func (c keyClient) Do(req *http.Request) (*http.Response, error) {
+	if have := req.Header.Get("Fastly-Key"); have != c.key {
+		return nil, fmt.Errorf("want Fastly-Key %q, have %q", c.key, have)
+	}
+	return c.client.Do(req)
+}
+
 type codeClient struct {
 	code int
 }
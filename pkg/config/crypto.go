@@ -0,0 +1,119 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedTokenPrefix versions the encrypted token format so that the key
+// derivation parameters can be changed in future without breaking existing
+// config files.
+const encryptedTokenPrefix = "v1:"
+
+const (
+	saltSize = 16
+	keySize  = 32
+
+	// scrypt cost parameters, as recommended for interactive logins.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrIncorrectPassphrase indicates an encrypted token couldn't be decrypted,
+// most likely because the wrong passphrase was provided.
+var ErrIncorrectPassphrase = errors.New("unable to decrypt the API token: incorrect passphrase")
+
+// ErrTokenLocked indicates the encrypted token from the config file was
+// needed before it had been decrypted.
+var ErrTokenLocked = errors.New("the stored API token is encrypted and hasn't been unlocked")
+
+// EncryptToken seals the API token with AES-GCM, using a key derived from the
+// passphrase with scrypt. The returned string is safe to store in the config
+// file and includes the random salt and nonce needed to decrypt it.
+func EncryptToken(token, passphrase string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", fmt.Errorf("error generating salt: %w", err)
+	}
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	sealed := append(salt, nonce...)
+	sealed = gcm.Seal(sealed, nonce, []byte(token), nil)
+	return encryptedTokenPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptToken reverses EncryptToken.
+func DecryptToken(encrypted, passphrase string) (string, error) {
+	if !strings.HasPrefix(encrypted, encryptedTokenPrefix) {
+		return "", fmt.Errorf("unsupported encrypted token format")
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(encrypted, encryptedTokenPrefix))
+	if err != nil {
+		return "", fmt.Errorf("error decoding encrypted token: %w", err)
+	}
+	if len(sealed) < saltSize {
+		return "", fmt.Errorf("encrypted token is truncated")
+	}
+
+	salt, sealed := sealed[:saltSize], sealed[saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted token is truncated")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	token, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrIncorrectPassphrase
+	}
+	return string(token), nil
+}
+
+// SealToken returns the plaintext and encrypted token values to store in the
+// config file for token, encrypting it when passphrase isn't empty (see
+// Passphrase).
+func SealToken(token, passphrase string) (plaintext, encrypted string, err error) {
+	if passphrase == "" {
+		return token, "", nil
+	}
+	encrypted, err = EncryptToken(token, passphrase)
+	if err != nil {
+		return "", "", err
+	}
+	return "", encrypted, nil
+}
+
+// newGCM derives a key from the passphrase and salt, returning an AES-GCM
+// cipher using it.
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,135 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestEncryptToken(t *testing.T) {
+	encrypted, err := config.EncryptToken("abcdef", "secret")
+	testutil.AssertNoError(t, err)
+	if strings.Contains(encrypted, "abcdef") {
+		t.Fatalf("encrypted token contains the plaintext: %s", encrypted)
+	}
+
+	// The salt and nonce are random, so the same token never encrypts to the
+	// same value twice.
+	again, err := config.EncryptToken("abcdef", "secret")
+	testutil.AssertNoError(t, err)
+	if encrypted == again {
+		t.Fatal("want distinct ciphertexts, have identical ones")
+	}
+
+	token, err := config.DecryptToken(encrypted, "secret")
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "abcdef", token)
+
+	_, err = config.DecryptToken(encrypted, "wrong")
+	if !errors.Is(err, config.ErrIncorrectPassphrase) {
+		t.Fatalf("want %v, have %v", config.ErrIncorrectPassphrase, err)
+	}
+
+	for _, malformed := range []string{"abcdef", "v1:!!!", "v1:AAAA"} {
+		_, err = config.DecryptToken(malformed, "secret")
+		if err == nil {
+			t.Fatalf("want error decrypting %q, have none", malformed)
+		}
+	}
+}
+
+func TestDataUnlock(t *testing.T) {
+	encrypted, err := config.EncryptToken("encrypted-token", "secret")
+	testutil.AssertNoError(t, err)
+
+	for _, testcase := range []struct {
+		name          string
+		data          config.Data
+		passphrase    string
+		wantEncrypted bool
+		wantError     string
+		wantToken     string
+		wantSource    config.Source
+		wantLocked    bool
+	}{
+		{
+			name:          "user section",
+			data:          config.Data{File: config.File{User: config.User{EncryptedToken: encrypted}}},
+			passphrase:    "secret",
+			wantEncrypted: true,
+			wantToken:     "encrypted-token",
+			wantSource:    config.SourceFile,
+		},
+		{
+			name: "profile",
+			data: config.Data{File: config.File{
+				User:     config.User{Token: "user-token"},
+				Profiles: map[string]*config.Profile{"prod": {Default: true, EncryptedToken: encrypted}},
+			}},
+			passphrase:    "secret",
+			wantEncrypted: true,
+			wantToken:     "encrypted-token",
+			wantSource:    config.SourceFile,
+		},
+		{
+			name:       "plaintext token takes precedence",
+			data:       config.Data{File: config.File{User: config.User{Token: "user-token", EncryptedToken: encrypted}}},
+			passphrase: "wrong",
+			wantToken:  "user-token",
+			wantSource: config.SourceFile,
+		},
+		{
+			name:       "token flag takes precedence",
+			data:       config.Data{File: config.File{User: config.User{EncryptedToken: encrypted}}, Flag: config.Flag{Token: "flag-token"}},
+			passphrase: "wrong",
+			wantToken:  "flag-token",
+			wantSource: config.SourceFlag,
+		},
+		{
+			name:          "incorrect passphrase",
+			data:          config.Data{File: config.File{User: config.User{EncryptedToken: encrypted}}},
+			passphrase:    "wrong",
+			wantEncrypted: true,
+			wantError:     "incorrect passphrase",
+			wantSource:    config.SourceFile,
+			wantLocked:    true,
+		},
+		{
+			name: "locked profile doesn't fall back to the user section",
+			data: config.Data{File: config.File{
+				User:     config.User{Token: "user-token"},
+				Profiles: map[string]*config.Profile{"prod": {Default: true, EncryptedToken: encrypted}},
+			}},
+			passphrase:    "wrong",
+			wantEncrypted: true,
+			wantError:     "incorrect passphrase",
+			wantSource:    config.SourceFile,
+			wantLocked:    true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if have := testcase.data.EncryptedToken() != ""; have != testcase.wantEncrypted {
+				t.Fatalf("want encrypted token %t, have %t", testcase.wantEncrypted, have)
+			}
+
+			err := testcase.data.Unlock(testcase.passphrase)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+
+			token, source, err := testcase.data.Token()
+			if testcase.wantLocked {
+				if !errors.Is(err, config.ErrTokenLocked) {
+					t.Fatalf("want %v, have %v", config.ErrTokenLocked, err)
+				}
+			} else {
+				testutil.AssertNoError(t, err)
+			}
+			testutil.AssertString(t, testcase.wantToken, token)
+			if source != testcase.wantSource {
+				t.Fatalf("want source %v, have %v", testcase.wantSource, source)
+			}
+		})
+	}
+}
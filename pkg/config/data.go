@@ -16,6 +16,7 @@ import (
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/filesystem"
 	"github.com/fastly/cli/pkg/revision"
+	"github.com/fastly/cli/pkg/session"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/cli/pkg/useragent"
 	toml "github.com/pelletier/go-toml"
@@ -98,23 +99,130 @@ type Data struct {
 
 	Client    api.Interface
 	RTSClient api.RealtimeStatsInterface
+
+	// Session is the edit session in progress, if any. Commands target its
+	// service and draft version by default.
+	Session *session.State
+
+	// decryptedToken caches the encrypted token from the config file once
+	// it's been unlocked (see Unlock), along with the passphrase used.
+	decryptedToken string
+	passphrase     string
 }
 
 // Token yields the Fastly API token.
-func (d *Data) Token() (string, Source) {
+//
+// When a credential profile is selected (see Profile) its token takes
+// precedence over the token stored in the [user] section of the config file.
+// An encrypted token is only yielded once it has been unlocked. Until then an
+// error is returned, rather than falling back to a token the user didn't
+// select.
+func (d *Data) Token() (string, Source, error) {
 	if d.Flag.Token != "" {
-		return d.Flag.Token, SourceFlag
+		return d.Flag.Token, SourceFlag, nil
 	}
 
 	if d.Env.Token != "" {
-		return d.Env.Token, SourceEnvironment
+		return d.Env.Token, SourceEnvironment, nil
+	}
+
+	if _, p := d.SelectedProfile(); p != nil {
+		if p.Token != "" {
+			return p.Token, SourceFile, nil
+		}
+		if p.EncryptedToken != "" {
+			return d.unlockedToken()
+		}
 	}
 
 	if d.File.User.Token != "" {
-		return d.File.User.Token, SourceFile
+		return d.File.User.Token, SourceFile, nil
 	}
 
-	return "", SourceUndefined
+	if d.File.User.EncryptedToken != "" {
+		return d.unlockedToken()
+	}
+
+	return "", SourceUndefined, nil
+}
+
+// unlockedToken yields the encrypted token from the config file once it's
+// been unlocked (see Unlock).
+func (d *Data) unlockedToken() (string, Source, error) {
+	if d.decryptedToken == "" {
+		return "", SourceFile, fsterr.RemediationError{
+			Inner:       ErrTokenLocked,
+			Remediation: fmt.Sprintf("Provide the passphrase of the stored token via %s, or run the command interactively to be prompted for it.", env.TokenPassphrase),
+		}
+	}
+	return d.decryptedToken, SourceFile, nil
+}
+
+// EncryptedToken yields the encrypted token from the config file that Token
+// would otherwise use, or an empty string if a plaintext token takes
+// precedence or there's no encrypted token stored.
+func (d *Data) EncryptedToken() string {
+	if d.Flag.Token != "" || d.Env.Token != "" {
+		return ""
+	}
+
+	if _, p := d.SelectedProfile(); p != nil {
+		if p.Token != "" {
+			return ""
+		}
+		if p.EncryptedToken != "" {
+			return p.EncryptedToken
+		}
+	}
+
+	if d.File.User.Token != "" {
+		return ""
+	}
+	return d.File.User.EncryptedToken
+}
+
+// Unlock decrypts the encrypted token from the config file, if there is one,
+// so that it's subsequently yielded by Token.
+func (d *Data) Unlock(passphrase string) error {
+	encrypted := d.EncryptedToken()
+	if encrypted == "" {
+		return nil
+	}
+	token, err := DecryptToken(encrypted, passphrase)
+	if err != nil {
+		return err
+	}
+	d.decryptedToken = token
+	d.passphrase = passphrase
+	return nil
+}
+
+// Passphrase returns the passphrase to encrypt a new token with before it
+// replaces encrypted in the config file, so that a token the user chose to
+// encrypt is never replaced by a plaintext one. It's the passphrase which
+// unlocked the stored token, or otherwise the one from the environment, and
+// either way must decrypt encrypted. An empty string is returned when
+// encrypted is empty, meaning the new token is stored as plaintext.
+func (d *Data) Passphrase(encrypted string) (string, error) {
+	if encrypted == "" {
+		return "", nil
+	}
+	for _, passphrase := range []string{d.passphrase, d.Env.TokenPassphrase} {
+		if passphrase == "" {
+			continue
+		}
+		if _, err := DecryptToken(encrypted, passphrase); err == nil {
+			return passphrase, nil
+		}
+	}
+	remediation := fmt.Sprintf("Set %s to the passphrase of the stored token, so that the new token is encrypted with it too. Alternatively run `fastly configure --encrypt` to replace the stored token.", env.TokenPassphrase)
+	if d.Env.TokenPassphrase != "" {
+		return "", fsterr.RemediationError{Inner: ErrIncorrectPassphrase, Remediation: remediation}
+	}
+	return "", fsterr.RemediationError{
+		Inner:       fmt.Errorf("the stored API token is encrypted and no passphrase was provided"),
+		Remediation: remediation,
+	}
 }
 
 // Verbose yields the verbose flag, which can only be set via flags.
@@ -122,6 +230,16 @@ func (d *Data) Verbose() bool {
 	return d.Flag.Verbose
 }
 
+// DryRun yields the dry-run flag, which can only be set via flags.
+func (d *Data) DryRun() bool {
+	return d.Flag.DryRun
+}
+
+// JSON yields the json flag, which can only be set via flags.
+func (d *Data) JSON() bool {
+	return d.Flag.JSON
+}
+
 // Endpoint yields the API endpoint.
 func (d *Data) Endpoint() (string, Source) {
 	if d.Flag.Endpoint != "" {
@@ -132,6 +250,10 @@ func (d *Data) Endpoint() (string, Source) {
 		return d.Env.Endpoint, SourceEnvironment
 	}
 
+	if _, p := d.SelectedProfile(); p != nil && p.APIEndpoint != DefaultEndpoint && p.APIEndpoint != "" {
+		return p.APIEndpoint, SourceFile
+	}
+
 	if d.File.Fastly.APIEndpoint != DefaultEndpoint && d.File.Fastly.APIEndpoint != "" {
 		return d.File.Fastly.APIEndpoint, SourceFile
 	}
@@ -139,6 +261,38 @@ func (d *Data) Endpoint() (string, Source) {
 	return DefaultEndpoint, SourceDefault // this method should not fail
 }
 
+// Profile yields the name of the selected credential profile.
+//
+// A profile can be selected explicitly via the --profile flag or the
+// FASTLY_PROFILE environment variable, otherwise the profile marked as the
+// default within the config file is used.
+func (d *Data) Profile() (string, Source) {
+	if d.Flag.Profile != "" {
+		return d.Flag.Profile, SourceFlag
+	}
+
+	if d.Env.Profile != "" {
+		return d.Env.Profile, SourceEnvironment
+	}
+
+	if name := d.File.DefaultProfile(); name != "" {
+		return name, SourceFile
+	}
+
+	return "", SourceUndefined
+}
+
+// SelectedProfile returns the name and data of the selected credential
+// profile. The returned profile is nil if no profile was selected or if the
+// selected profile doesn't exist in the config file.
+func (d *Data) SelectedProfile() (string, *Profile) {
+	name, _ := d.Profile()
+	if name == "" {
+		return name, nil
+	}
+	return name, d.File.Profiles[name]
+}
+
 // FilePath is the location of the fastly CLI application config file.
 var FilePath = func() string {
 	if dir, err := os.UserConfigDir(); err == nil {
@@ -174,6 +328,7 @@ type File struct {
 	Fastly        Fastly              `toml:"fastly"`
 	CLI           CLI                 `toml:"cli"`
 	User          User                `toml:"user"`
+	Profiles      map[string]*Profile `toml:"profile,omitempty"`
 	Language      Language            `toml:"language"`
 	StarterKits   StarterKitLanguages `toml:"starter-kits"`
 
@@ -201,8 +356,40 @@ type CLI struct {
 
 // User represents user specific configuration.
 type User struct {
-	Token string `toml:"token"`
-	Email string `toml:"email"`
+	Token          string `toml:"token"`
+	EncryptedToken string `toml:"encrypted_token,omitempty"`
+	Email          string `toml:"email"`
+}
+
+// Profile represents a named set of user credentials.
+type Profile struct {
+	APIEndpoint string `toml:"api_endpoint"`
+	Default     bool   `toml:"default"`
+	Email       string `toml:"email"`
+	Token       string `toml:"token"`
+
+	EncryptedToken string `toml:"encrypted_token,omitempty"`
+}
+
+// DefaultProfile returns the name of the profile marked as the default, or an
+// empty string if there is no default profile.
+func (f *File) DefaultProfile() string {
+	for name, p := range f.Profiles {
+		if p != nil && p.Default {
+			return name
+		}
+	}
+	return ""
+}
+
+// SetDefaultProfile marks the named profile as the default and ensures no
+// other profile is also marked as the default.
+func (f *File) SetDefaultProfile(name string) {
+	for n, p := range f.Profiles {
+		if p != nil {
+			p.Default = n == name
+		}
+	}
 }
 
 // Language represents C@E language specific configuration.
@@ -475,17 +662,38 @@ func (f *File) Write(fpath string) error {
 	return nil
 }
 
+// CheckFilePermissions warns if the config file at fpath is accessible by
+// anyone other than its owner, e.g. because it was altered outside of the
+// application. A missing file isn't reported.
+func CheckFilePermissions(out io.Writer, fpath string) {
+	fi, err := os.Stat(fpath)
+	if err != nil {
+		return
+	}
+	if mode := fi.Mode().Perm(); mode > FilePermissions {
+		text.Warning(out, "Unprotected configuration file.")
+		fmt.Fprintf(out, "Permissions %04o for '%s' are too open\n", mode, fpath)
+		fmt.Fprintf(out, "It is recommended that your configuration file is NOT accessible by others.\n")
+		fmt.Fprintln(out)
+	}
+}
+
 // Environment represents all of the configuration parameters that can come
 // from environment variables.
 type Environment struct {
 	Token    string
 	Endpoint string
+	Profile  string
+
+	TokenPassphrase string
 }
 
 // Read populates the fields from the provided environment.
 func (e *Environment) Read(state map[string]string) {
 	e.Token = state[env.Token]
 	e.Endpoint = state[env.Endpoint]
+	e.Profile = state[env.Profile]
+	e.TokenPassphrase = state[env.TokenPassphrase]
 }
 
 // Flag represents all of the configuration parameters that can be set with
@@ -495,6 +703,9 @@ type Flag struct {
 	Token    string
 	Verbose  bool
 	Endpoint string
+	Profile  string
+	JSON     bool
+	DryRun   bool
 }
 
 // This suggests our embedded config is unexpectedly faulty and so we should
@@ -420,3 +420,111 @@ func TestValidConfig(t *testing.T) {
 		})
 	}
 }
+
+// TestDataProfile validates the credential profile selection and the
+// precedence of profile data when resolving the token and endpoint.
+func TestDataProfile(t *testing.T) {
+	file := config.File{
+		User: config.User{Token: "user-token"},
+		Profiles: map[string]*config.Profile{
+			"prod":    {Default: true, Token: "prod-token"},
+			"staging": {Token: "staging-token", APIEndpoint: "https://staging.example.com"},
+		},
+	}
+
+	for _, testcase := range []struct {
+		name         string
+		data         config.Data
+		wantProfile  string
+		wantToken    string
+		wantSource   config.Source
+		wantEndpoint string
+	}{
+		{
+			name:         "no profiles uses the user section",
+			data:         config.Data{File: config.File{User: config.User{Token: "user-token"}}},
+			wantToken:    "user-token",
+			wantSource:   config.SourceFile,
+			wantEndpoint: config.DefaultEndpoint,
+		},
+		{
+			name:         "default profile",
+			data:         config.Data{File: file},
+			wantProfile:  "prod",
+			wantToken:    "prod-token",
+			wantSource:   config.SourceFile,
+			wantEndpoint: config.DefaultEndpoint,
+		},
+		{
+			name:         "profile from environment",
+			data:         config.Data{File: file, Env: config.Environment{Profile: "staging"}},
+			wantProfile:  "staging",
+			wantToken:    "staging-token",
+			wantSource:   config.SourceFile,
+			wantEndpoint: "https://staging.example.com",
+		},
+		{
+			name:         "profile from flag overrides environment",
+			data:         config.Data{File: file, Env: config.Environment{Profile: "staging"}, Flag: config.Flag{Profile: "prod"}},
+			wantProfile:  "prod",
+			wantToken:    "prod-token",
+			wantSource:   config.SourceFile,
+			wantEndpoint: config.DefaultEndpoint,
+		},
+		{
+			name:         "token flag overrides profile",
+			data:         config.Data{File: file, Flag: config.Flag{Profile: "staging", Token: "flag-token"}},
+			wantProfile:  "staging",
+			wantToken:    "flag-token",
+			wantSource:   config.SourceFlag,
+			wantEndpoint: "https://staging.example.com",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			profile, _ := testcase.data.Profile()
+			testutil.AssertString(t, testcase.wantProfile, profile)
+
+			token, source, err := testcase.data.Token()
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, testcase.wantToken, token)
+			if source != testcase.wantSource {
+				t.Fatalf("want source %v, have %v", testcase.wantSource, source)
+			}
+
+			endpoint, _ := testcase.data.Endpoint()
+			testutil.AssertString(t, testcase.wantEndpoint, endpoint)
+		})
+	}
+}
+
+func TestCheckFilePermissions(t *testing.T) {
+	fpath := testutil.MakeTempFile(t, "")
+	defer os.RemoveAll(fpath)
+
+	for _, testcase := range []struct {
+		mode        os.FileMode
+		wantWarning bool
+	}{
+		{mode: 0600},
+		{mode: 0400},
+		{mode: 0644, wantWarning: true},
+		{mode: 0660, wantWarning: true},
+	} {
+		t.Run(testcase.mode.String(), func(t *testing.T) {
+			testutil.AssertNoError(t, os.Chmod(fpath, testcase.mode))
+
+			var stdout bytes.Buffer
+			config.CheckFilePermissions(&stdout, fpath)
+
+			if testcase.wantWarning {
+				testutil.AssertStringContains(t, stdout.String(), "are too open")
+			} else {
+				testutil.AssertString(t, "", stdout.String())
+			}
+		})
+	}
+
+	var stdout bytes.Buffer
+	config.CheckFilePermissions(&stdout, filepath.Join(os.TempDir(), "missing-config.toml"))
+	testutil.AssertString(t, "", stdout.String())
+}
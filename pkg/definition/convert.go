@@ -0,0 +1,195 @@
+package definition
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// metadataKeys are fields returned by the API which describe where a resource
+// lives rather than how it is configured.
+var metadataKeys = map[string]bool{
+	"acl_id":        true,
+	"created_at":    true,
+	"deleted_at":    true,
+	"dictionary_id": true,
+	"id":            true,
+	"pool_id":       true,
+	"service_id":    true,
+	"updated_at":    true,
+	"version":       true,
+}
+
+// NewResource converts a go-fastly resource struct (or pointer to one) into a
+// normalised Resource, using the struct's mapstructure tags as keys.
+func NewResource(v interface{}) Resource {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	rt := rv.Type()
+
+	r := make(Resource)
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		key := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if key == "" || metadataKeys[key] {
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.IsZero() && key != "name" {
+			continue
+		}
+		r[key] = normalise(fv.Interface())
+	}
+	return r
+}
+
+// resources converts a slice of go-fastly resource structs into Resources.
+func resources(v interface{}) []Resource {
+	rv := reflect.ValueOf(v)
+	rs := make([]Resource, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		rs = append(rs, NewResource(rv.Index(i).Interface()))
+	}
+	return rs
+}
+
+// normalise reduces a value to one of a small set of types (string, bool,
+// int64, float64 or []interface{}) so that values fetched from the API and
+// values decoded from a file can be compared directly.
+func normalise(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalise(rv.Elem().Interface())
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); f == math.Trunc(f) {
+			return int64(f)
+		}
+		return rv.Float()
+	case reflect.Slice, reflect.Array:
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			out[i] = normalise(rv.Index(i).Interface())
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// populate sets the fields of the go-fastly input struct pointed to by dst from
+// r, matching keys against the struct's form tags. A nil value sets the field
+// to its zero value.
+//
+// Keys without a corresponding field are ignored when creating a resource, as
+// the API returns some read-only fields. When updating (strict is true) they
+// are reported as an error because the change could never be applied.
+func (r Resource) populate(dst interface{}, strict bool) error {
+	rv := reflect.ValueOf(dst).Elem()
+	rt := rv.Type()
+
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < rt.NumField(); i++ {
+		tag := strings.Split(rt.Field(i).Tag.Get("form"), ",")[0]
+		if tag != "" {
+			fields[tag] = rv.Field(i)
+		}
+	}
+
+	keys := make([]string, 0, len(r))
+	for k := range r {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if r[k] == Redacted {
+			return fmt.Errorf("the value of '%s' is redacted, replace it with the real value", k)
+		}
+		f, ok := fields[k]
+		if !ok {
+			if strict {
+				return fmt.Errorf("'%s' cannot be updated", k)
+			}
+			continue
+		}
+		if err := assign(f, r[k]); err != nil {
+			return fmt.Errorf("invalid value for '%s': %w", k, err)
+		}
+	}
+	return nil
+}
+
+// assign sets dst to the normalised value v, converting it to the type of dst.
+func assign(dst reflect.Value, v interface{}) error {
+	if dst.Kind() == reflect.Ptr {
+		p := reflect.New(dst.Type().Elem())
+		if err := assign(p.Elem(), v); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	}
+
+	v = normalise(v)
+	if v == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %v", v)
+		}
+		dst.SetString(s)
+	case reflect.Bool:
+		b, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean, got %v", v)
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("expected an integer, got %v", v)
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := v.(int64)
+		if !ok || n < 0 {
+			return fmt.Errorf("expected a positive integer, got %v", v)
+		}
+		dst.SetUint(uint64(n))
+	case reflect.Slice:
+		vs, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected a list, got %v", v)
+		}
+		s := reflect.MakeSlice(dst.Type(), len(vs), len(vs))
+		for i := range vs {
+			if err := assign(s.Index(i), vs[i]); err != nil {
+				return err
+			}
+		}
+		dst.Set(s)
+	default:
+		return fmt.Errorf("unsupported field type %s", dst.Type())
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package definition
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	toml "github.com/pelletier/go-toml"
+)
+
+// Supported serialisation formats.
+const (
+	FormatTOML = "toml"
+	FormatJSON = "json"
+)
+
+// Redacted replaces the value of any secret field (see api.IsSecret) when a
+// definition is redacted.
+const Redacted = api.Redacted
+
+// Resource is a normalised resource, keyed by the API field name. Fields that
+// hold the API's zero value are omitted.
+type Resource map[string]interface{}
+
+// Name returns the name of the resource.
+func (r Resource) Name() string {
+	s, _ := r["name"].(string)
+	return s
+}
+
+// Dictionary is an edge dictionary along with its items.
+type Dictionary struct {
+	Name      string            `json:"name" toml:"name"`
+	WriteOnly bool              `json:"write_only,omitempty" toml:"write_only,omitempty"`
+	Items     map[string]string `json:"items,omitempty" toml:"items,omitempty"`
+}
+
+// ACL is an access control list along with its entries.
+type ACL struct {
+	Name    string     `json:"name" toml:"name"`
+	Entries []Resource `json:"entries,omitempty" toml:"entry,omitempty"`
+}
+
+// Service is the full set of resources attached to a service version.
+// DirectorBackends holds the names of the backends of each director and
+// PoolServers holds the servers of each pool, both keyed by the name of the
+// director or pool. Like the items of a dictionary, pool servers aren't
+// versioned.
+type Service struct {
+	ServiceID        string                `json:"service_id" toml:"service_id"`
+	Version          int                   `json:"version" toml:"version"`
+	Domains          []Resource            `json:"domains,omitempty" toml:"domain,omitempty"`
+	Conditions       []Resource            `json:"conditions,omitempty" toml:"condition,omitempty"`
+	Backends         []Resource            `json:"backends,omitempty" toml:"backend,omitempty"`
+	Healthchecks     []Resource            `json:"healthchecks,omitempty" toml:"healthcheck,omitempty"`
+	Directors        []Resource            `json:"directors,omitempty" toml:"director,omitempty"`
+	DirectorBackends map[string][]string   `json:"director_backends,omitempty" toml:"director_backends,omitempty"`
+	Pools            []Resource            `json:"pools,omitempty" toml:"pool,omitempty"`
+	PoolServers      map[string][]Resource `json:"pool_servers,omitempty" toml:"pool_server,omitempty"`
+	Dictionaries     []Dictionary          `json:"dictionaries,omitempty" toml:"dictionary,omitempty"`
+	ACLs             []ACL                 `json:"acls,omitempty" toml:"acl,omitempty"`
+	VCLs             []Resource            `json:"vcls,omitempty" toml:"vcl,omitempty"`
+	Snippets         []Resource            `json:"snippets,omitempty" toml:"snippet,omitempty"`
+	Headers          []Resource            `json:"headers,omitempty" toml:"header,omitempty"`
+	CacheSettings    []Resource            `json:"cache_settings,omitempty" toml:"cache_setting,omitempty"`
+	RequestSettings  []Resource            `json:"request_settings,omitempty" toml:"request_setting,omitempty"`
+	ResponseObjects  []Resource            `json:"response_objects,omitempty" toml:"response_object,omitempty"`
+	Gzips            []Resource            `json:"gzips,omitempty" toml:"gzip,omitempty"`
+	Logging          map[string][]Resource `json:"logging,omitempty" toml:"logging,omitempty"`
+}
+
+// Sort orders every collection in the definition so that the serialised
+// output is stable between runs.
+func (s *Service) Sort() {
+	for _, rs := range s.versioned() {
+		sortResources(rs)
+	}
+	for _, rs := range s.Logging {
+		sortResources(rs)
+	}
+	for _, names := range s.DirectorBackends {
+		sort.Strings(names)
+	}
+	for _, rs := range s.PoolServers {
+		sort.Slice(rs, func(i, j int) bool {
+			return ServerKey(rs[i]) < ServerKey(rs[j])
+		})
+	}
+	sort.Slice(s.Dictionaries, func(i, j int) bool {
+		return s.Dictionaries[i].Name < s.Dictionaries[j].Name
+	})
+	sort.Slice(s.ACLs, func(i, j int) bool {
+		return s.ACLs[i].Name < s.ACLs[j].Name
+	})
+	for _, a := range s.ACLs {
+		sort.Slice(a.Entries, func(i, j int) bool {
+			return EntryKey(a.Entries[i]) < EntryKey(a.Entries[j])
+		})
+	}
+}
+
+// Redact replaces the value of every secret field, along with the items of
+// write-only dictionaries, with the Redacted placeholder.
+func (s *Service) Redact() {
+	redactResources("backend", s.Backends)
+	redactResources("pool", s.Pools)
+	for kind, rs := range s.Logging {
+		redactResources(kind, rs)
+	}
+	for _, d := range s.Dictionaries {
+		if !d.WriteOnly {
+			continue
+		}
+		for k := range d.Items {
+			d.Items[k] = Redacted
+		}
+	}
+}
+
+// Encode serialises the definition to w in the given format.
+func (s *Service) Encode(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	case FormatTOML:
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Order(toml.OrderAlphabetical).Encode(s); err != nil {
+			return err
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// Decode reads a definition in the given format from r. Values are normalised
+// so that they can be compared with a definition returned by Fetch.
+func Decode(r io.Reader, format string) (*Service, error) {
+	var s Service
+	switch format {
+	case FormatJSON:
+		if err := json.NewDecoder(r).Decode(&s); err != nil {
+			return nil, err
+		}
+	case FormatTOML:
+		if err := toml.NewDecoder(r).Decode(&s); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	for _, rs := range s.resources() {
+		for _, r := range rs {
+			for k, v := range r {
+				r[k] = normalise(v)
+			}
+		}
+	}
+	s.Sort()
+	return &s, nil
+}
+
+// ReadFile reads a definition from disk, inferring the format from the file
+// extension.
+func ReadFile(path string) (*Service, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // #nosec G307
+
+	s, err := Decode(f, FormatFromPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// FormatFromPath infers the serialisation format from a file extension,
+// defaulting to TOML.
+func FormatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatTOML
+}
+
+// EntryKey identifies an ACL entry by its IP and subnet.
+func EntryKey(r Resource) string {
+	ip, _ := r["ip"].(string)
+	if subnet, ok := r["subnet"].(int64); ok {
+		return fmt.Sprintf("%s/%d", ip, subnet)
+	}
+	return ip
+}
+
+// ServerKey identifies a pool server by its address and port.
+func ServerKey(r Resource) string {
+	address, _ := r["address"].(string)
+	if port, ok := r["port"].(int64); ok {
+		return fmt.Sprintf("%s:%d", address, port)
+	}
+	return address
+}
+
+// versioned returns the slices of named, versioned Resources held by the
+// definition, other than logging endpoints.
+func (s *Service) versioned() [][]Resource {
+	return [][]Resource{
+		s.Domains, s.Conditions, s.Backends, s.Healthchecks, s.Directors, s.Pools, s.VCLs, s.Snippets,
+		s.Headers, s.CacheSettings, s.RequestSettings, s.ResponseObjects, s.Gzips,
+	}
+}
+
+// resources returns every slice of Resources held by the definition.
+func (s *Service) resources() [][]Resource {
+	rs := s.versioned()
+	for _, a := range s.ACLs {
+		rs = append(rs, a.Entries)
+	}
+	for _, l := range s.Logging {
+		rs = append(rs, l)
+	}
+	for _, servers := range s.PoolServers {
+		rs = append(rs, servers)
+	}
+	return rs
+}
+
+func sortResources(rs []Resource) {
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name() < rs[j].Name()
+	})
+}
+
+func redactResources(kind string, rs []Resource) {
+	for _, r := range rs {
+		for k := range r {
+			if api.IsSecret(kind, k) {
+				r[k] = Redacted
+			}
+		}
+	}
+}
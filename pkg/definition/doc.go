@@ -0,0 +1,4 @@
+// Package definition contains a normalised, declarative representation of the
+// resources attached to a Fastly service version, along with the logic for
+// fetching it from the API and serialising it to disk.
+package definition
@@ -0,0 +1,226 @@
+package definition
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// concurrency is the maximum number of API requests Fetch has in flight.
+const concurrency = 8
+
+// Fetch retrieves every resource attached to a service version and returns
+// them as a sorted, unredacted Service. The API requests are issued
+// concurrently.
+func Fetch(c api.Interface, serviceID string, version int) (*Service, error) {
+	s := &Service{
+		ServiceID: serviceID,
+		Version:   version,
+		Logging:   make(map[string][]Resource),
+	}
+	f := newFetcher()
+
+	f.list(c, serviceID, version, DomainKind, func(rs []Resource) { s.Domains = rs })
+	f.list(c, serviceID, version, ConditionKind, func(rs []Resource) { s.Conditions = rs })
+	f.list(c, serviceID, version, BackendKind, func(rs []Resource) { s.Backends = rs })
+	f.list(c, serviceID, version, HealthcheckKind, func(rs []Resource) { s.Healthchecks = rs })
+	f.list(c, serviceID, version, DirectorKind, func(rs []Resource) { s.Directors = rs })
+	f.list(c, serviceID, version, VCLKind, func(rs []Resource) { s.VCLs = rs })
+	f.list(c, serviceID, version, SnippetKind, func(rs []Resource) { s.Snippets = rs })
+	f.list(c, serviceID, version, HeaderKind, func(rs []Resource) { s.Headers = rs })
+	f.list(c, serviceID, version, CacheSettingKind, func(rs []Resource) { s.CacheSettings = rs })
+	f.list(c, serviceID, version, RequestSettingKind, func(rs []Resource) { s.RequestSettings = rs })
+	f.list(c, serviceID, version, ResponseObjectKind, func(rs []Resource) { s.ResponseObjects = rs })
+	f.list(c, serviceID, version, GzipKind, func(rs []Resource) { s.Gzips = rs })
+	for _, k := range LoggingKinds {
+		name := k.Name
+		f.list(c, serviceID, version, k, func(rs []Resource) {
+			if len(rs) > 0 {
+				s.Logging[name] = rs
+			}
+		})
+	}
+
+	f.run(func() error {
+		ds, err := c.ListDictionaries(&fastly.ListDictionariesInput{ServiceID: serviceID, ServiceVersion: version})
+		if err != nil {
+			return fmt.Errorf("error listing dictionaries: %w", err)
+		}
+		dictionaries := make([]Dictionary, len(ds))
+		for i, d := range ds {
+			dictionaries[i] = Dictionary{Name: d.Name, WriteOnly: d.WriteOnly}
+		}
+		f.set(func() { s.Dictionaries = dictionaries })
+
+		for i, d := range ds {
+			i, d := i, d
+			f.run(func() error {
+				items, err := c.ListDictionaryItems(&fastly.ListDictionaryItemsInput{ServiceID: serviceID, DictionaryID: d.ID})
+				if err != nil {
+					return fmt.Errorf("error listing items for dictionary '%s': %w", d.Name, err)
+				}
+				f.set(func() {
+					if len(items) == 0 {
+						return
+					}
+					s.Dictionaries[i].Items = make(map[string]string, len(items))
+					for _, item := range items {
+						s.Dictionaries[i].Items[item.ItemKey] = item.ItemValue
+					}
+				})
+				return nil
+			})
+		}
+		return nil
+	})
+
+	f.run(func() error {
+		as, err := c.ListACLs(&fastly.ListACLsInput{ServiceID: serviceID, ServiceVersion: version})
+		if err != nil {
+			return fmt.Errorf("error listing ACLs: %w", err)
+		}
+		acls := make([]ACL, len(as))
+		for i, a := range as {
+			acls[i] = ACL{Name: a.Name}
+		}
+		f.set(func() { s.ACLs = acls })
+
+		for i, a := range as {
+			i, a := i, a
+			f.run(func() error {
+				entries, err := c.ListACLEntries(&fastly.ListACLEntriesInput{ServiceID: serviceID, ACLID: a.ID})
+				if err != nil {
+					return fmt.Errorf("error listing entries for ACL '%s': %w", a.Name, err)
+				}
+				f.set(func() { s.ACLs[i].Entries = resources(entries) })
+				return nil
+			})
+		}
+		return nil
+	})
+
+	f.run(func() error {
+		ps, err := c.ListPools(&fastly.ListPoolsInput{ServiceID: serviceID, ServiceVersion: version})
+		if err != nil {
+			return fmt.Errorf("error listing pool: %w", err)
+		}
+		pools := resources(ps)
+		f.set(func() { s.Pools = pools })
+
+		for _, p := range ps {
+			p := p
+			f.run(func() error {
+				servers, err := c.ListServers(&fastly.ListServersInput{ServiceID: serviceID, PoolID: p.ID})
+				if err != nil {
+					return fmt.Errorf("error listing servers for pool '%s': %w", p.Name, err)
+				}
+				f.set(func() {
+					if len(servers) == 0 {
+						return
+					}
+					if s.PoolServers == nil {
+						s.PoolServers = make(map[string][]Resource)
+					}
+					s.PoolServers[p.Name] = resources(servers)
+				})
+				return nil
+			})
+		}
+		return nil
+	})
+
+	if err := f.wait(); err != nil {
+		return nil, err
+	}
+
+	// The Fastly API has no endpoint for listing a director's backends, so once
+	// both are known every backend is checked for a mapping to every director.
+	for _, d := range s.Directors {
+		for _, b := range s.Backends {
+			director, backend := d.Name(), b.Name()
+			f.run(func() error {
+				_, err := c.GetDirectorBackend(&fastly.GetDirectorBackendInput{ServiceID: serviceID, ServiceVersion: version, Director: director, Backend: backend})
+				if err != nil {
+					if errors.IsNotFound(err) {
+						return nil
+					}
+					return fmt.Errorf("error checking whether director '%s' uses backend '%s': %w", director, backend, err)
+				}
+				f.set(func() {
+					if s.DirectorBackends == nil {
+						s.DirectorBackends = make(map[string][]string)
+					}
+					s.DirectorBackends[director] = append(s.DirectorBackends[director], backend)
+				})
+				return nil
+			})
+		}
+	}
+
+	if err := f.wait(); err != nil {
+		return nil, err
+	}
+	s.Sort()
+	return s, nil
+}
+
+// fetcher runs a bounded number of functions concurrently and records the
+// first error returned by any of them.
+type fetcher struct {
+	wg  sync.WaitGroup
+	mu  sync.Mutex
+	sem chan struct{}
+	err error
+}
+
+func newFetcher() *fetcher {
+	return &fetcher{sem: make(chan struct{}, concurrency)}
+}
+
+// run schedules fn. It is safe to call run from within a scheduled function.
+func (f *fetcher) run(fn func() error) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		f.sem <- struct{}{}
+		err := fn()
+		<-f.sem
+		if err != nil {
+			f.set(func() {
+				if f.err == nil {
+					f.err = err
+				}
+			})
+		}
+	}()
+}
+
+// list schedules a List call for the given kind, passing the converted
+// resources to assign while holding the fetcher's lock.
+func (f *fetcher) list(c api.Interface, serviceID string, version int, k Kind, assign func([]Resource)) {
+	f.run(func() error {
+		v, err := k.List(c, serviceID, version)
+		if err != nil {
+			return fmt.Errorf("error listing %s: %w", k.Name, err)
+		}
+		rs := resources(v)
+		f.set(func() { assign(rs) })
+		return nil
+	})
+}
+
+// set calls fn while holding the fetcher's lock.
+func (f *fetcher) set(fn func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn()
+}
+
+// wait blocks until every scheduled function has returned.
+func (f *fetcher) wait() error {
+	f.wg.Wait()
+	return f.err
+}
@@ -0,0 +1,1079 @@
+package definition
+
+import (
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Kind describes a versioned resource type which is identified by name.
+type Kind struct {
+	// Name is the name of the resource type, which matches the CLI command.
+	Name string
+	// List returns a slice of go-fastly structs for the service version.
+	List func(c api.Interface, serviceID string, version int) (interface{}, error)
+	// Create creates a resource from r.
+	Create func(c api.Interface, serviceID string, version int, r Resource) error
+	// Update sets the fields held in r on the named resource.
+	Update func(c api.Interface, serviceID string, version int, name string, r Resource) error
+	// Delete deletes the named resource.
+	Delete func(c api.Interface, serviceID string, version int, name string) error
+}
+
+// Kinds for the resource types held directly on a Service.
+var (
+	DomainKind = Kind{
+		Name: "domain",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListDomains(&fastly.ListDomainsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateDomainInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateDomain(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateDomainInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateDomain(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteDomain(&fastly.DeleteDomainInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	HealthcheckKind = Kind{
+		Name: "healthcheck",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListHealthChecks(&fastly.ListHealthChecksInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateHealthCheckInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateHealthCheck(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateHealthCheckInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateHealthCheck(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteHealthCheck(&fastly.DeleteHealthCheckInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	BackendKind = Kind{
+		Name: "backend",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListBackends(&fastly.ListBackendsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateBackendInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateBackend(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateBackendInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateBackend(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteBackend(&fastly.DeleteBackendInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	DictionaryKind = Kind{
+		Name: "dictionary",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListDictionaries(&fastly.ListDictionariesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateDictionaryInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateDictionary(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateDictionaryInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateDictionary(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteDictionary(&fastly.DeleteDictionaryInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	ACLKind = Kind{
+		Name: "acl",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListACLs(&fastly.ListACLsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateACLInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateACL(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateACLInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateACL(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteACL(&fastly.DeleteACLInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	VCLKind = Kind{
+		Name: "vcl",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListVCLs(&fastly.ListVCLsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateVCLInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateVCL(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateVCLInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateVCL(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteVCL(&fastly.DeleteVCLInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	SnippetKind = Kind{
+		Name: "snippet",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListSnippets(&fastly.ListSnippetsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateSnippetInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateSnippet(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateSnippetInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateSnippet(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteSnippet(&fastly.DeleteSnippetInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	ConditionKind = Kind{
+		Name: "condition",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListConditions(&fastly.ListConditionsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateConditionInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			if _, err := c.CreateCondition(i); err != nil {
+				return err
+			}
+			// The API client can't set a comment when creating a condition, so
+			// it's set by a subsequent update.
+			if _, ok := r["comment"]; !ok {
+				return nil
+			}
+			u := &fastly.UpdateConditionInput{ServiceID: serviceID, ServiceVersion: version, Name: i.Name}
+			if err := (Resource{"comment": r["comment"]}).populate(u, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateCondition(u)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateConditionInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateCondition(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteCondition(&fastly.DeleteConditionInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	HeaderKind = Kind{
+		Name: "header",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListHeaders(&fastly.ListHeadersInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateHeaderInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateHeader(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateHeaderInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateHeader(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteHeader(&fastly.DeleteHeaderInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	CacheSettingKind = Kind{
+		Name: "cache-setting",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListCacheSettings(&fastly.ListCacheSettingsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateCacheSettingInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateCacheSetting(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateCacheSettingInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateCacheSetting(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteCacheSetting(&fastly.DeleteCacheSettingInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	RequestSettingKind = Kind{
+		Name: "request-setting",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListRequestSettings(&fastly.ListRequestSettingsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateRequestSettingInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateRequestSetting(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateRequestSettingInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateRequestSetting(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteRequestSetting(&fastly.DeleteRequestSettingInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	ResponseObjectKind = Kind{
+		Name: "response-object",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListResponseObjects(&fastly.ListResponseObjectsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateResponseObjectInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateResponseObject(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateResponseObjectInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateResponseObject(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteResponseObject(&fastly.DeleteResponseObjectInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	GzipKind = Kind{
+		Name: "gzip",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListGzips(&fastly.ListGzipsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateGzipInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateGzip(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateGzipInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateGzip(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteGzip(&fastly.DeleteGzipInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	DirectorKind = Kind{
+		Name: "director",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListDirectors(&fastly.ListDirectorsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateDirectorInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateDirector(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateDirectorInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateDirector(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteDirector(&fastly.DeleteDirectorInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+
+	PoolKind = Kind{
+		Name: "pool",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListPools(&fastly.ListPoolsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreatePoolInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreatePool(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdatePoolInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdatePool(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeletePool(&fastly.DeletePoolInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	}
+)
+
+// LoggingKinds are the supported logging endpoint providers.
+var LoggingKinds = []Kind{
+	{
+		Name: "azureblob",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListBlobStorages(&fastly.ListBlobStoragesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateBlobStorageInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateBlobStorage(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateBlobStorageInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateBlobStorage(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteBlobStorage(&fastly.DeleteBlobStorageInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "bigquery",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListBigQueries(&fastly.ListBigQueriesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateBigQueryInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateBigQuery(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateBigQueryInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateBigQuery(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteBigQuery(&fastly.DeleteBigQueryInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "cloudfiles",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListCloudfiles(&fastly.ListCloudfilesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateCloudfilesInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateCloudfiles(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateCloudfilesInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateCloudfiles(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteCloudfiles(&fastly.DeleteCloudfilesInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "datadog",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListDatadog(&fastly.ListDatadogInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateDatadogInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateDatadog(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateDatadogInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateDatadog(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteDatadog(&fastly.DeleteDatadogInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "digitalocean",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListDigitalOceans(&fastly.ListDigitalOceansInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateDigitalOceanInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateDigitalOcean(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateDigitalOceanInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateDigitalOcean(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteDigitalOcean(&fastly.DeleteDigitalOceanInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "elasticsearch",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListElasticsearch(&fastly.ListElasticsearchInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateElasticsearchInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateElasticsearch(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateElasticsearchInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateElasticsearch(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteElasticsearch(&fastly.DeleteElasticsearchInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "ftp",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListFTPs(&fastly.ListFTPsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateFTPInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateFTP(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateFTPInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateFTP(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteFTP(&fastly.DeleteFTPInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "gcs",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListGCSs(&fastly.ListGCSsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateGCSInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateGCS(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateGCSInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateGCS(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteGCS(&fastly.DeleteGCSInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "googlepubsub",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListPubsubs(&fastly.ListPubsubsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreatePubsubInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreatePubsub(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdatePubsubInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdatePubsub(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeletePubsub(&fastly.DeletePubsubInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "heroku",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListHerokus(&fastly.ListHerokusInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateHerokuInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateHeroku(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateHerokuInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateHeroku(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteHeroku(&fastly.DeleteHerokuInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "honeycomb",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListHoneycombs(&fastly.ListHoneycombsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateHoneycombInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateHoneycomb(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateHoneycombInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateHoneycomb(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteHoneycomb(&fastly.DeleteHoneycombInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "https",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListHTTPS(&fastly.ListHTTPSInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateHTTPSInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateHTTPS(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateHTTPSInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateHTTPS(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteHTTPS(&fastly.DeleteHTTPSInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "kafka",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListKafkas(&fastly.ListKafkasInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateKafkaInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateKafka(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateKafkaInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateKafka(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteKafka(&fastly.DeleteKafkaInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "kinesis",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListKinesis(&fastly.ListKinesisInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateKinesisInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateKinesis(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateKinesisInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateKinesis(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteKinesis(&fastly.DeleteKinesisInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "logentries",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListLogentries(&fastly.ListLogentriesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateLogentriesInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateLogentries(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateLogentriesInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateLogentries(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteLogentries(&fastly.DeleteLogentriesInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "loggly",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListLoggly(&fastly.ListLogglyInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateLogglyInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateLoggly(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateLogglyInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateLoggly(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteLoggly(&fastly.DeleteLogglyInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "logshuttle",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListLogshuttles(&fastly.ListLogshuttlesInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateLogshuttleInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateLogshuttle(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateLogshuttleInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateLogshuttle(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteLogshuttle(&fastly.DeleteLogshuttleInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "newrelic",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListNewRelic(&fastly.ListNewRelicInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateNewRelicInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateNewRelic(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateNewRelicInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateNewRelic(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteNewRelic(&fastly.DeleteNewRelicInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "openstack",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListOpenstack(&fastly.ListOpenstackInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateOpenstackInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateOpenstack(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateOpenstackInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateOpenstack(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteOpenstack(&fastly.DeleteOpenstackInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "papertrail",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListPapertrails(&fastly.ListPapertrailsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreatePapertrailInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreatePapertrail(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdatePapertrailInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdatePapertrail(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeletePapertrail(&fastly.DeletePapertrailInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "s3",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListS3s(&fastly.ListS3sInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateS3Input{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateS3(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateS3Input{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateS3(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteS3(&fastly.DeleteS3Input{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "scalyr",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListScalyrs(&fastly.ListScalyrsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateScalyrInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateScalyr(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateScalyrInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateScalyr(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteScalyr(&fastly.DeleteScalyrInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "sftp",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListSFTPs(&fastly.ListSFTPsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateSFTPInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateSFTP(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateSFTPInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateSFTP(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteSFTP(&fastly.DeleteSFTPInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "splunk",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListSplunks(&fastly.ListSplunksInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateSplunkInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateSplunk(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateSplunkInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateSplunk(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteSplunk(&fastly.DeleteSplunkInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "sumologic",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListSumologics(&fastly.ListSumologicsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateSumologicInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateSumologic(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateSumologicInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateSumologic(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteSumologic(&fastly.DeleteSumologicInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+	{
+		Name: "syslog",
+		List: func(c api.Interface, serviceID string, version int) (interface{}, error) {
+			return c.ListSyslogs(&fastly.ListSyslogsInput{ServiceID: serviceID, ServiceVersion: version})
+		},
+		Create: func(c api.Interface, serviceID string, version int, r Resource) error {
+			i := &fastly.CreateSyslogInput{ServiceID: serviceID, ServiceVersion: version}
+			if err := r.populate(i, false); err != nil {
+				return err
+			}
+			_, err := c.CreateSyslog(i)
+			return err
+		},
+		Update: func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+			i := &fastly.UpdateSyslogInput{ServiceID: serviceID, ServiceVersion: version, Name: name}
+			if err := r.populate(i, true); err != nil {
+				return err
+			}
+			_, err := c.UpdateSyslog(i)
+			return err
+		},
+		Delete: func(c api.Interface, serviceID string, version int, name string) error {
+			return c.DeleteSyslog(&fastly.DeleteSyslogInput{ServiceID: serviceID, ServiceVersion: version, Name: name})
+		},
+	},
+}
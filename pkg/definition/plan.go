@@ -0,0 +1,632 @@
+package definition
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/undo"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Action is the type of modification a Change makes to a resource.
+type Action string
+
+// Actions a Change can take.
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Kind labels for the resources which aren't described by a Kind value.
+const (
+	DictionaryItemKind  = "dictionary item"
+	ACLEntryKind        = "acl entry"
+	DirectorBackendKind = "director backend"
+	PoolServerKind      = "pool server"
+)
+
+// operation is a single API call (or sequence of calls) made against a service
+// version.
+type operation func(c api.Interface, serviceID string, version int) error
+
+// Change is a single modification to a resource.
+type Change struct {
+	Action Action `json:"action"`
+	// Kind is the resource type, e.g. "backend", "logging s3" or
+	// "dictionary item".
+	Kind string `json:"kind"`
+	// Parent is the name of the dictionary, ACL, director or pool holding an
+	// item, entry, backend or server.
+	Parent string `json:"parent,omitempty"`
+	// Name identifies the resource within its kind and parent.
+	Name string `json:"name"`
+	// Fields lists the fields which differ when updating a resource.
+	Fields []string `json:"fields,omitempty"`
+	// Unversioned reports whether the change takes effect immediately rather
+	// than when the service version is activated, which is the case for items
+	// and entries of dictionaries and ACLs, and servers of pools, that already
+	// exist.
+	Unversioned bool `json:"unversioned,omitempty"`
+
+	// From and To hold the current and desired state of the resource. From is
+	// nil when creating and To is nil when deleting. They may contain secrets
+	// and so are never serialised.
+	From Resource `json:"-"`
+	To   Resource `json:"-"`
+
+	apply  operation
+	revert operation
+}
+
+// Symbol returns the character used to display the change's action.
+func (ch Change) Symbol() string {
+	return map[Action]string{Create: "+", Update: "~", Delete: "-"}[ch.Action]
+}
+
+// Subject names the changed resource, along with its parent if it has one.
+func (ch Change) Subject() string {
+	if ch.Parent != "" {
+		return fmt.Sprintf("\"%s\" in \"%s\"", ch.Name, ch.Parent)
+	}
+	return fmt.Sprintf("\"%s\"", ch.Name)
+}
+
+// String renders the change on a single line, e.g. `~ backend "origin"`.
+func (ch Change) String() string {
+	return fmt.Sprintf("%s %s %s", ch.Symbol(), ch.Kind, ch.Subject())
+}
+
+// Plan is the set of changes required to bring a service version in line with
+// a desired definition. Changes are grouped by resource type.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+// NewPlan compares the current state of a service version with the desired
+// state and returns the changes needed to reconcile them.
+func NewPlan(current, desired *Service) *Plan {
+	p := &Plan{}
+	p.diff(DomainKind, DomainKind.Name, current.Domains, desired.Domains)
+	p.diff(ConditionKind, ConditionKind.Name, current.Conditions, desired.Conditions)
+	p.diff(HealthcheckKind, HealthcheckKind.Name, current.Healthchecks, desired.Healthchecks)
+	p.diff(BackendKind, BackendKind.Name, current.Backends, desired.Backends)
+	p.diff(DirectorKind, DirectorKind.Name, current.Directors, desired.Directors)
+	p.diffDirectorBackends(current.DirectorBackends, desired.DirectorBackends)
+	p.diffPools(current, desired)
+	p.diffDictionaries(current.Dictionaries, desired.Dictionaries)
+	p.diffACLs(current.ACLs, desired.ACLs)
+	p.diff(VCLKind, VCLKind.Name, current.VCLs, desired.VCLs)
+	p.diff(SnippetKind, SnippetKind.Name, current.Snippets, desired.Snippets)
+	p.diff(HeaderKind, HeaderKind.Name, current.Headers, desired.Headers)
+	p.diff(CacheSettingKind, CacheSettingKind.Name, current.CacheSettings, desired.CacheSettings)
+	p.diff(RequestSettingKind, RequestSettingKind.Name, current.RequestSettings, desired.RequestSettings)
+	p.diff(ResponseObjectKind, ResponseObjectKind.Name, current.ResponseObjects, desired.ResponseObjects)
+	p.diff(GzipKind, GzipKind.Name, current.Gzips, desired.Gzips)
+	for _, k := range LoggingKinds {
+		p.diff(k, "logging "+k.Name, current.Logging[k.Name], desired.Logging[k.Name])
+	}
+	return p
+}
+
+// Empty reports whether the plan has no changes.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(a Action) int {
+	var n int
+	for _, ch := range p.Changes {
+		if ch.Action == a {
+			n++
+		}
+	}
+	return n
+}
+
+// Validate returns an error if a resource would be created from a definition
+// whose secrets have been redacted.
+func (p *Plan) Validate() error {
+	for _, ch := range p.Changes {
+		if ch.Action != Create {
+			continue
+		}
+		for _, k := range sortedKeys(ch.To) {
+			if ch.To[k] == Redacted {
+				return fmt.Errorf("cannot create %s \"%s\": the value of '%s' is redacted, replace it with the real value", ch.Kind, ch.Name, k)
+			}
+		}
+	}
+	return nil
+}
+
+// Apply makes the changes against an editable service version. Creates and
+// updates are made first, in dependency order, followed by deletes in reverse
+// order. The inverse of every successful change is pushed onto stack so that
+// the caller can roll back if a later change fails.
+func (p *Plan) Apply(c api.Interface, serviceID string, version int, progress text.Progress, stack undo.Stacker) error {
+	var changes, deletes []Change
+	for _, ch := range p.Changes {
+		if ch.Action == Delete {
+			deletes = append([]Change{ch}, deletes...)
+		} else {
+			changes = append(changes, ch)
+		}
+	}
+
+	for _, ch := range append(changes, deletes...) {
+		progress.Step(fmt.Sprintf("Applying %s...", ch))
+		if err := ch.apply(c, serviceID, version); err != nil {
+			return fmt.Errorf("error applying %s: %w", ch, err)
+		}
+		revert := ch.revert
+		stack.Push(func() error {
+			return revert(c, serviceID, version)
+		})
+	}
+	return nil
+}
+
+func (p *Plan) add(ch Change) {
+	p.Changes = append(p.Changes, ch)
+}
+
+// diff compares two sorted slices of resources identified by name.
+func (p *Plan) diff(k Kind, label string, current, desired []Resource) {
+	have := make(map[string]Resource, len(current))
+	for _, r := range current {
+		have[r.Name()] = r
+	}
+
+	for _, to := range desired {
+		name := to.Name()
+		from, ok := have[name]
+		if !ok {
+			p.add(Change{Action: Create, Kind: label, Name: name, To: to, apply: create(k, to), revert: remove(k, name)})
+			continue
+		}
+		if fields := changedFields(from, to); len(fields) > 0 {
+			p.add(Change{
+				Action: Update, Kind: label, Name: name, Fields: fields, From: from, To: to,
+				apply:  update(k, name, subset(to, fields)),
+				revert: update(k, name, subset(from, fields)),
+			})
+		}
+	}
+
+	want := make(map[string]bool, len(desired))
+	for _, r := range desired {
+		want[r.Name()] = true
+	}
+	for _, from := range current {
+		if name := from.Name(); !want[name] {
+			p.add(Change{Action: Delete, Kind: label, Name: name, From: from, apply: remove(k, name), revert: create(k, from)})
+		}
+	}
+}
+
+// diffDictionaries compares dictionaries and then the items of every desired
+// dictionary. Items of a deleted dictionary are left untouched because they
+// are shared with the other versions of the service.
+func (p *Plan) diffDictionaries(current, desired []Dictionary) {
+	p.diff(DictionaryKind, DictionaryKind.Name, dictionaryResources(current), dictionaryResources(desired))
+
+	have := make(map[string]Dictionary, len(current))
+	for _, d := range current {
+		have[d.Name] = d
+	}
+	for _, d := range desired {
+		existing, unversioned := have[d.Name]
+		dictionary := d.Name
+
+		for _, key := range sortedKeys(d.Items) {
+			to := Resource{"item_key": key, "item_value": d.Items[key]}
+			old, ok := existing.Items[key]
+			switch {
+			case !ok:
+				p.add(Change{
+					Action: Create, Kind: DictionaryItemKind, Parent: dictionary, Name: key, To: to, Unversioned: unversioned,
+					apply:  setItem(dictionary, key, d.Items[key], true),
+					revert: deleteItem(dictionary, key),
+				})
+			case old != d.Items[key] && d.Items[key] != Redacted:
+				p.add(Change{
+					Action: Update, Kind: DictionaryItemKind, Parent: dictionary, Name: key, Fields: []string{"item_value"},
+					From: Resource{"item_key": key, "item_value": old}, To: to, Unversioned: unversioned,
+					apply:  setItem(dictionary, key, d.Items[key], false),
+					revert: setItem(dictionary, key, old, false),
+				})
+			}
+		}
+		for _, key := range sortedKeys(existing.Items) {
+			if _, ok := d.Items[key]; !ok {
+				old := existing.Items[key]
+				p.add(Change{
+					Action: Delete, Kind: DictionaryItemKind, Parent: dictionary, Name: key,
+					From: Resource{"item_key": key, "item_value": old}, Unversioned: unversioned,
+					apply:  deleteItem(dictionary, key),
+					revert: setItem(dictionary, key, old, true),
+				})
+			}
+		}
+	}
+}
+
+// diffACLs compares ACLs and then the entries of every desired ACL. Entries of
+// a deleted ACL are left untouched because they are shared with the other
+// versions of the service.
+func (p *Plan) diffACLs(current, desired []ACL) {
+	p.diff(ACLKind, ACLKind.Name, aclResources(current), aclResources(desired))
+
+	have := make(map[string]ACL, len(current))
+	for _, a := range current {
+		have[a.Name] = a
+	}
+	for _, a := range desired {
+		existing, unversioned := have[a.Name]
+		acl := a.Name
+
+		entries := make(map[string]Resource, len(existing.Entries))
+		for _, e := range existing.Entries {
+			entries[EntryKey(e)] = e
+		}
+		want := make(map[string]bool, len(a.Entries))
+
+		for _, to := range a.Entries {
+			key := EntryKey(to)
+			want[key] = true
+			from, ok := entries[key]
+			if !ok {
+				p.add(Change{
+					Action: Create, Kind: ACLEntryKind, Parent: acl, Name: key, To: to, Unversioned: unversioned,
+					apply:  createEntry(acl, to),
+					revert: deleteEntry(acl, key),
+				})
+				continue
+			}
+			if fields := changedFields(from, to); len(fields) > 0 {
+				p.add(Change{
+					Action: Update, Kind: ACLEntryKind, Parent: acl, Name: key, Fields: fields, From: from, To: to, Unversioned: unversioned,
+					apply:  updateEntry(acl, key, subset(to, fields)),
+					revert: updateEntry(acl, key, subset(from, fields)),
+				})
+			}
+		}
+		for _, from := range existing.Entries {
+			if key := EntryKey(from); !want[key] {
+				p.add(Change{
+					Action: Delete, Kind: ACLEntryKind, Parent: acl, Name: key, From: from, Unversioned: unversioned,
+					apply:  deleteEntry(acl, key),
+					revert: createEntry(acl, from),
+				})
+			}
+		}
+	}
+}
+
+// diffDirectorBackends compares the backends of every director. Directors and
+// backends are created before and deleted after the mappings between them, as
+// deletes are made in reverse order.
+func (p *Plan) diffDirectorBackends(current, desired map[string][]string) {
+	for _, director := range sortedKeys(desired) {
+		have := make(map[string]bool, len(current[director]))
+		for _, backend := range current[director] {
+			have[backend] = true
+		}
+		for _, backend := range desired[director] {
+			if !have[backend] {
+				p.add(Change{
+					Action: Create, Kind: DirectorBackendKind, Parent: director, Name: backend,
+					apply:  createDirectorBackend(director, backend),
+					revert: deleteDirectorBackend(director, backend),
+				})
+			}
+		}
+	}
+	for _, director := range sortedKeys(current) {
+		want := make(map[string]bool, len(desired[director]))
+		for _, backend := range desired[director] {
+			want[backend] = true
+		}
+		for _, backend := range current[director] {
+			if !want[backend] {
+				p.add(Change{
+					Action: Delete, Kind: DirectorBackendKind, Parent: director, Name: backend,
+					apply:  deleteDirectorBackend(director, backend),
+					revert: createDirectorBackend(director, backend),
+				})
+			}
+		}
+	}
+}
+
+// diffPools compares pools and then the servers of every desired pool.
+// Servers of a deleted pool are left untouched because they are shared with
+// the other versions of the service.
+func (p *Plan) diffPools(current, desired *Service) {
+	p.diff(PoolKind, PoolKind.Name, current.Pools, desired.Pools)
+
+	have := make(map[string]bool, len(current.Pools))
+	for _, r := range current.Pools {
+		have[r.Name()] = true
+	}
+	for _, r := range desired.Pools {
+		pool := r.Name()
+		unversioned := have[pool]
+
+		servers := make(map[string]Resource, len(current.PoolServers[pool]))
+		for _, s := range current.PoolServers[pool] {
+			servers[ServerKey(s)] = s
+		}
+		want := make(map[string]bool, len(desired.PoolServers[pool]))
+
+		for _, to := range desired.PoolServers[pool] {
+			key := ServerKey(to)
+			want[key] = true
+			from, ok := servers[key]
+			if !ok {
+				p.add(Change{
+					Action: Create, Kind: PoolServerKind, Parent: pool, Name: key, To: to, Unversioned: unversioned,
+					apply:  createServer(pool, to),
+					revert: deleteServer(pool, key),
+				})
+				continue
+			}
+			if fields := changedFields(from, to); len(fields) > 0 {
+				p.add(Change{
+					Action: Update, Kind: PoolServerKind, Parent: pool, Name: key, Fields: fields, From: from, To: to, Unversioned: unversioned,
+					apply:  updateServer(pool, key, subset(to, fields)),
+					revert: updateServer(pool, key, subset(from, fields)),
+				})
+			}
+		}
+		for _, from := range current.PoolServers[pool] {
+			if key := ServerKey(from); !want[key] {
+				p.add(Change{
+					Action: Delete, Kind: PoolServerKind, Parent: pool, Name: key, From: from, Unversioned: unversioned,
+					apply:  deleteServer(pool, key),
+					revert: createServer(pool, from),
+				})
+			}
+		}
+	}
+}
+
+func create(k Kind, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		return k.Create(c, serviceID, version, r)
+	}
+}
+
+func update(k Kind, name string, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		return k.Update(c, serviceID, version, name, r)
+	}
+}
+
+func remove(k Kind, name string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		return k.Delete(c, serviceID, version, name)
+	}
+}
+
+func setItem(dictionary, key, value string, create bool) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		d, err := c.GetDictionary(&fastly.GetDictionaryInput{ServiceID: serviceID, ServiceVersion: version, Name: dictionary})
+		if err != nil {
+			return err
+		}
+		if create {
+			_, err = c.CreateDictionaryItem(&fastly.CreateDictionaryItemInput{ServiceID: serviceID, DictionaryID: d.ID, ItemKey: key, ItemValue: value})
+		} else {
+			_, err = c.UpdateDictionaryItem(&fastly.UpdateDictionaryItemInput{ServiceID: serviceID, DictionaryID: d.ID, ItemKey: key, ItemValue: value})
+		}
+		return err
+	}
+}
+
+func deleteItem(dictionary, key string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		d, err := c.GetDictionary(&fastly.GetDictionaryInput{ServiceID: serviceID, ServiceVersion: version, Name: dictionary})
+		if err != nil {
+			return err
+		}
+		return c.DeleteDictionaryItem(&fastly.DeleteDictionaryItemInput{ServiceID: serviceID, DictionaryID: d.ID, ItemKey: key})
+	}
+}
+
+func createEntry(acl string, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		a, err := c.GetACL(&fastly.GetACLInput{ServiceID: serviceID, ServiceVersion: version, Name: acl})
+		if err != nil {
+			return err
+		}
+		i := &fastly.CreateACLEntryInput{ServiceID: serviceID, ACLID: a.ID}
+		if err := r.populate(i, false); err != nil {
+			return err
+		}
+		_, err = c.CreateACLEntry(i)
+		return err
+	}
+}
+
+func updateEntry(acl, key string, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		aclID, id, err := entryID(c, serviceID, version, acl, key)
+		if err != nil {
+			return err
+		}
+		i := &fastly.UpdateACLEntryInput{ServiceID: serviceID, ACLID: aclID, ID: id}
+		if err := r.populate(i, true); err != nil {
+			return err
+		}
+		_, err = c.UpdateACLEntry(i)
+		return err
+	}
+}
+
+func deleteEntry(acl, key string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		aclID, id, err := entryID(c, serviceID, version, acl, key)
+		if err != nil {
+			return err
+		}
+		return c.DeleteACLEntry(&fastly.DeleteACLEntryInput{ServiceID: serviceID, ACLID: aclID, ID: id})
+	}
+}
+
+func createDirectorBackend(director, backend string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		_, err := c.CreateDirectorBackend(&fastly.CreateDirectorBackendInput{ServiceID: serviceID, ServiceVersion: version, Director: director, Backend: backend})
+		return err
+	}
+}
+
+func deleteDirectorBackend(director, backend string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		return c.DeleteDirectorBackend(&fastly.DeleteDirectorBackendInput{ServiceID: serviceID, ServiceVersion: version, Director: director, Backend: backend})
+	}
+}
+
+func createServer(pool string, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		p, err := c.GetPool(&fastly.GetPoolInput{ServiceID: serviceID, ServiceVersion: version, Name: pool})
+		if err != nil {
+			return err
+		}
+		i := &fastly.CreateServerInput{ServiceID: serviceID, PoolID: p.ID}
+		if err := r.populate(i, false); err != nil {
+			return err
+		}
+		_, err = c.CreateServer(i)
+		return err
+	}
+}
+
+func updateServer(pool, key string, r Resource) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		poolID, id, err := serverID(c, serviceID, version, pool, key)
+		if err != nil {
+			return err
+		}
+		i := &fastly.UpdateServerInput{ServiceID: serviceID, PoolID: poolID, Server: id}
+		if err := r.populate(i, true); err != nil {
+			return err
+		}
+		_, err = c.UpdateServer(i)
+		return err
+	}
+}
+
+func deleteServer(pool, key string) operation {
+	return func(c api.Interface, serviceID string, version int) error {
+		poolID, id, err := serverID(c, serviceID, version, pool, key)
+		if err != nil {
+			return err
+		}
+		return c.DeleteServer(&fastly.DeleteServerInput{ServiceID: serviceID, PoolID: poolID, Server: id})
+	}
+}
+
+// serverID looks up the IDs of a named pool and one of its servers.
+func serverID(c api.Interface, serviceID string, version int, pool, key string) (poolID, id string, err error) {
+	p, err := c.GetPool(&fastly.GetPoolInput{ServiceID: serviceID, ServiceVersion: version, Name: pool})
+	if err != nil {
+		return "", "", err
+	}
+	servers, err := c.ListServers(&fastly.ListServersInput{ServiceID: serviceID, PoolID: p.ID})
+	if err != nil {
+		return "", "", err
+	}
+	for _, s := range servers {
+		if ServerKey(NewResource(s)) == key {
+			return p.ID, s.ID, nil
+		}
+	}
+	return "", "", fmt.Errorf("server %s not found in pool '%s'", key, pool)
+}
+
+// entryID looks up the IDs of a named ACL and one of its entries.
+func entryID(c api.Interface, serviceID string, version int, acl, key string) (aclID, id string, err error) {
+	a, err := c.GetACL(&fastly.GetACLInput{ServiceID: serviceID, ServiceVersion: version, Name: acl})
+	if err != nil {
+		return "", "", err
+	}
+	entries, err := c.ListACLEntries(&fastly.ListACLEntriesInput{ServiceID: serviceID, ACLID: a.ID})
+	if err != nil {
+		return "", "", err
+	}
+	for _, e := range entries {
+		if EntryKey(NewResource(e)) == key {
+			return a.ID, e.ID, nil
+		}
+	}
+	return "", "", fmt.Errorf("entry %s not found in ACL '%s'", key, acl)
+}
+
+// changedFields returns the sorted names of the fields that differ between two
+// versions of a resource. A redacted desired value matches any current value.
+func changedFields(from, to Resource) []string {
+	keys := make(map[string]bool)
+	for k := range from {
+		keys[k] = true
+	}
+	for k := range to {
+		keys[k] = true
+	}
+
+	var fields []string
+	for k := range keys {
+		if k == "name" || to[k] == Redacted {
+			continue
+		}
+		if !reflect.DeepEqual(from[k], to[k]) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// subset returns the given fields of r. Fields missing from r are included
+// with a nil value so that they are reset to their zero value.
+func subset(r Resource, fields []string) Resource {
+	s := make(Resource, len(fields))
+	for _, f := range fields {
+		s[f] = r[f]
+	}
+	return s
+}
+
+func dictionaryResources(ds []Dictionary) []Resource {
+	rs := make([]Resource, 0, len(ds))
+	for _, d := range ds {
+		r := Resource{"name": d.Name}
+		if d.WriteOnly {
+			r["write_only"] = true
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func aclResources(as []ACL) []Resource {
+	rs := make([]Resource, 0, len(as))
+	for _, a := range as {
+		rs = append(rs, Resource{"name": a.Name})
+	}
+	return rs
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -11,6 +11,14 @@ const (
 	// Endpoint is the env var we look in for the API endpoint.
 	Endpoint = "FASTLY_API_ENDPOINT"
 
+	// Profile is the env var we look in for the name of the credential profile.
+	Profile = "FASTLY_PROFILE"
+
+	// TokenPassphrase is the env var we look in for the passphrase used to
+	// decrypt an encrypted API token, e.g. when running in CI.
+	/* #nosec */
+	TokenPassphrase = "FASTLY_TOKEN_PASSPHRASE"
+
 	// ServiceID is the env var we look in for the required Service ID.
 	ServiceID = "FASTLY_SERVICE_ID"
 )
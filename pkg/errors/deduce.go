@@ -42,6 +42,13 @@ func Deduce(err error) RemediationError {
 	return RemediationError{Inner: err, Remediation: BugRemediation}
 }
 
+// IsNotFound reports whether err is, or wraps, a Fastly API response with a
+// 404 Not Found status code.
+func IsNotFound(err error) bool {
+	var httpError *fastly.HTTPError
+	return errors.As(err, &httpError) && httpError.IsNotFound()
+}
+
 // SimplifyFastlyError reduces the potentially complex and multi-line Error
 // rendering of a fastly.HTTPError to something more palatable for a CLI.
 func SimplifyFastlyError(httpError fastly.HTTPError) error {
@@ -64,6 +64,39 @@ func TestDeduce(t *testing.T) {
 	}
 }
 
+func TestIsNotFound(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input error
+		want  bool
+	}{
+		{
+			name:  "fastly.HTTPError 404",
+			input: &fastly.HTTPError{StatusCode: http.StatusNotFound},
+			want:  true,
+		},
+		{
+			name:  "wrapped fastly.HTTPError 404",
+			input: fmt.Errorf("error fetching backend: %w", &fastly.HTTPError{StatusCode: http.StatusNotFound}),
+			want:  true,
+		},
+		{
+			name:  "fastly.HTTPError 500",
+			input: &fastly.HTTPError{StatusCode: http.StatusInternalServerError},
+			want:  false,
+		},
+		{
+			name:  "plain error",
+			input: fmt.Errorf("not found"),
+			want:  false,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertBool(t, testcase.want, errors.IsNotFound(testcase.input))
+		})
+	}
+}
+
 type isTemporary struct{ error }
 
 func (isTemporary) Temporary() bool { return true }
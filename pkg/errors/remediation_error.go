@@ -96,3 +96,9 @@ var AutoCloneRemediation = strings.Join([]string{
 var IDRemediation = strings.Join([]string{
 	"Please provide one via the --id flag",
 }, " ")
+
+// ProfileRemediation suggests listing the available credential profiles.
+var ProfileRemediation = strings.Join([]string{
+	"Run `fastly profile list` to view the available profiles,",
+	"or `fastly profile create --name <name>` to create a new profile.",
+}, " ")
@@ -0,0 +1,3 @@
+// Package journal records the API calls made by the CLI that modify an
+// account, along with enough state to undo them.
+package journal
@@ -0,0 +1,146 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// FilePath is the location of the fastly CLI journal.
+var FilePath = func() string {
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, "fastly", "journal.json")
+	}
+	if dir, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(dir, ".fastly", "journal.json")
+	}
+	panic("unable to deduce user config dir or user home dir")
+}()
+
+// MaxEntries is the number of entries kept in the journal. The oldest entries
+// are discarded once it's exceeded.
+const MaxEntries = 500
+
+// FilePermissions is the file mode of the journal. It's only readable by its
+// owner, as inputs to the API can include credentials, e.g. for logging
+// endpoints.
+const FilePermissions = 0600
+
+// Entry records the API calls that modified the account during a single run
+// of the CLI.
+type Entry struct {
+	ID             int         `json:"id"`
+	Time           time.Time   `json:"time"`
+	Args           []string    `json:"args"`
+	ServiceID      string      `json:"service_id,omitempty"`
+	ServiceVersion int         `json:"service_version,omitempty"`
+	Operations     []Operation `json:"operations"`
+	UndoneAt       *time.Time  `json:"undone_at,omitempty"`
+}
+
+// Operation records a single API call. Before is the state of the resource
+// prior to the call, where it could be fetched, and is used to undo updates
+// and deletions.
+type Operation struct {
+	Method string          `json:"method"`
+	Input  json.RawMessage `json:"input,omitempty"`
+	Before json.RawMessage `json:"before,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// Succeeded reports whether the API call was successful.
+func (o Operation) Succeeded() bool {
+	return o.Error == ""
+}
+
+// Read returns the entries in the journal at path, oldest first. A journal
+// that doesn't exist yet has no entries.
+func Read(path string) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading journal: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error parsing journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Write replaces the journal at path with the entries, discarding the oldest
+// if there are more than MaxEntries.
+func Write(path string, entries []Entry) error {
+	if len(entries) > MaxEntries {
+		entries = entries[len(entries)-MaxEntries:]
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding journal: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("error creating journal directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, FilePermissions); err != nil {
+		return fmt.Errorf("error writing journal: %w", err)
+	}
+	return nil
+}
+
+// Append adds the entry to the journal at path, assigning it the next ID.
+func Append(path string, e Entry) (Entry, error) {
+	entries, err := Read(path)
+	if err != nil {
+		return e, err
+	}
+
+	e.ID = 1
+	if n := len(entries); n > 0 {
+		e.ID = entries[n-1].ID + 1
+	}
+	entries = append(entries, e)
+	return e, Write(path, entries)
+}
+
+// Find returns the entry with the given ID.
+func Find(entries []Entry, id int) (*Entry, bool) {
+	for i := range entries {
+		if entries[i].ID == id {
+			return &entries[i], true
+		}
+	}
+	return nil, false
+}
+
+// LastActive returns the time at which each version of the service was last
+// known to be active, according to the activations recorded in the journal.
+// A version replaced by an activation was active until the time of the entry.
+func LastActive(entries []Entry, serviceID string) map[int]time.Time {
+	last := make(map[int]time.Time)
+	for _, e := range entries {
+		for _, op := range e.Operations {
+			if op.Method != "ActivateVersion" || !op.Succeeded() {
+				continue
+			}
+			var in fastly.ActivateVersionInput
+			if err := json.Unmarshal(op.Input, &in); err != nil || in.ServiceID != serviceID {
+				continue
+			}
+			last[in.ServiceVersion] = e.Time
+			var before fastly.Service
+			if op.Before != nil && json.Unmarshal(op.Before, &before) == nil && before.ActiveVersion > 0 {
+				last[int(before.ActiveVersion)] = e.Time
+			}
+		}
+	}
+	return last
+}
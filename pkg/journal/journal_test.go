@@ -0,0 +1,367 @@
+package journal_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/journal"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+var errTest = errors.New("fixture error")
+
+func TestAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fastly", "journal.json")
+
+	entries, err := journal.Read(path)
+	testutil.AssertNoError(t, err)
+	if len(entries) != 0 {
+		t.Fatalf("want no entries, have %d", len(entries))
+	}
+
+	for i := 1; i <= journal.MaxEntries+2; i++ {
+		e, err := journal.Append(path, journal.Entry{Args: []string{"service", "update"}})
+		testutil.AssertNoError(t, err)
+		if e.ID != i {
+			t.Fatalf("want ID %d, have %d", i, e.ID)
+		}
+	}
+
+	entries, err = journal.Read(path)
+	testutil.AssertNoError(t, err)
+	if len(entries) != journal.MaxEntries {
+		t.Fatalf("want %d entries, have %d", journal.MaxEntries, len(entries))
+	}
+	if entries[0].ID != 3 {
+		t.Fatalf("want oldest entries discarded, have first ID %d", entries[0].ID)
+	}
+	if _, ok := journal.Find(entries, journal.MaxEntries+2); !ok {
+		t.Fatal("want latest entry found, have none")
+	}
+
+	fi, err := os.Stat(path)
+	testutil.AssertNoError(t, err)
+	if fi.Mode().Perm() != journal.FilePermissions {
+		t.Fatalf("want permissions %o, have %o", journal.FilePermissions, fi.Mode().Perm())
+	}
+}
+
+func TestLastActive(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2021, 6, d, 0, 0, 0, 0, time.UTC) }
+	entries := []journal.Entry{
+		{Time: day(1), Operations: []journal.Operation{
+			op("ActivateVersion", fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 2}, fastly.Service{ID: "123", ActiveVersion: 1}, nil),
+		}},
+		{Time: day(2), Operations: []journal.Operation{
+			op("ActivateVersion", fastly.ActivateVersionInput{ServiceID: "456", ServiceVersion: 7}, fastly.Service{ID: "456", ActiveVersion: 6}, nil),
+			{Method: "ActivateVersion", Input: json.RawMessage(`{"ServiceID": "123", "ServiceVersion": 4}`), Error: "fixture error"},
+		}},
+		{Time: day(3), Operations: []journal.Operation{
+			op("ActivateVersion", fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 3}, fastly.Service{ID: "123", ActiveVersion: 2}, nil),
+		}},
+	}
+
+	testutil.AssertEqual(t, map[int]time.Time{1: day(1), 2: day(3), 3: day(3)}, journal.LastActive(entries, "123"))
+}
+
+func TestRecorder(t *testing.T) {
+	r := journal.NewRecorder(mock.API{
+		GetBackendFn: func(i *fastly.GetBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, Port: 80}, nil
+		},
+		UpdateBackendFn: func(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, Port: *i.Port}, nil
+		},
+		CloneVersionFn: func(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+			return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion + 1}, nil
+		},
+		DeleteBackendFn: func(i *fastly.DeleteBackendInput) error {
+			return errTest
+		},
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return nil, nil
+		},
+	})
+
+	_, err := r.CloneVersion(&fastly.CloneVersionInput{ServiceID: "123", ServiceVersion: 1})
+	testutil.AssertNoError(t, err)
+	_, err = r.ListBackends(&fastly.ListBackendsInput{ServiceID: "123", ServiceVersion: 2})
+	testutil.AssertNoError(t, err)
+	_, err = r.UpdateBackend(&fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: fastly.Uint(8080)})
+	testutil.AssertNoError(t, err)
+	err = r.DeleteBackend(&fastly.DeleteBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www"})
+	testutil.AssertErrorContains(t, err, errTest.Error())
+
+	e := r.Entry([]string{"backend", "update", "--token", "secret", "--password=secret", "-v"})
+	testutil.AssertString(t, "backend update --token REDACTED --password=REDACTED -v", strings.Join(e.Args, " "))
+	testutil.AssertString(t, "123", e.ServiceID)
+	if e.ServiceVersion != 2 {
+		t.Fatalf("want version 2, have %d", e.ServiceVersion)
+	}
+
+	var methods []string
+	for _, op := range e.Operations {
+		methods = append(methods, op.Method)
+	}
+	testutil.AssertString(t, "CloneVersion UpdateBackend DeleteBackend", strings.Join(methods, " "))
+
+	update := e.Operations[1]
+	var before fastly.Backend
+	testutil.AssertNoError(t, json.Unmarshal(update.Before, &before))
+	if before.Port != 80 {
+		t.Fatalf("want port 80 recorded before the update, have %d", before.Port)
+	}
+	if !update.Succeeded() || e.Operations[2].Succeeded() {
+		t.Fatal("want only the delete recorded as failed")
+	}
+	testutil.AssertString(t, errTest.Error(), e.Operations[2].Error)
+}
+
+func TestRecorderRedactsCredentials(t *testing.T) {
+	r := journal.NewRecorder(mock.API{
+		CreateTokenFn: func(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+			return &fastly.Token{ID: "abc", Name: i.Name, AccessToken: "s3cr3t"}, nil
+		},
+		GetS3Fn: func(i *fastly.GetS3Input) (*fastly.S3, error) {
+			return &fastly.S3{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, AccessKey: "old-key", SecretKey: "old-secret"}, nil
+		},
+		UpdateS3Fn: func(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+			return &fastly.S3{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, AccessKey: *i.AccessKey, SecretKey: *i.SecretKey}, nil
+		},
+	})
+
+	_, err := r.CreateToken(&fastly.CreateTokenInput{Name: "ci", Username: "test@example.com", Password: "hunter2"})
+	testutil.AssertNoError(t, err)
+	_, err = r.UpdateS3(&fastly.UpdateS3Input{ServiceID: "123", ServiceVersion: 2, Name: "logs", AccessKey: fastly.String("new-key"), SecretKey: fastly.String("new-secret")})
+	testutil.AssertNoError(t, err)
+
+	data, err := json.Marshal(r.Entry(nil))
+	testutil.AssertNoError(t, err)
+	for _, secret := range []string{"hunter2", "s3cr3t", "old-key", "old-secret", "new-key", "new-secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("want %q redacted, have %s", secret, data)
+		}
+	}
+	testutil.AssertStringContains(t, string(data), "test@example.com")
+	testutil.AssertStringContains(t, string(data), "logs")
+}
+
+func TestRecorderRedactsLoggingCredentials(t *testing.T) {
+	r := journal.NewRecorder(mock.API{
+		CreateHTTPSFn: func(i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+			return &fastly.HTTPS{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, URL: i.URL, HeaderName: i.HeaderName, HeaderValue: i.HeaderValue}, nil
+		},
+		CreateSumologicFn: func(i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+			return &fastly.Sumologic{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, URL: i.URL}, nil
+		},
+	})
+
+	_, err := r.CreateHTTPS(&fastly.CreateHTTPSInput{ServiceID: "123", ServiceVersion: 2, Name: "web", URL: "https://example.com/logs", HeaderName: "Authorization", HeaderValue: "Bearer hunter2"})
+	testutil.AssertNoError(t, err)
+	_, err = r.CreateSumologic(&fastly.CreateSumologicInput{ServiceID: "123", ServiceVersion: 2, Name: "sumo", URL: "https://collectors.sumologic.com/receiver/v1/http/s3cr3t"})
+	testutil.AssertNoError(t, err)
+
+	data, err := json.Marshal(r.Entry(nil))
+	testutil.AssertNoError(t, err)
+	for _, secret := range []string{"hunter2", "s3cr3t"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("want %q redacted, have %s", secret, data)
+		}
+	}
+	testutil.AssertStringContains(t, string(data), "https://example.com/logs")
+	testutil.AssertStringContains(t, string(data), "Authorization")
+}
+
+func TestUndo(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		ops       []journal.Operation
+		wantCalls []string
+		wantInput interface{}
+		wantError string
+	}{
+		{
+			name: "create is undone by delete",
+			ops: []journal.Operation{
+				op("CloneVersion", fastly.CloneVersionInput{ServiceID: "123", ServiceVersion: 1}, nil, fastly.Version{ServiceID: "123", Number: 2}),
+				op("CreateBackend", fastly.CreateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www", Address: "example.com"}, nil, fastly.Backend{ServiceID: "123", ServiceVersion: 2, Name: "www"}),
+			},
+			wantCalls: []string{"DeleteBackend (ServiceID: 123, ServiceVersion: 2, Name: www)"},
+			wantInput: &fastly.DeleteBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www"},
+		},
+		{
+			name: "create identified by the result",
+			ops: []journal.Operation{
+				op("CreateService", fastly.CreateServiceInput{Name: "test"}, nil, fastly.Service{ID: "123", Name: "test"}),
+			},
+			wantCalls: []string{"DeleteService (ID: 123)"},
+			wantInput: &fastly.DeleteServiceInput{ID: "123"},
+		},
+		{
+			name: "update restores the previous values of updated fields",
+			ops: []journal.Operation{
+				op("UpdateBackend",
+					fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www", NewName: fastly.String("web"), Port: fastly.Uint(8080)},
+					fastly.Backend{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: 80, Address: "example.com"},
+					fastly.Backend{ServiceID: "123", ServiceVersion: 2, Name: "web", Port: 8080, Address: "example.com"},
+				),
+			},
+			wantCalls: []string{"UpdateBackend (ServiceID: 123, ServiceVersion: 2, Name: web)"},
+			wantInput: &fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "web", NewName: fastly.String("www"), Port: fastly.Uint(80)},
+		},
+		{
+			name: "update restores empty values",
+			ops: []journal.Operation{
+				op("UpdateVersion",
+					fastly.UpdateVersionInput{ServiceID: "123", ServiceVersion: 2, Comment: fastly.String("new")},
+					fastly.Version{ServiceID: "123", Number: 2},
+					fastly.Version{ServiceID: "123", Number: 2, Comment: "new"},
+				),
+			},
+			wantInput: &fastly.UpdateVersionInput{ServiceID: "123", ServiceVersion: 2, Comment: fastly.String("")},
+		},
+		{
+			name: "delete is undone by recreating",
+			ops: []journal.Operation{
+				op("DeleteDictionaryItem",
+					fastly.DeleteDictionaryItemInput{ServiceID: "123", DictionaryID: "456", ItemKey: "foo"},
+					fastly.DictionaryItem{ServiceID: "123", DictionaryID: "456", ItemKey: "foo", ItemValue: "bar"},
+					nil,
+				),
+			},
+			wantCalls: []string{"CreateDictionaryItem (ServiceID: 123, DictionaryID: 456)"},
+			wantInput: &fastly.CreateDictionaryItemInput{ServiceID: "123", DictionaryID: "456", ItemKey: "foo", ItemValue: "bar"},
+		},
+		{
+			name: "activation reactivates the previous version",
+			ops: []journal.Operation{
+				op("ActivateVersion", fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 3}, fastly.Service{ID: "123", ActiveVersion: 2}, nil),
+			},
+			wantInput: &fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 2},
+		},
+		{
+			name: "first activation is undone by deactivating",
+			ops: []journal.Operation{
+				op("ActivateVersion", fastly.ActivateVersionInput{ServiceID: "123", ServiceVersion: 1}, fastly.Service{ID: "123"}, nil),
+			},
+			wantInput: &fastly.DeactivateVersionInput{ServiceID: "123", ServiceVersion: 1},
+		},
+		{
+			name: "failed operations are skipped",
+			ops: []journal.Operation{
+				{Method: "PurgeAll", Input: json.RawMessage(`{}`), Error: "fixture error"},
+			},
+		},
+		{
+			name: "purge",
+			ops: []journal.Operation{
+				op("PurgeAll", fastly.PurgeAllInput{ServiceID: "123"}, nil, nil),
+			},
+			wantError: "PurgeAll can't be undone",
+		},
+		{
+			name: "update without previous state",
+			ops: []journal.Operation{
+				op("UpdateBackend", fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www", Port: fastly.Uint(8080)}, nil, nil),
+			},
+			wantError: "its previous state wasn't recorded",
+		},
+		{
+			name: "deleted dictionary",
+			ops: []journal.Operation{
+				op("DeleteDictionary", fastly.DeleteDictionaryInput{ServiceID: "123", ServiceVersion: 2, Name: "d"}, fastly.Dictionary{Name: "d"}, nil),
+			},
+			wantError: "its items would be lost",
+		},
+		{
+			name: "updated credentials",
+			ops: []journal.Operation{
+				op("UpdateS3",
+					fastly.UpdateS3Input{ServiceID: "123", ServiceVersion: 2, Name: "logs", SecretKey: fastly.String(api.Redacted)},
+					fastly.S3{ServiceID: "123", ServiceVersion: 2, Name: "logs", SecretKey: api.Redacted},
+					nil,
+				),
+			},
+			wantError: "the previous value of SecretKey is a credential, which isn't recorded",
+		},
+		{
+			name: "deleted resource with credentials",
+			ops: []journal.Operation{
+				op("DeleteS3",
+					fastly.DeleteS3Input{ServiceID: "123", ServiceVersion: 2, Name: "logs"},
+					fastly.S3{ServiceID: "123", ServiceVersion: 2, Name: "logs", BucketName: "b", SecretKey: api.Redacted},
+					nil,
+				),
+			},
+			wantError: "its credentials aren't recorded",
+		},
+		{
+			name: "created resource that can't be identified",
+			ops: []journal.Operation{
+				op("CreateToken", fastly.CreateTokenInput{Name: "t"}, nil, fastly.Token{ID: "abc"}),
+			},
+			wantError: "the created resource can't be identified",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			calls, err := journal.Undo(journal.Entry{Operations: testcase.ops})
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if err != nil {
+				return
+			}
+
+			if testcase.wantInput == nil {
+				if len(calls) != 0 {
+					t.Fatalf("want no calls, have %v", calls)
+				}
+				return
+			}
+			if len(calls) != 1 {
+				t.Fatalf("want 1 call, have %v", calls)
+			}
+			testutil.AssertEqual(t, testcase.wantInput, calls[0].Input)
+			for i, want := range testcase.wantCalls {
+				testutil.AssertString(t, want, calls[i].String())
+			}
+		})
+	}
+}
+
+func TestUndoInvoke(t *testing.T) {
+	var deleted *fastly.DeleteBackendInput
+	client := mock.API{
+		DeleteBackendFn: func(i *fastly.DeleteBackendInput) error {
+			deleted = i
+			return nil
+		},
+	}
+
+	calls, err := journal.Undo(journal.Entry{Operations: []journal.Operation{
+		op("CreateBackend", fastly.CreateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www"}, nil, nil),
+	}})
+	testutil.AssertNoError(t, err)
+	testutil.AssertNoError(t, calls[0].Invoke(client))
+	testutil.AssertEqual(t, &fastly.DeleteBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "www"}, deleted)
+}
+
+// op returns a successful operation, encoding its input, previous state and
+// result as the recorder does.
+func op(method string, input, before, result interface{}) journal.Operation {
+	o := journal.Operation{Method: method}
+	o.Input, _ = json.Marshal(input)
+	if before != nil {
+		o.Before, _ = json.Marshal(before)
+	}
+	if result != nil {
+		o.Result, _ = json.Marshal(result)
+	}
+	return o
+}
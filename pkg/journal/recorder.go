@@ -0,0 +1,1485 @@
+package journal
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Recorder decorates an Interface so that every call which modifies an
+// account is recorded, along with the state of the resource beforehand where
+// the API allows it to be fetched. Read calls pass through untouched.
+//
+// Credentials, such as passwords, tokens and the keys of logging endpoints,
+// are redacted before they're recorded (see api.Redact).
+type Recorder struct {
+	api.Interface
+
+	mu             sync.Mutex
+	operations     []Operation
+	serviceID      string
+	serviceVersion int
+}
+
+// NewRecorder returns a Recorder decorating client.
+func NewRecorder(client api.Interface) *Recorder {
+	return &Recorder{Interface: client}
+}
+
+// Operations returns the calls recorded so far.
+func (r *Recorder) Operations() []Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Operation(nil), r.operations...)
+}
+
+// Entry returns a journal entry for the calls recorded so far, made by
+// running the CLI with args. Credentials passed as flags are redacted.
+func (r *Recorder) Entry(args []string) Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Entry{
+		Time:           time.Now().UTC(),
+		Args:           redact(args),
+		ServiceID:      r.serviceID,
+		ServiceVersion: r.serviceVersion,
+		Operations:     append([]Operation(nil), r.operations...),
+	}
+}
+
+// before fetches the current state of the resource that method is about to
+// modify, if it can be undone and the API has a way of fetching it. Failures
+// are ignored, as they only mean the call can't be undone.
+func (r *Recorder) before(method string, input interface{}) json.RawMessage {
+	getter, ok := beforeMethod(method)
+	if !ok {
+		return nil
+	}
+	m := reflect.ValueOf(&r.Interface).Elem().MethodByName(getter)
+	if !m.IsValid() {
+		return nil
+	}
+	in := reflect.New(m.Type().In(0).Elem())
+	copyFields(in, reflect.ValueOf(input), allFields)
+	if sid := reflect.Indirect(reflect.ValueOf(input)).FieldByName("ServiceID"); getter == "GetService" && sid.IsValid() {
+		// Service inputs identify the service by ServiceID, except this one.
+		in.Elem().FieldByName("ID").Set(sid)
+	}
+	out := m.Call([]reflect.Value{in})
+	if !out[1].IsNil() {
+		return nil
+	}
+	return marshal(api.Redact(out[0].Interface()))
+}
+
+// record appends the call to the recorded operations.
+func (r *Recorder) record(method string, input interface{}, before json.RawMessage, result interface{}, err error) {
+	op := Operation{
+		Method: method,
+		Input:  marshal(api.Redact(input)),
+		Before: before,
+		Result: marshal(api.Redact(result)),
+	}
+	if err != nil {
+		op.Error = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations = append(r.operations, op)
+
+	// The entry is associated with the first service modified, and the last
+	// version of it, which is the one a command using --autoclone changed.
+	if v := reflect.Indirect(reflect.ValueOf(input)); v.Kind() == reflect.Struct {
+		if id := v.FieldByName("ServiceID"); id.IsValid() && id.Kind() == reflect.String && id.String() != "" {
+			if r.serviceID == "" {
+				r.serviceID = id.String()
+			}
+			if id.String() == r.serviceID {
+				if sv := v.FieldByName("ServiceVersion"); sv.IsValid() && sv.Kind() == reflect.Int && sv.Int() != 0 {
+					r.serviceVersion = int(sv.Int())
+				}
+			}
+		}
+	}
+}
+
+// marshal encodes v, returning nil for nil values so they're omitted from the
+// journal.
+func marshal(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Map) && rv.IsNil() {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// sensitiveFlags are the flags whose values are redacted from the journal.
+var sensitiveFlags = map[string]bool{
+	"--token":    true,
+	"-t":         true,
+	"--password": true,
+	"--otp":      true,
+}
+
+// redact replaces the values of sensitive flags in args.
+func redact(args []string) []string {
+	result := make([]string, len(args))
+	for i, arg := range args {
+		result[i] = arg
+		if i > 0 && sensitiveFlags[args[i-1]] {
+			result[i] = "REDACTED"
+			continue
+		}
+		if j := strings.IndexByte(arg, '='); j > 0 && sensitiveFlags[arg[:j]] {
+			result[i] = arg[:j+1] + "REDACTED"
+		}
+	}
+	return result
+}
+
+// CreateService implements api.Interface.
+func (r *Recorder) CreateService(i *fastly.CreateServiceInput) (*fastly.Service, error) {
+	before := r.before("CreateService", i)
+	result, err := r.Interface.CreateService(i)
+	r.record("CreateService", i, before, result, err)
+	return result, err
+}
+
+// UpdateService implements api.Interface.
+func (r *Recorder) UpdateService(i *fastly.UpdateServiceInput) (*fastly.Service, error) {
+	before := r.before("UpdateService", i)
+	result, err := r.Interface.UpdateService(i)
+	r.record("UpdateService", i, before, result, err)
+	return result, err
+}
+
+// DeleteService implements api.Interface.
+func (r *Recorder) DeleteService(i *fastly.DeleteServiceInput) error {
+	before := r.before("DeleteService", i)
+	err := r.Interface.DeleteService(i)
+	r.record("DeleteService", i, before, nil, err)
+	return err
+}
+
+// CloneVersion implements api.Interface.
+func (r *Recorder) CloneVersion(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+	before := r.before("CloneVersion", i)
+	result, err := r.Interface.CloneVersion(i)
+	r.record("CloneVersion", i, before, result, err)
+	return result, err
+}
+
+// UpdateVersion implements api.Interface.
+func (r *Recorder) UpdateVersion(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+	before := r.before("UpdateVersion", i)
+	result, err := r.Interface.UpdateVersion(i)
+	r.record("UpdateVersion", i, before, result, err)
+	return result, err
+}
+
+// ActivateVersion implements api.Interface.
+func (r *Recorder) ActivateVersion(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	before := r.before("ActivateVersion", i)
+	result, err := r.Interface.ActivateVersion(i)
+	r.record("ActivateVersion", i, before, result, err)
+	return result, err
+}
+
+// DeactivateVersion implements api.Interface.
+func (r *Recorder) DeactivateVersion(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+	before := r.before("DeactivateVersion", i)
+	result, err := r.Interface.DeactivateVersion(i)
+	r.record("DeactivateVersion", i, before, result, err)
+	return result, err
+}
+
+// LockVersion implements api.Interface.
+func (r *Recorder) LockVersion(i *fastly.LockVersionInput) (*fastly.Version, error) {
+	before := r.before("LockVersion", i)
+	result, err := r.Interface.LockVersion(i)
+	r.record("LockVersion", i, before, result, err)
+	return result, err
+}
+
+// CreateDomain implements api.Interface.
+func (r *Recorder) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	before := r.before("CreateDomain", i)
+	result, err := r.Interface.CreateDomain(i)
+	r.record("CreateDomain", i, before, result, err)
+	return result, err
+}
+
+// UpdateDomain implements api.Interface.
+func (r *Recorder) UpdateDomain(i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+	before := r.before("UpdateDomain", i)
+	result, err := r.Interface.UpdateDomain(i)
+	r.record("UpdateDomain", i, before, result, err)
+	return result, err
+}
+
+// DeleteDomain implements api.Interface.
+func (r *Recorder) DeleteDomain(i *fastly.DeleteDomainInput) error {
+	before := r.before("DeleteDomain", i)
+	err := r.Interface.DeleteDomain(i)
+	r.record("DeleteDomain", i, before, nil, err)
+	return err
+}
+
+// CreateBackend implements api.Interface.
+func (r *Recorder) CreateBackend(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	before := r.before("CreateBackend", i)
+	result, err := r.Interface.CreateBackend(i)
+	r.record("CreateBackend", i, before, result, err)
+	return result, err
+}
+
+// UpdateBackend implements api.Interface.
+func (r *Recorder) UpdateBackend(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	before := r.before("UpdateBackend", i)
+	result, err := r.Interface.UpdateBackend(i)
+	r.record("UpdateBackend", i, before, result, err)
+	return result, err
+}
+
+// DeleteBackend implements api.Interface.
+func (r *Recorder) DeleteBackend(i *fastly.DeleteBackendInput) error {
+	before := r.before("DeleteBackend", i)
+	err := r.Interface.DeleteBackend(i)
+	r.record("DeleteBackend", i, before, nil, err)
+	return err
+}
+
+// CreateHealthCheck implements api.Interface.
+func (r *Recorder) CreateHealthCheck(i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	before := r.before("CreateHealthCheck", i)
+	result, err := r.Interface.CreateHealthCheck(i)
+	r.record("CreateHealthCheck", i, before, result, err)
+	return result, err
+}
+
+// UpdateHealthCheck implements api.Interface.
+func (r *Recorder) UpdateHealthCheck(i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error) {
+	before := r.before("UpdateHealthCheck", i)
+	result, err := r.Interface.UpdateHealthCheck(i)
+	r.record("UpdateHealthCheck", i, before, result, err)
+	return result, err
+}
+
+// DeleteHealthCheck implements api.Interface.
+func (r *Recorder) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
+	before := r.before("DeleteHealthCheck", i)
+	err := r.Interface.DeleteHealthCheck(i)
+	r.record("DeleteHealthCheck", i, before, nil, err)
+	return err
+}
+
+// CreateCondition implements api.Interface.
+func (r *Recorder) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	before := r.before("CreateCondition", i)
+	result, err := r.Interface.CreateCondition(i)
+	r.record("CreateCondition", i, before, result, err)
+	return result, err
+}
+
+// UpdateCondition implements api.Interface.
+func (r *Recorder) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	before := r.before("UpdateCondition", i)
+	result, err := r.Interface.UpdateCondition(i)
+	r.record("UpdateCondition", i, before, result, err)
+	return result, err
+}
+
+// DeleteCondition implements api.Interface.
+func (r *Recorder) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	before := r.before("DeleteCondition", i)
+	err := r.Interface.DeleteCondition(i)
+	r.record("DeleteCondition", i, before, nil, err)
+	return err
+}
+
+// CreateHeader implements api.Interface.
+func (r *Recorder) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	before := r.before("CreateHeader", i)
+	result, err := r.Interface.CreateHeader(i)
+	r.record("CreateHeader", i, before, result, err)
+	return result, err
+}
+
+// UpdateHeader implements api.Interface.
+func (r *Recorder) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	before := r.before("UpdateHeader", i)
+	result, err := r.Interface.UpdateHeader(i)
+	r.record("UpdateHeader", i, before, result, err)
+	return result, err
+}
+
+// DeleteHeader implements api.Interface.
+func (r *Recorder) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	before := r.before("DeleteHeader", i)
+	err := r.Interface.DeleteHeader(i)
+	r.record("DeleteHeader", i, before, nil, err)
+	return err
+}
+
+// CreateCacheSetting implements api.Interface.
+func (r *Recorder) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	before := r.before("CreateCacheSetting", i)
+	result, err := r.Interface.CreateCacheSetting(i)
+	r.record("CreateCacheSetting", i, before, result, err)
+	return result, err
+}
+
+// UpdateCacheSetting implements api.Interface.
+func (r *Recorder) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	before := r.before("UpdateCacheSetting", i)
+	result, err := r.Interface.UpdateCacheSetting(i)
+	r.record("UpdateCacheSetting", i, before, result, err)
+	return result, err
+}
+
+// DeleteCacheSetting implements api.Interface.
+func (r *Recorder) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	before := r.before("DeleteCacheSetting", i)
+	err := r.Interface.DeleteCacheSetting(i)
+	r.record("DeleteCacheSetting", i, before, nil, err)
+	return err
+}
+
+// CreateRequestSetting implements api.Interface.
+func (r *Recorder) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	before := r.before("CreateRequestSetting", i)
+	result, err := r.Interface.CreateRequestSetting(i)
+	r.record("CreateRequestSetting", i, before, result, err)
+	return result, err
+}
+
+// UpdateRequestSetting implements api.Interface.
+func (r *Recorder) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	before := r.before("UpdateRequestSetting", i)
+	result, err := r.Interface.UpdateRequestSetting(i)
+	r.record("UpdateRequestSetting", i, before, result, err)
+	return result, err
+}
+
+// DeleteRequestSetting implements api.Interface.
+func (r *Recorder) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	before := r.before("DeleteRequestSetting", i)
+	err := r.Interface.DeleteRequestSetting(i)
+	r.record("DeleteRequestSetting", i, before, nil, err)
+	return err
+}
+
+// CreateResponseObject implements api.Interface.
+func (r *Recorder) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	before := r.before("CreateResponseObject", i)
+	result, err := r.Interface.CreateResponseObject(i)
+	r.record("CreateResponseObject", i, before, result, err)
+	return result, err
+}
+
+// UpdateResponseObject implements api.Interface.
+func (r *Recorder) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	before := r.before("UpdateResponseObject", i)
+	result, err := r.Interface.UpdateResponseObject(i)
+	r.record("UpdateResponseObject", i, before, result, err)
+	return result, err
+}
+
+// DeleteResponseObject implements api.Interface.
+func (r *Recorder) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	before := r.before("DeleteResponseObject", i)
+	err := r.Interface.DeleteResponseObject(i)
+	r.record("DeleteResponseObject", i, before, nil, err)
+	return err
+}
+
+// CreateGzip implements api.Interface.
+func (r *Recorder) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	before := r.before("CreateGzip", i)
+	result, err := r.Interface.CreateGzip(i)
+	r.record("CreateGzip", i, before, result, err)
+	return result, err
+}
+
+// UpdateGzip implements api.Interface.
+func (r *Recorder) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	before := r.before("UpdateGzip", i)
+	result, err := r.Interface.UpdateGzip(i)
+	r.record("UpdateGzip", i, before, result, err)
+	return result, err
+}
+
+// DeleteGzip implements api.Interface.
+func (r *Recorder) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	before := r.before("DeleteGzip", i)
+	err := r.Interface.DeleteGzip(i)
+	r.record("DeleteGzip", i, before, nil, err)
+	return err
+}
+
+// CreateDirector implements api.Interface.
+func (r *Recorder) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	before := r.before("CreateDirector", i)
+	result, err := r.Interface.CreateDirector(i)
+	r.record("CreateDirector", i, before, result, err)
+	return result, err
+}
+
+// UpdateDirector implements api.Interface.
+func (r *Recorder) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	before := r.before("UpdateDirector", i)
+	result, err := r.Interface.UpdateDirector(i)
+	r.record("UpdateDirector", i, before, result, err)
+	return result, err
+}
+
+// DeleteDirector implements api.Interface.
+func (r *Recorder) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	before := r.before("DeleteDirector", i)
+	err := r.Interface.DeleteDirector(i)
+	r.record("DeleteDirector", i, before, nil, err)
+	return err
+}
+
+// CreateDirectorBackend implements api.Interface.
+func (r *Recorder) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	before := r.before("CreateDirectorBackend", i)
+	result, err := r.Interface.CreateDirectorBackend(i)
+	r.record("CreateDirectorBackend", i, before, result, err)
+	return result, err
+}
+
+// DeleteDirectorBackend implements api.Interface.
+func (r *Recorder) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	before := r.before("DeleteDirectorBackend", i)
+	err := r.Interface.DeleteDirectorBackend(i)
+	r.record("DeleteDirectorBackend", i, before, nil, err)
+	return err
+}
+
+// CreatePool implements api.Interface.
+func (r *Recorder) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	before := r.before("CreatePool", i)
+	result, err := r.Interface.CreatePool(i)
+	r.record("CreatePool", i, before, result, err)
+	return result, err
+}
+
+// UpdatePool implements api.Interface.
+func (r *Recorder) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	before := r.before("UpdatePool", i)
+	result, err := r.Interface.UpdatePool(i)
+	r.record("UpdatePool", i, before, result, err)
+	return result, err
+}
+
+// DeletePool implements api.Interface.
+func (r *Recorder) DeletePool(i *fastly.DeletePoolInput) error {
+	before := r.before("DeletePool", i)
+	err := r.Interface.DeletePool(i)
+	r.record("DeletePool", i, before, nil, err)
+	return err
+}
+
+// CreateServer implements api.Interface.
+func (r *Recorder) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	before := r.before("CreateServer", i)
+	result, err := r.Interface.CreateServer(i)
+	r.record("CreateServer", i, before, result, err)
+	return result, err
+}
+
+// UpdateServer implements api.Interface.
+func (r *Recorder) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	before := r.before("UpdateServer", i)
+	result, err := r.Interface.UpdateServer(i)
+	r.record("UpdateServer", i, before, result, err)
+	return result, err
+}
+
+// DeleteServer implements api.Interface.
+func (r *Recorder) DeleteServer(i *fastly.DeleteServerInput) error {
+	before := r.before("DeleteServer", i)
+	err := r.Interface.DeleteServer(i)
+	r.record("DeleteServer", i, before, nil, err)
+	return err
+}
+
+// CreateCustomTLSCertificate implements api.Interface.
+func (r *Recorder) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	before := r.before("CreateCustomTLSCertificate", i)
+	result, err := r.Interface.CreateCustomTLSCertificate(i)
+	r.record("CreateCustomTLSCertificate", i, before, result, err)
+	return result, err
+}
+
+// UpdateCustomTLSCertificate implements api.Interface.
+func (r *Recorder) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	before := r.before("UpdateCustomTLSCertificate", i)
+	result, err := r.Interface.UpdateCustomTLSCertificate(i)
+	r.record("UpdateCustomTLSCertificate", i, before, result, err)
+	return result, err
+}
+
+// DeleteCustomTLSCertificate implements api.Interface.
+func (r *Recorder) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	before := r.before("DeleteCustomTLSCertificate", i)
+	err := r.Interface.DeleteCustomTLSCertificate(i)
+	r.record("DeleteCustomTLSCertificate", i, before, nil, err)
+	return err
+}
+
+// CreatePrivateKey implements api.Interface.
+func (r *Recorder) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	before := r.before("CreatePrivateKey", i)
+	result, err := r.Interface.CreatePrivateKey(i)
+	r.record("CreatePrivateKey", i, before, result, err)
+	return result, err
+}
+
+// DeletePrivateKey implements api.Interface.
+func (r *Recorder) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	before := r.before("DeletePrivateKey", i)
+	err := r.Interface.DeletePrivateKey(i)
+	r.record("DeletePrivateKey", i, before, nil, err)
+	return err
+}
+
+// CreateTLSActivation implements api.Interface.
+func (r *Recorder) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	before := r.before("CreateTLSActivation", i)
+	result, err := r.Interface.CreateTLSActivation(i)
+	r.record("CreateTLSActivation", i, before, result, err)
+	return result, err
+}
+
+// UpdateTLSActivation implements api.Interface.
+func (r *Recorder) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	before := r.before("UpdateTLSActivation", i)
+	result, err := r.Interface.UpdateTLSActivation(i)
+	r.record("UpdateTLSActivation", i, before, result, err)
+	return result, err
+}
+
+// DeleteTLSActivation implements api.Interface.
+func (r *Recorder) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	before := r.before("DeleteTLSActivation", i)
+	err := r.Interface.DeleteTLSActivation(i)
+	r.record("DeleteTLSActivation", i, before, nil, err)
+	return err
+}
+
+// CreateTLSSubscription implements api.Interface.
+func (r *Recorder) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	before := r.before("CreateTLSSubscription", i)
+	result, err := r.Interface.CreateTLSSubscription(i)
+	r.record("CreateTLSSubscription", i, before, result, err)
+	return result, err
+}
+
+// DeleteTLSSubscription implements api.Interface.
+func (r *Recorder) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	before := r.before("DeleteTLSSubscription", i)
+	err := r.Interface.DeleteTLSSubscription(i)
+	r.record("DeleteTLSSubscription", i, before, nil, err)
+	return err
+}
+
+// UpdatePackage implements api.Interface.
+func (r *Recorder) UpdatePackage(i *fastly.UpdatePackageInput) (*fastly.Package, error) {
+	before := r.before("UpdatePackage", i)
+	result, err := r.Interface.UpdatePackage(i)
+	r.record("UpdatePackage", i, before, result, err)
+	return result, err
+}
+
+// CreateDictionary implements api.Interface.
+func (r *Recorder) CreateDictionary(i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+	before := r.before("CreateDictionary", i)
+	result, err := r.Interface.CreateDictionary(i)
+	r.record("CreateDictionary", i, before, result, err)
+	return result, err
+}
+
+// DeleteDictionary implements api.Interface.
+func (r *Recorder) DeleteDictionary(i *fastly.DeleteDictionaryInput) error {
+	before := r.before("DeleteDictionary", i)
+	err := r.Interface.DeleteDictionary(i)
+	r.record("DeleteDictionary", i, before, nil, err)
+	return err
+}
+
+// UpdateDictionary implements api.Interface.
+func (r *Recorder) UpdateDictionary(i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error) {
+	before := r.before("UpdateDictionary", i)
+	result, err := r.Interface.UpdateDictionary(i)
+	r.record("UpdateDictionary", i, before, result, err)
+	return result, err
+}
+
+// CreateDictionaryItem implements api.Interface.
+func (r *Recorder) CreateDictionaryItem(i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	before := r.before("CreateDictionaryItem", i)
+	result, err := r.Interface.CreateDictionaryItem(i)
+	r.record("CreateDictionaryItem", i, before, result, err)
+	return result, err
+}
+
+// UpdateDictionaryItem implements api.Interface.
+func (r *Recorder) UpdateDictionaryItem(i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	before := r.before("UpdateDictionaryItem", i)
+	result, err := r.Interface.UpdateDictionaryItem(i)
+	r.record("UpdateDictionaryItem", i, before, result, err)
+	return result, err
+}
+
+// DeleteDictionaryItem implements api.Interface.
+func (r *Recorder) DeleteDictionaryItem(i *fastly.DeleteDictionaryItemInput) error {
+	before := r.before("DeleteDictionaryItem", i)
+	err := r.Interface.DeleteDictionaryItem(i)
+	r.record("DeleteDictionaryItem", i, before, nil, err)
+	return err
+}
+
+// BatchModifyDictionaryItems implements api.Interface.
+func (r *Recorder) BatchModifyDictionaryItems(i *fastly.BatchModifyDictionaryItemsInput) error {
+	before := r.before("BatchModifyDictionaryItems", i)
+	err := r.Interface.BatchModifyDictionaryItems(i)
+	r.record("BatchModifyDictionaryItems", i, before, nil, err)
+	return err
+}
+
+// CreateBigQuery implements api.Interface.
+func (r *Recorder) CreateBigQuery(i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
+	before := r.before("CreateBigQuery", i)
+	result, err := r.Interface.CreateBigQuery(i)
+	r.record("CreateBigQuery", i, before, result, err)
+	return result, err
+}
+
+// UpdateBigQuery implements api.Interface.
+func (r *Recorder) UpdateBigQuery(i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error) {
+	before := r.before("UpdateBigQuery", i)
+	result, err := r.Interface.UpdateBigQuery(i)
+	r.record("UpdateBigQuery", i, before, result, err)
+	return result, err
+}
+
+// DeleteBigQuery implements api.Interface.
+func (r *Recorder) DeleteBigQuery(i *fastly.DeleteBigQueryInput) error {
+	before := r.before("DeleteBigQuery", i)
+	err := r.Interface.DeleteBigQuery(i)
+	r.record("DeleteBigQuery", i, before, nil, err)
+	return err
+}
+
+// CreateS3 implements api.Interface.
+func (r *Recorder) CreateS3(i *fastly.CreateS3Input) (*fastly.S3, error) {
+	before := r.before("CreateS3", i)
+	result, err := r.Interface.CreateS3(i)
+	r.record("CreateS3", i, before, result, err)
+	return result, err
+}
+
+// UpdateS3 implements api.Interface.
+func (r *Recorder) UpdateS3(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	before := r.before("UpdateS3", i)
+	result, err := r.Interface.UpdateS3(i)
+	r.record("UpdateS3", i, before, result, err)
+	return result, err
+}
+
+// DeleteS3 implements api.Interface.
+func (r *Recorder) DeleteS3(i *fastly.DeleteS3Input) error {
+	before := r.before("DeleteS3", i)
+	err := r.Interface.DeleteS3(i)
+	r.record("DeleteS3", i, before, nil, err)
+	return err
+}
+
+// CreateKinesis implements api.Interface.
+func (r *Recorder) CreateKinesis(i *fastly.CreateKinesisInput) (*fastly.Kinesis, error) {
+	before := r.before("CreateKinesis", i)
+	result, err := r.Interface.CreateKinesis(i)
+	r.record("CreateKinesis", i, before, result, err)
+	return result, err
+}
+
+// UpdateKinesis implements api.Interface.
+func (r *Recorder) UpdateKinesis(i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error) {
+	before := r.before("UpdateKinesis", i)
+	result, err := r.Interface.UpdateKinesis(i)
+	r.record("UpdateKinesis", i, before, result, err)
+	return result, err
+}
+
+// DeleteKinesis implements api.Interface.
+func (r *Recorder) DeleteKinesis(i *fastly.DeleteKinesisInput) error {
+	before := r.before("DeleteKinesis", i)
+	err := r.Interface.DeleteKinesis(i)
+	r.record("DeleteKinesis", i, before, nil, err)
+	return err
+}
+
+// CreateSyslog implements api.Interface.
+func (r *Recorder) CreateSyslog(i *fastly.CreateSyslogInput) (*fastly.Syslog, error) {
+	before := r.before("CreateSyslog", i)
+	result, err := r.Interface.CreateSyslog(i)
+	r.record("CreateSyslog", i, before, result, err)
+	return result, err
+}
+
+// UpdateSyslog implements api.Interface.
+func (r *Recorder) UpdateSyslog(i *fastly.UpdateSyslogInput) (*fastly.Syslog, error) {
+	before := r.before("UpdateSyslog", i)
+	result, err := r.Interface.UpdateSyslog(i)
+	r.record("UpdateSyslog", i, before, result, err)
+	return result, err
+}
+
+// DeleteSyslog implements api.Interface.
+func (r *Recorder) DeleteSyslog(i *fastly.DeleteSyslogInput) error {
+	before := r.before("DeleteSyslog", i)
+	err := r.Interface.DeleteSyslog(i)
+	r.record("DeleteSyslog", i, before, nil, err)
+	return err
+}
+
+// CreateLogentries implements api.Interface.
+func (r *Recorder) CreateLogentries(i *fastly.CreateLogentriesInput) (*fastly.Logentries, error) {
+	before := r.before("CreateLogentries", i)
+	result, err := r.Interface.CreateLogentries(i)
+	r.record("CreateLogentries", i, before, result, err)
+	return result, err
+}
+
+// UpdateLogentries implements api.Interface.
+func (r *Recorder) UpdateLogentries(i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error) {
+	before := r.before("UpdateLogentries", i)
+	result, err := r.Interface.UpdateLogentries(i)
+	r.record("UpdateLogentries", i, before, result, err)
+	return result, err
+}
+
+// DeleteLogentries implements api.Interface.
+func (r *Recorder) DeleteLogentries(i *fastly.DeleteLogentriesInput) error {
+	before := r.before("DeleteLogentries", i)
+	err := r.Interface.DeleteLogentries(i)
+	r.record("DeleteLogentries", i, before, nil, err)
+	return err
+}
+
+// CreatePapertrail implements api.Interface.
+func (r *Recorder) CreatePapertrail(i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error) {
+	before := r.before("CreatePapertrail", i)
+	result, err := r.Interface.CreatePapertrail(i)
+	r.record("CreatePapertrail", i, before, result, err)
+	return result, err
+}
+
+// UpdatePapertrail implements api.Interface.
+func (r *Recorder) UpdatePapertrail(i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error) {
+	before := r.before("UpdatePapertrail", i)
+	result, err := r.Interface.UpdatePapertrail(i)
+	r.record("UpdatePapertrail", i, before, result, err)
+	return result, err
+}
+
+// DeletePapertrail implements api.Interface.
+func (r *Recorder) DeletePapertrail(i *fastly.DeletePapertrailInput) error {
+	before := r.before("DeletePapertrail", i)
+	err := r.Interface.DeletePapertrail(i)
+	r.record("DeletePapertrail", i, before, nil, err)
+	return err
+}
+
+// CreateSumologic implements api.Interface.
+func (r *Recorder) CreateSumologic(i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+	before := r.before("CreateSumologic", i)
+	result, err := r.Interface.CreateSumologic(i)
+	r.record("CreateSumologic", i, before, result, err)
+	return result, err
+}
+
+// UpdateSumologic implements api.Interface.
+func (r *Recorder) UpdateSumologic(i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error) {
+	before := r.before("UpdateSumologic", i)
+	result, err := r.Interface.UpdateSumologic(i)
+	r.record("UpdateSumologic", i, before, result, err)
+	return result, err
+}
+
+// DeleteSumologic implements api.Interface.
+func (r *Recorder) DeleteSumologic(i *fastly.DeleteSumologicInput) error {
+	before := r.before("DeleteSumologic", i)
+	err := r.Interface.DeleteSumologic(i)
+	r.record("DeleteSumologic", i, before, nil, err)
+	return err
+}
+
+// CreateGCS implements api.Interface.
+func (r *Recorder) CreateGCS(i *fastly.CreateGCSInput) (*fastly.GCS, error) {
+	before := r.before("CreateGCS", i)
+	result, err := r.Interface.CreateGCS(i)
+	r.record("CreateGCS", i, before, result, err)
+	return result, err
+}
+
+// UpdateGCS implements api.Interface.
+func (r *Recorder) UpdateGCS(i *fastly.UpdateGCSInput) (*fastly.GCS, error) {
+	before := r.before("UpdateGCS", i)
+	result, err := r.Interface.UpdateGCS(i)
+	r.record("UpdateGCS", i, before, result, err)
+	return result, err
+}
+
+// DeleteGCS implements api.Interface.
+func (r *Recorder) DeleteGCS(i *fastly.DeleteGCSInput) error {
+	before := r.before("DeleteGCS", i)
+	err := r.Interface.DeleteGCS(i)
+	r.record("DeleteGCS", i, before, nil, err)
+	return err
+}
+
+// CreateFTP implements api.Interface.
+func (r *Recorder) CreateFTP(i *fastly.CreateFTPInput) (*fastly.FTP, error) {
+	before := r.before("CreateFTP", i)
+	result, err := r.Interface.CreateFTP(i)
+	r.record("CreateFTP", i, before, result, err)
+	return result, err
+}
+
+// UpdateFTP implements api.Interface.
+func (r *Recorder) UpdateFTP(i *fastly.UpdateFTPInput) (*fastly.FTP, error) {
+	before := r.before("UpdateFTP", i)
+	result, err := r.Interface.UpdateFTP(i)
+	r.record("UpdateFTP", i, before, result, err)
+	return result, err
+}
+
+// DeleteFTP implements api.Interface.
+func (r *Recorder) DeleteFTP(i *fastly.DeleteFTPInput) error {
+	before := r.before("DeleteFTP", i)
+	err := r.Interface.DeleteFTP(i)
+	r.record("DeleteFTP", i, before, nil, err)
+	return err
+}
+
+// CreateSplunk implements api.Interface.
+func (r *Recorder) CreateSplunk(i *fastly.CreateSplunkInput) (*fastly.Splunk, error) {
+	before := r.before("CreateSplunk", i)
+	result, err := r.Interface.CreateSplunk(i)
+	r.record("CreateSplunk", i, before, result, err)
+	return result, err
+}
+
+// UpdateSplunk implements api.Interface.
+func (r *Recorder) UpdateSplunk(i *fastly.UpdateSplunkInput) (*fastly.Splunk, error) {
+	before := r.before("UpdateSplunk", i)
+	result, err := r.Interface.UpdateSplunk(i)
+	r.record("UpdateSplunk", i, before, result, err)
+	return result, err
+}
+
+// DeleteSplunk implements api.Interface.
+func (r *Recorder) DeleteSplunk(i *fastly.DeleteSplunkInput) error {
+	before := r.before("DeleteSplunk", i)
+	err := r.Interface.DeleteSplunk(i)
+	r.record("DeleteSplunk", i, before, nil, err)
+	return err
+}
+
+// CreateScalyr implements api.Interface.
+func (r *Recorder) CreateScalyr(i *fastly.CreateScalyrInput) (*fastly.Scalyr, error) {
+	before := r.before("CreateScalyr", i)
+	result, err := r.Interface.CreateScalyr(i)
+	r.record("CreateScalyr", i, before, result, err)
+	return result, err
+}
+
+// UpdateScalyr implements api.Interface.
+func (r *Recorder) UpdateScalyr(i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error) {
+	before := r.before("UpdateScalyr", i)
+	result, err := r.Interface.UpdateScalyr(i)
+	r.record("UpdateScalyr", i, before, result, err)
+	return result, err
+}
+
+// DeleteScalyr implements api.Interface.
+func (r *Recorder) DeleteScalyr(i *fastly.DeleteScalyrInput) error {
+	before := r.before("DeleteScalyr", i)
+	err := r.Interface.DeleteScalyr(i)
+	r.record("DeleteScalyr", i, before, nil, err)
+	return err
+}
+
+// CreateLoggly implements api.Interface.
+func (r *Recorder) CreateLoggly(i *fastly.CreateLogglyInput) (*fastly.Loggly, error) {
+	before := r.before("CreateLoggly", i)
+	result, err := r.Interface.CreateLoggly(i)
+	r.record("CreateLoggly", i, before, result, err)
+	return result, err
+}
+
+// UpdateLoggly implements api.Interface.
+func (r *Recorder) UpdateLoggly(i *fastly.UpdateLogglyInput) (*fastly.Loggly, error) {
+	before := r.before("UpdateLoggly", i)
+	result, err := r.Interface.UpdateLoggly(i)
+	r.record("UpdateLoggly", i, before, result, err)
+	return result, err
+}
+
+// DeleteLoggly implements api.Interface.
+func (r *Recorder) DeleteLoggly(i *fastly.DeleteLogglyInput) error {
+	before := r.before("DeleteLoggly", i)
+	err := r.Interface.DeleteLoggly(i)
+	r.record("DeleteLoggly", i, before, nil, err)
+	return err
+}
+
+// CreateHoneycomb implements api.Interface.
+func (r *Recorder) CreateHoneycomb(i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error) {
+	before := r.before("CreateHoneycomb", i)
+	result, err := r.Interface.CreateHoneycomb(i)
+	r.record("CreateHoneycomb", i, before, result, err)
+	return result, err
+}
+
+// UpdateHoneycomb implements api.Interface.
+func (r *Recorder) UpdateHoneycomb(i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error) {
+	before := r.before("UpdateHoneycomb", i)
+	result, err := r.Interface.UpdateHoneycomb(i)
+	r.record("UpdateHoneycomb", i, before, result, err)
+	return result, err
+}
+
+// DeleteHoneycomb implements api.Interface.
+func (r *Recorder) DeleteHoneycomb(i *fastly.DeleteHoneycombInput) error {
+	before := r.before("DeleteHoneycomb", i)
+	err := r.Interface.DeleteHoneycomb(i)
+	r.record("DeleteHoneycomb", i, before, nil, err)
+	return err
+}
+
+// CreateHeroku implements api.Interface.
+func (r *Recorder) CreateHeroku(i *fastly.CreateHerokuInput) (*fastly.Heroku, error) {
+	before := r.before("CreateHeroku", i)
+	result, err := r.Interface.CreateHeroku(i)
+	r.record("CreateHeroku", i, before, result, err)
+	return result, err
+}
+
+// UpdateHeroku implements api.Interface.
+func (r *Recorder) UpdateHeroku(i *fastly.UpdateHerokuInput) (*fastly.Heroku, error) {
+	before := r.before("UpdateHeroku", i)
+	result, err := r.Interface.UpdateHeroku(i)
+	r.record("UpdateHeroku", i, before, result, err)
+	return result, err
+}
+
+// DeleteHeroku implements api.Interface.
+func (r *Recorder) DeleteHeroku(i *fastly.DeleteHerokuInput) error {
+	before := r.before("DeleteHeroku", i)
+	err := r.Interface.DeleteHeroku(i)
+	r.record("DeleteHeroku", i, before, nil, err)
+	return err
+}
+
+// CreateSFTP implements api.Interface.
+func (r *Recorder) CreateSFTP(i *fastly.CreateSFTPInput) (*fastly.SFTP, error) {
+	before := r.before("CreateSFTP", i)
+	result, err := r.Interface.CreateSFTP(i)
+	r.record("CreateSFTP", i, before, result, err)
+	return result, err
+}
+
+// UpdateSFTP implements api.Interface.
+func (r *Recorder) UpdateSFTP(i *fastly.UpdateSFTPInput) (*fastly.SFTP, error) {
+	before := r.before("UpdateSFTP", i)
+	result, err := r.Interface.UpdateSFTP(i)
+	r.record("UpdateSFTP", i, before, result, err)
+	return result, err
+}
+
+// DeleteSFTP implements api.Interface.
+func (r *Recorder) DeleteSFTP(i *fastly.DeleteSFTPInput) error {
+	before := r.before("DeleteSFTP", i)
+	err := r.Interface.DeleteSFTP(i)
+	r.record("DeleteSFTP", i, before, nil, err)
+	return err
+}
+
+// CreateLogshuttle implements api.Interface.
+func (r *Recorder) CreateLogshuttle(i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error) {
+	before := r.before("CreateLogshuttle", i)
+	result, err := r.Interface.CreateLogshuttle(i)
+	r.record("CreateLogshuttle", i, before, result, err)
+	return result, err
+}
+
+// UpdateLogshuttle implements api.Interface.
+func (r *Recorder) UpdateLogshuttle(i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error) {
+	before := r.before("UpdateLogshuttle", i)
+	result, err := r.Interface.UpdateLogshuttle(i)
+	r.record("UpdateLogshuttle", i, before, result, err)
+	return result, err
+}
+
+// DeleteLogshuttle implements api.Interface.
+func (r *Recorder) DeleteLogshuttle(i *fastly.DeleteLogshuttleInput) error {
+	before := r.before("DeleteLogshuttle", i)
+	err := r.Interface.DeleteLogshuttle(i)
+	r.record("DeleteLogshuttle", i, before, nil, err)
+	return err
+}
+
+// CreateCloudfiles implements api.Interface.
+func (r *Recorder) CreateCloudfiles(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	before := r.before("CreateCloudfiles", i)
+	result, err := r.Interface.CreateCloudfiles(i)
+	r.record("CreateCloudfiles", i, before, result, err)
+	return result, err
+}
+
+// UpdateCloudfiles implements api.Interface.
+func (r *Recorder) UpdateCloudfiles(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	before := r.before("UpdateCloudfiles", i)
+	result, err := r.Interface.UpdateCloudfiles(i)
+	r.record("UpdateCloudfiles", i, before, result, err)
+	return result, err
+}
+
+// DeleteCloudfiles implements api.Interface.
+func (r *Recorder) DeleteCloudfiles(i *fastly.DeleteCloudfilesInput) error {
+	before := r.before("DeleteCloudfiles", i)
+	err := r.Interface.DeleteCloudfiles(i)
+	r.record("DeleteCloudfiles", i, before, nil, err)
+	return err
+}
+
+// CreateDigitalOcean implements api.Interface.
+func (r *Recorder) CreateDigitalOcean(i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	before := r.before("CreateDigitalOcean", i)
+	result, err := r.Interface.CreateDigitalOcean(i)
+	r.record("CreateDigitalOcean", i, before, result, err)
+	return result, err
+}
+
+// UpdateDigitalOcean implements api.Interface.
+func (r *Recorder) UpdateDigitalOcean(i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	before := r.before("UpdateDigitalOcean", i)
+	result, err := r.Interface.UpdateDigitalOcean(i)
+	r.record("UpdateDigitalOcean", i, before, result, err)
+	return result, err
+}
+
+// DeleteDigitalOcean implements api.Interface.
+func (r *Recorder) DeleteDigitalOcean(i *fastly.DeleteDigitalOceanInput) error {
+	before := r.before("DeleteDigitalOcean", i)
+	err := r.Interface.DeleteDigitalOcean(i)
+	r.record("DeleteDigitalOcean", i, before, nil, err)
+	return err
+}
+
+// CreateElasticsearch implements api.Interface.
+func (r *Recorder) CreateElasticsearch(i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	before := r.before("CreateElasticsearch", i)
+	result, err := r.Interface.CreateElasticsearch(i)
+	r.record("CreateElasticsearch", i, before, result, err)
+	return result, err
+}
+
+// UpdateElasticsearch implements api.Interface.
+func (r *Recorder) UpdateElasticsearch(i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	before := r.before("UpdateElasticsearch", i)
+	result, err := r.Interface.UpdateElasticsearch(i)
+	r.record("UpdateElasticsearch", i, before, result, err)
+	return result, err
+}
+
+// DeleteElasticsearch implements api.Interface.
+func (r *Recorder) DeleteElasticsearch(i *fastly.DeleteElasticsearchInput) error {
+	before := r.before("DeleteElasticsearch", i)
+	err := r.Interface.DeleteElasticsearch(i)
+	r.record("DeleteElasticsearch", i, before, nil, err)
+	return err
+}
+
+// CreateBlobStorage implements api.Interface.
+func (r *Recorder) CreateBlobStorage(i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error) {
+	before := r.before("CreateBlobStorage", i)
+	result, err := r.Interface.CreateBlobStorage(i)
+	r.record("CreateBlobStorage", i, before, result, err)
+	return result, err
+}
+
+// UpdateBlobStorage implements api.Interface.
+func (r *Recorder) UpdateBlobStorage(i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error) {
+	before := r.before("UpdateBlobStorage", i)
+	result, err := r.Interface.UpdateBlobStorage(i)
+	r.record("UpdateBlobStorage", i, before, result, err)
+	return result, err
+}
+
+// DeleteBlobStorage implements api.Interface.
+func (r *Recorder) DeleteBlobStorage(i *fastly.DeleteBlobStorageInput) error {
+	before := r.before("DeleteBlobStorage", i)
+	err := r.Interface.DeleteBlobStorage(i)
+	r.record("DeleteBlobStorage", i, before, nil, err)
+	return err
+}
+
+// CreateDatadog implements api.Interface.
+func (r *Recorder) CreateDatadog(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+	before := r.before("CreateDatadog", i)
+	result, err := r.Interface.CreateDatadog(i)
+	r.record("CreateDatadog", i, before, result, err)
+	return result, err
+}
+
+// UpdateDatadog implements api.Interface.
+func (r *Recorder) UpdateDatadog(i *fastly.UpdateDatadogInput) (*fastly.Datadog, error) {
+	before := r.before("UpdateDatadog", i)
+	result, err := r.Interface.UpdateDatadog(i)
+	r.record("UpdateDatadog", i, before, result, err)
+	return result, err
+}
+
+// DeleteDatadog implements api.Interface.
+func (r *Recorder) DeleteDatadog(i *fastly.DeleteDatadogInput) error {
+	before := r.before("DeleteDatadog", i)
+	err := r.Interface.DeleteDatadog(i)
+	r.record("DeleteDatadog", i, before, nil, err)
+	return err
+}
+
+// CreateHTTPS implements api.Interface.
+func (r *Recorder) CreateHTTPS(i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+	before := r.before("CreateHTTPS", i)
+	result, err := r.Interface.CreateHTTPS(i)
+	r.record("CreateHTTPS", i, before, result, err)
+	return result, err
+}
+
+// UpdateHTTPS implements api.Interface.
+func (r *Recorder) UpdateHTTPS(i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error) {
+	before := r.before("UpdateHTTPS", i)
+	result, err := r.Interface.UpdateHTTPS(i)
+	r.record("UpdateHTTPS", i, before, result, err)
+	return result, err
+}
+
+// DeleteHTTPS implements api.Interface.
+func (r *Recorder) DeleteHTTPS(i *fastly.DeleteHTTPSInput) error {
+	before := r.before("DeleteHTTPS", i)
+	err := r.Interface.DeleteHTTPS(i)
+	r.record("DeleteHTTPS", i, before, nil, err)
+	return err
+}
+
+// CreateKafka implements api.Interface.
+func (r *Recorder) CreateKafka(i *fastly.CreateKafkaInput) (*fastly.Kafka, error) {
+	before := r.before("CreateKafka", i)
+	result, err := r.Interface.CreateKafka(i)
+	r.record("CreateKafka", i, before, result, err)
+	return result, err
+}
+
+// UpdateKafka implements api.Interface.
+func (r *Recorder) UpdateKafka(i *fastly.UpdateKafkaInput) (*fastly.Kafka, error) {
+	before := r.before("UpdateKafka", i)
+	result, err := r.Interface.UpdateKafka(i)
+	r.record("UpdateKafka", i, before, result, err)
+	return result, err
+}
+
+// DeleteKafka implements api.Interface.
+func (r *Recorder) DeleteKafka(i *fastly.DeleteKafkaInput) error {
+	before := r.before("DeleteKafka", i)
+	err := r.Interface.DeleteKafka(i)
+	r.record("DeleteKafka", i, before, nil, err)
+	return err
+}
+
+// CreatePubsub implements api.Interface.
+func (r *Recorder) CreatePubsub(i *fastly.CreatePubsubInput) (*fastly.Pubsub, error) {
+	before := r.before("CreatePubsub", i)
+	result, err := r.Interface.CreatePubsub(i)
+	r.record("CreatePubsub", i, before, result, err)
+	return result, err
+}
+
+// UpdatePubsub implements api.Interface.
+func (r *Recorder) UpdatePubsub(i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error) {
+	before := r.before("UpdatePubsub", i)
+	result, err := r.Interface.UpdatePubsub(i)
+	r.record("UpdatePubsub", i, before, result, err)
+	return result, err
+}
+
+// DeletePubsub implements api.Interface.
+func (r *Recorder) DeletePubsub(i *fastly.DeletePubsubInput) error {
+	before := r.before("DeletePubsub", i)
+	err := r.Interface.DeletePubsub(i)
+	r.record("DeletePubsub", i, before, nil, err)
+	return err
+}
+
+// CreateOpenstack implements api.Interface.
+func (r *Recorder) CreateOpenstack(i *fastly.CreateOpenstackInput) (*fastly.Openstack, error) {
+	before := r.before("CreateOpenstack", i)
+	result, err := r.Interface.CreateOpenstack(i)
+	r.record("CreateOpenstack", i, before, result, err)
+	return result, err
+}
+
+// UpdateOpenstack implements api.Interface.
+func (r *Recorder) UpdateOpenstack(i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error) {
+	before := r.before("UpdateOpenstack", i)
+	result, err := r.Interface.UpdateOpenstack(i)
+	r.record("UpdateOpenstack", i, before, result, err)
+	return result, err
+}
+
+// DeleteOpenstack implements api.Interface.
+func (r *Recorder) DeleteOpenstack(i *fastly.DeleteOpenstackInput) error {
+	before := r.before("DeleteOpenstack", i)
+	err := r.Interface.DeleteOpenstack(i)
+	r.record("DeleteOpenstack", i, before, nil, err)
+	return err
+}
+
+// CreateUser implements api.Interface.
+func (r *Recorder) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	before := r.before("CreateUser", i)
+	result, err := r.Interface.CreateUser(i)
+	r.record("CreateUser", i, before, result, err)
+	return result, err
+}
+
+// UpdateUser implements api.Interface.
+func (r *Recorder) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	before := r.before("UpdateUser", i)
+	result, err := r.Interface.UpdateUser(i)
+	r.record("UpdateUser", i, before, result, err)
+	return result, err
+}
+
+// DeleteUser implements api.Interface.
+func (r *Recorder) DeleteUser(i *fastly.DeleteUserInput) error {
+	before := r.before("DeleteUser", i)
+	err := r.Interface.DeleteUser(i)
+	r.record("DeleteUser", i, before, nil, err)
+	return err
+}
+
+// CreateToken implements api.Interface.
+func (r *Recorder) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	before := r.before("CreateToken", i)
+	result, err := r.Interface.CreateToken(i)
+	r.record("CreateToken", i, before, result, err)
+	return result, err
+}
+
+// DeleteToken implements api.Interface.
+func (r *Recorder) DeleteToken(i *fastly.DeleteTokenInput) error {
+	before := r.before("DeleteToken", i)
+	err := r.Interface.DeleteToken(i)
+	r.record("DeleteToken", i, before, nil, err)
+	return err
+}
+
+// DeleteTokenSelf implements api.Interface.
+func (r *Recorder) DeleteTokenSelf() error {
+	err := r.Interface.DeleteTokenSelf()
+	r.record("DeleteTokenSelf", nil, nil, nil, err)
+	return err
+}
+
+// CreateManagedLogging implements api.Interface.
+func (r *Recorder) CreateManagedLogging(i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error) {
+	before := r.before("CreateManagedLogging", i)
+	result, err := r.Interface.CreateManagedLogging(i)
+	r.record("CreateManagedLogging", i, before, result, err)
+	return result, err
+}
+
+// CreateVCL implements api.Interface.
+func (r *Recorder) CreateVCL(i *fastly.CreateVCLInput) (*fastly.VCL, error) {
+	before := r.before("CreateVCL", i)
+	result, err := r.Interface.CreateVCL(i)
+	r.record("CreateVCL", i, before, result, err)
+	return result, err
+}
+
+// UpdateVCL implements api.Interface.
+func (r *Recorder) UpdateVCL(i *fastly.UpdateVCLInput) (*fastly.VCL, error) {
+	before := r.before("UpdateVCL", i)
+	result, err := r.Interface.UpdateVCL(i)
+	r.record("UpdateVCL", i, before, result, err)
+	return result, err
+}
+
+// DeleteVCL implements api.Interface.
+func (r *Recorder) DeleteVCL(i *fastly.DeleteVCLInput) error {
+	before := r.before("DeleteVCL", i)
+	err := r.Interface.DeleteVCL(i)
+	r.record("DeleteVCL", i, before, nil, err)
+	return err
+}
+
+// CreateSnippet implements api.Interface.
+func (r *Recorder) CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+	before := r.before("CreateSnippet", i)
+	result, err := r.Interface.CreateSnippet(i)
+	r.record("CreateSnippet", i, before, result, err)
+	return result, err
+}
+
+// UpdateSnippet implements api.Interface.
+func (r *Recorder) UpdateSnippet(i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+	before := r.before("UpdateSnippet", i)
+	result, err := r.Interface.UpdateSnippet(i)
+	r.record("UpdateSnippet", i, before, result, err)
+	return result, err
+}
+
+// UpdateDynamicSnippet implements api.Interface.
+func (r *Recorder) UpdateDynamicSnippet(i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	before := r.before("UpdateDynamicSnippet", i)
+	result, err := r.Interface.UpdateDynamicSnippet(i)
+	r.record("UpdateDynamicSnippet", i, before, result, err)
+	return result, err
+}
+
+// DeleteSnippet implements api.Interface.
+func (r *Recorder) DeleteSnippet(i *fastly.DeleteSnippetInput) error {
+	before := r.before("DeleteSnippet", i)
+	err := r.Interface.DeleteSnippet(i)
+	r.record("DeleteSnippet", i, before, nil, err)
+	return err
+}
+
+// Purge implements api.Interface.
+func (r *Recorder) Purge(i *fastly.PurgeInput) (*fastly.Purge, error) {
+	before := r.before("Purge", i)
+	result, err := r.Interface.Purge(i)
+	r.record("Purge", i, before, result, err)
+	return result, err
+}
+
+// PurgeKey implements api.Interface.
+func (r *Recorder) PurgeKey(i *fastly.PurgeKeyInput) (*fastly.Purge, error) {
+	before := r.before("PurgeKey", i)
+	result, err := r.Interface.PurgeKey(i)
+	r.record("PurgeKey", i, before, result, err)
+	return result, err
+}
+
+// PurgeKeys implements api.Interface.
+func (r *Recorder) PurgeKeys(i *fastly.PurgeKeysInput) (map[string]string, error) {
+	before := r.before("PurgeKeys", i)
+	result, err := r.Interface.PurgeKeys(i)
+	r.record("PurgeKeys", i, before, result, err)
+	return result, err
+}
+
+// PurgeAll implements api.Interface.
+func (r *Recorder) PurgeAll(i *fastly.PurgeAllInput) (*fastly.Purge, error) {
+	before := r.before("PurgeAll", i)
+	result, err := r.Interface.PurgeAll(i)
+	r.record("PurgeAll", i, before, result, err)
+	return result, err
+}
+
+// CreateACL implements api.Interface.
+func (r *Recorder) CreateACL(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	before := r.before("CreateACL", i)
+	result, err := r.Interface.CreateACL(i)
+	r.record("CreateACL", i, before, result, err)
+	return result, err
+}
+
+// DeleteACL implements api.Interface.
+func (r *Recorder) DeleteACL(i *fastly.DeleteACLInput) error {
+	before := r.before("DeleteACL", i)
+	err := r.Interface.DeleteACL(i)
+	r.record("DeleteACL", i, before, nil, err)
+	return err
+}
+
+// UpdateACL implements api.Interface.
+func (r *Recorder) UpdateACL(i *fastly.UpdateACLInput) (*fastly.ACL, error) {
+	before := r.before("UpdateACL", i)
+	result, err := r.Interface.UpdateACL(i)
+	r.record("UpdateACL", i, before, result, err)
+	return result, err
+}
+
+// CreateACLEntry implements api.Interface.
+func (r *Recorder) CreateACLEntry(i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	before := r.before("CreateACLEntry", i)
+	result, err := r.Interface.CreateACLEntry(i)
+	r.record("CreateACLEntry", i, before, result, err)
+	return result, err
+}
+
+// DeleteACLEntry implements api.Interface.
+func (r *Recorder) DeleteACLEntry(i *fastly.DeleteACLEntryInput) error {
+	before := r.before("DeleteACLEntry", i)
+	err := r.Interface.DeleteACLEntry(i)
+	r.record("DeleteACLEntry", i, before, nil, err)
+	return err
+}
+
+// UpdateACLEntry implements api.Interface.
+func (r *Recorder) UpdateACLEntry(i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error) {
+	before := r.before("UpdateACLEntry", i)
+	result, err := r.Interface.UpdateACLEntry(i)
+	r.record("UpdateACLEntry", i, before, result, err)
+	return result, err
+}
+
+// BatchModifyACLEntries implements api.Interface.
+func (r *Recorder) BatchModifyACLEntries(i *fastly.BatchModifyACLEntriesInput) error {
+	before := r.before("BatchModifyACLEntries", i)
+	err := r.Interface.BatchModifyACLEntries(i)
+	r.record("BatchModifyACLEntries", i, before, nil, err)
+	return err
+}
+
+// CreateNewRelic implements api.Interface.
+func (r *Recorder) CreateNewRelic(i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error) {
+	before := r.before("CreateNewRelic", i)
+	result, err := r.Interface.CreateNewRelic(i)
+	r.record("CreateNewRelic", i, before, result, err)
+	return result, err
+}
+
+// DeleteNewRelic implements api.Interface.
+func (r *Recorder) DeleteNewRelic(i *fastly.DeleteNewRelicInput) error {
+	before := r.before("DeleteNewRelic", i)
+	err := r.Interface.DeleteNewRelic(i)
+	r.record("DeleteNewRelic", i, before, nil, err)
+	return err
+}
+
+// UpdateNewRelic implements api.Interface.
+func (r *Recorder) UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
+	before := r.before("UpdateNewRelic", i)
+	result, err := r.Interface.UpdateNewRelic(i)
+	r.record("UpdateNewRelic", i, before, result, err)
+	return result, err
+}
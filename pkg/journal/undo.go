@@ -0,0 +1,440 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Call is an API call that compensates for a recorded operation.
+type Call struct {
+	Method string
+	Input  interface{}
+}
+
+// Invoke makes the call using client.
+func (c Call) Invoke(client api.Interface) error {
+	m := reflect.ValueOf(&client).Elem().MethodByName(c.Method)
+	if !m.IsValid() {
+		return fmt.Errorf("unknown API method %s", c.Method)
+	}
+	out := m.Call([]reflect.Value{reflect.ValueOf(c.Input)})
+	if err, _ := out[len(out)-1].Interface().(error); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String renders the method along with the fields identifying the resource it
+// applies to.
+func (c Call) String() string {
+	v := reflect.Indirect(reflect.ValueOf(c.Input))
+	var fields []string
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if pathField(f) && !v.Field(i).IsZero() {
+			fields = append(fields, fmt.Sprintf("%s: %v", f.Name, v.Field(i).Interface()))
+		}
+	}
+	return fmt.Sprintf("%s (%s)", c.Method, strings.Join(fields, ", "))
+}
+
+// ErrNotUndoable indicates an entry includes an operation that can't be
+// undone.
+type ErrNotUndoable struct {
+	Method string
+	Reason string
+}
+
+func (e ErrNotUndoable) Error() string {
+	return fmt.Sprintf("%s can't be undone: %s", e.Method, e.Reason)
+}
+
+// notUndoable are methods that can't be undone by recreating what they
+// deleted, because the recreated resource would have a new ID or would lose
+// content the API doesn't return, such as dictionary items or credentials.
+var notUndoable = map[string]string{
+	"DeleteACL":        "its entries would be lost",
+	"DeleteDictionary": "its items would be lost",
+	"DeletePool":       "its servers would be lost",
+	"DeleteService":    "its versions would be lost",
+	"DeleteToken":      "tokens can't be recreated",
+	"DeleteTokenSelf":  "tokens can't be recreated",
+	"DeleteUser":       "the user would have a new ID",
+	"LockVersion":      "versions can't be unlocked",
+}
+
+// Undo returns the calls that undo the successful operations of the entry, in
+// the order they should be made, which is the reverse of the operations.
+func Undo(e Entry) ([]Call, error) {
+	var calls []Call
+	for i := len(e.Operations) - 1; i >= 0; i-- {
+		op := e.Operations[i]
+		if !op.Succeeded() {
+			continue
+		}
+		c, err := compensate(op)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			calls = append(calls, *c)
+		}
+	}
+	return calls, nil
+}
+
+// compensate returns the call undoing op, or nil if nothing needs undoing.
+func compensate(op Operation) (*Call, error) {
+	if reason, ok := notUndoable[op.Method]; ok {
+		return nil, ErrNotUndoable{op.Method, reason}
+	}
+	for _, prefix := range []string{"Purge", "Batch"} {
+		if strings.HasPrefix(op.Method, prefix) {
+			return nil, ErrNotUndoable{op.Method, "the API has no inverse operation"}
+		}
+	}
+
+	switch op.Method {
+	case "CloneVersion":
+		// The cloned version is left as an inactive draft, as versions can't be
+		// deleted.
+		return nil, nil
+	case "ActivateVersion", "DeactivateVersion":
+		return compensateActivation(op)
+	}
+
+	inputType, ok := inputTypeOf(op.Method)
+	if !ok {
+		return nil, ErrNotUndoable{op.Method, "unknown API method"}
+	}
+	if usesJSONAPI(inputType) {
+		return nil, ErrNotUndoable{op.Method, "its relationships can't be restored"}
+	}
+
+	switch {
+	case strings.HasPrefix(op.Method, "Create"):
+		return compensateCreate(op)
+	case strings.HasPrefix(op.Method, "Update"):
+		return compensateUpdate(op)
+	case strings.HasPrefix(op.Method, "Delete"):
+		return compensateDelete(op)
+	}
+	return nil, ErrNotUndoable{op.Method, "the API has no inverse operation"}
+}
+
+// compensateCreate deletes what was created, identifying it from the input
+// and result of the call.
+func compensateCreate(op Operation) (*Call, error) {
+	method := "Delete" + strings.TrimPrefix(op.Method, "Create")
+	input, err := newInput(method, op.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := decodeInput(op)
+	if err != nil {
+		return nil, err
+	}
+	copyFields(input, in, allFields)
+	if op.Result != nil {
+		result, err := decodeResult(op.Method, op.Result)
+		if err != nil {
+			return nil, err
+		}
+		copyFields(input, result, allFields)
+	}
+
+	if !identified(input) {
+		return nil, ErrNotUndoable{op.Method, "the created resource can't be identified"}
+	}
+	return &Call{Method: method, Input: input.Interface()}, nil
+}
+
+// compensateUpdate restores the previous values of the fields that were
+// updated.
+func compensateUpdate(op Operation) (*Call, error) {
+	input, err := newInput(op.Method, op.Method)
+	if err != nil {
+		return nil, err
+	}
+	in, before, err := decodeInputAndBefore(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resource is identified as it was after the update, in case it was
+	// renamed.
+	copyFields(input, in, pathField)
+	if op.Result != nil {
+		result, err := decodeResult(op.Method, op.Result)
+		if err != nil {
+			return nil, err
+		}
+		copyFields(input, result, pathField)
+	}
+
+	dst, src := input.Elem(), in.Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		f := dst.Type().Field(i)
+		if pathField(f) || src.Field(i).IsZero() {
+			continue
+		}
+		if api.IsSecret(api.Kind(input.Interface()), apiName(f)) {
+			return nil, ErrNotUndoable{op.Method, fmt.Sprintf("the previous value of %s is a credential, which isn't recorded", f.Name)}
+		}
+		prev, ok := fieldByAPIName(before, apiName(f))
+		if !ok || !assign(dst.Field(i), prev, true) {
+			return nil, ErrNotUndoable{op.Method, fmt.Sprintf("the previous value of %s wasn't recorded", f.Name)}
+		}
+	}
+
+	if !identified(input) {
+		return nil, ErrNotUndoable{op.Method, "the updated resource can't be identified"}
+	}
+	return &Call{Method: op.Method, Input: input.Interface()}, nil
+}
+
+// compensateDelete recreates what was deleted from its recorded state.
+func compensateDelete(op Operation) (*Call, error) {
+	method := "Create" + strings.TrimPrefix(op.Method, "Delete")
+	input, err := newInput(method, op.Method)
+	if err != nil {
+		return nil, err
+	}
+	in, before, err := decodeInputAndBefore(op)
+	if err != nil {
+		return nil, err
+	}
+
+	copyFields(input, in, pathField)
+	dst := input.Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		f := dst.Type().Field(i)
+		if pathField(f) {
+			continue
+		}
+		prev, ok := fieldByAPIName(before, apiName(f))
+		if !ok {
+			continue
+		}
+		if api.IsSecret(api.Kind(input.Interface()), apiName(f)) && !prev.IsZero() {
+			return nil, ErrNotUndoable{op.Method, "its credentials aren't recorded"}
+		}
+		assign(dst.Field(i), prev, false)
+	}
+
+	if !identified(input) {
+		return nil, ErrNotUndoable{op.Method, "the deleted resource can't be identified"}
+	}
+	return &Call{Method: method, Input: input.Interface()}, nil
+}
+
+// compensateActivation reactivates whichever version was active beforehand,
+// or deactivates the version if none was.
+func compensateActivation(op Operation) (*Call, error) {
+	var (
+		in      fastly.ActivateVersionInput
+		service fastly.Service
+	)
+	if err := json.Unmarshal(op.Input, &in); err != nil {
+		return nil, fmt.Errorf("error decoding journal entry: %w", err)
+	}
+	if op.Before == nil {
+		return nil, ErrNotUndoable{op.Method, "the previously active version wasn't recorded"}
+	}
+	if err := json.Unmarshal(op.Before, &service); err != nil {
+		return nil, fmt.Errorf("error decoding journal entry: %w", err)
+	}
+	prev := int(service.ActiveVersion)
+
+	switch {
+	case op.Method == "DeactivateVersion" && prev == in.ServiceVersion:
+		return &Call{Method: "ActivateVersion", Input: &fastly.ActivateVersionInput{ServiceID: in.ServiceID, ServiceVersion: prev}}, nil
+	case op.Method == "DeactivateVersion", prev == in.ServiceVersion:
+		return nil, nil
+	case prev > 0:
+		return &Call{Method: "ActivateVersion", Input: &fastly.ActivateVersionInput{ServiceID: in.ServiceID, ServiceVersion: prev}}, nil
+	default:
+		return &Call{Method: "DeactivateVersion", Input: &fastly.DeactivateVersionInput{ServiceID: in.ServiceID, ServiceVersion: in.ServiceVersion}}, nil
+	}
+}
+
+// beforeMethod returns the method that fetches the state of the resource
+// modified by method, which is needed to undo it.
+func beforeMethod(method string) (string, bool) {
+	var getter string
+	switch {
+	case method == "ActivateVersion", method == "DeactivateVersion":
+		getter = "GetService"
+	case strings.HasPrefix(method, "Update"):
+		getter = "Get" + strings.TrimPrefix(method, "Update")
+	case strings.HasPrefix(method, "Delete"):
+		getter = "Get" + strings.TrimPrefix(method, "Delete")
+	default:
+		return "", false
+	}
+	_, ok := interfaceType.MethodByName(getter)
+	return getter, ok
+}
+
+var interfaceType = reflect.TypeOf((*api.Interface)(nil)).Elem()
+
+// inputTypeOf returns the input struct type of an API method.
+func inputTypeOf(method string) (reflect.Type, bool) {
+	m, ok := interfaceType.MethodByName(method)
+	if !ok || m.Type.NumIn() != 1 {
+		return nil, false
+	}
+	return m.Type.In(0).Elem(), true
+}
+
+// newInput returns a pointer to a new input for method, which undoes op.
+func newInput(method, op string) (reflect.Value, error) {
+	t, ok := inputTypeOf(method)
+	if !ok {
+		return reflect.Value{}, ErrNotUndoable{op, "the API has no inverse operation"}
+	}
+	return reflect.New(t), nil
+}
+
+func decodeInput(op Operation) (reflect.Value, error) {
+	t, _ := inputTypeOf(op.Method)
+	v := reflect.New(t)
+	if err := json.Unmarshal(op.Input, v.Interface()); err != nil {
+		return v, fmt.Errorf("error decoding journal entry: %w", err)
+	}
+	return v, nil
+}
+
+func decodeResult(method string, data json.RawMessage) (reflect.Value, error) {
+	m, _ := interfaceType.MethodByName(method)
+	t := m.Type.Out(0)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		return v, fmt.Errorf("error decoding journal entry: %w", err)
+	}
+	return v, nil
+}
+
+func decodeInputAndBefore(op Operation) (in, before reflect.Value, err error) {
+	in, err = decodeInput(op)
+	if err != nil {
+		return in, before, err
+	}
+	getter, ok := beforeMethod(op.Method)
+	if !ok || op.Before == nil {
+		return in, before, ErrNotUndoable{op.Method, "its previous state wasn't recorded"}
+	}
+	before, err = decodeResult(getter, op.Before)
+	return in, before, err
+}
+
+// pathField reports whether the field of an API input identifies the resource,
+// rather than being sent as part of the request body.
+func pathField(f reflect.StructField) bool {
+	tag, ok := f.Tag.Lookup("form")
+	return !ok || tag == "-"
+}
+
+func allFields(reflect.StructField) bool { return true }
+
+// apiName returns the name of the API field an input field is sent as.
+func apiName(f reflect.StructField) string {
+	return strings.Split(f.Tag.Get("form"), ",")[0]
+}
+
+// fieldByAPIName returns the field of an API resource that the API names
+// name.
+func fieldByAPIName(v reflect.Value, name string) (reflect.Value, bool) {
+	v = reflect.Indirect(v)
+	for i := 0; i < v.NumField(); i++ {
+		if strings.Split(v.Type().Field(i).Tag.Get("mapstructure"), ",")[0] == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// identified reports whether every field identifying the resource is set.
+func identified(input reflect.Value) bool {
+	v := input.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if pathField(v.Type().Field(i)) && v.Field(i).IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// usesJSONAPI reports whether the input is sent in the JSON:API format, whose
+// relationships can't be restored from the resource.
+func usesJSONAPI(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("jsonapi"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// copyFields sets each field of the struct dst points to, which include
+// selects, from the non-zero field of the same name in src.
+func copyFields(dst, src reflect.Value, include func(reflect.StructField) bool) {
+	dst, src = reflect.Indirect(dst), reflect.Indirect(src)
+	if dst.Kind() != reflect.Struct || src.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < dst.NumField(); i++ {
+		f := dst.Type().Field(i)
+		if !include(f) {
+			continue
+		}
+		if s := src.FieldByName(f.Name); s.IsValid() && !s.IsZero() {
+			assign(dst.Field(i), s, false)
+		}
+	}
+}
+
+// assign sets dst from src, dereferencing or allocating pointers as needed,
+// e.g. so a string populates a *string. Zero values are only assigned if
+// zero is set, as otherwise a pointer field would be sent as empty rather
+// than omitted.
+func assign(dst, src reflect.Value, zero bool) bool {
+	if src.Kind() == reflect.Ptr {
+		if src.IsNil() {
+			return zero
+		}
+		src = src.Elem()
+	}
+	if src.IsZero() && !zero {
+		return true
+	}
+	if dst.Kind() == reflect.Ptr {
+		if !convertible(src.Type(), dst.Type().Elem()) {
+			return false
+		}
+		p := reflect.New(dst.Type().Elem())
+		p.Elem().Set(src.Convert(dst.Type().Elem()))
+		dst.Set(p)
+		return true
+	}
+	if !convertible(src.Type(), dst.Type()) {
+		return false
+	}
+	dst.Set(src.Convert(dst.Type()))
+	return true
+}
+
+// convertible reports whether a value of type from can be converted to type
+// to without changing its meaning, which rules out e.g. int to string.
+func convertible(from, to reflect.Type) bool {
+	return from.Kind() == to.Kind() && from.ConvertibleTo(to)
+}
@@ -0,0 +1,3 @@
+// Package lint checks the configuration of a Fastly service version against
+// a set of rules, each of which reports findings of a given severity.
+package lint
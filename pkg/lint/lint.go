@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/definition"
+)
+
+// Severity is how serious a finding is. Only errors fail a lint run.
+type Severity string
+
+// Supported severities, in increasing order of seriousness.
+const (
+	Info    Severity = "info"
+	Warning Severity = "warning"
+	Error   Severity = "error"
+)
+
+var severityRank = map[Severity]int{Info: 0, Warning: 1, Error: 2}
+
+// Finding is a single problem reported by a rule.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Resource identifies the offending resource, e.g. `backend "origin"`,
+	// and is empty for findings about the service as a whole.
+	Resource string `json:"resource,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Rule is a single check run against a service definition.
+type Rule struct {
+	// Name identifies the rule, e.g. so that it can be disabled.
+	Name string
+	// Description summarises what the rule checks for.
+	Description string
+	// Severity is the severity of the rule's findings, unless Check sets
+	// another.
+	Severity Severity
+	// Check returns the rule's findings for the service.
+	Check func(s *definition.Service) []Finding
+}
+
+// Run checks the service against each of the rules and returns the findings,
+// most serious first.
+func Run(s *definition.Service, rules []Rule) []Finding {
+	var findings []Finding
+	for _, r := range rules {
+		for _, f := range r.Check(s) {
+			f.Rule = r.Name
+			if f.Severity == "" {
+				f.Severity = r.Severity
+			}
+			findings = append(findings, f)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityRank[findings[i].Severity] > severityRank[findings[j].Severity]
+	})
+	return findings
+}
+
+// Count returns the number of findings with the given severity.
+func Count(findings []Finding, s Severity) int {
+	var n int
+	for _, f := range findings {
+		if f.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+// Select returns the rules, excluding those named in disabled. It's an error
+// to name a rule that doesn't exist.
+func Select(rules []Rule, disabled []string) ([]Rule, error) {
+	skip := make(map[string]bool)
+	for _, name := range disabled {
+		skip[name] = true
+	}
+	var selected []Rule
+	for _, r := range rules {
+		if skip[r.Name] {
+			delete(skip, r.Name)
+			continue
+		}
+		selected = append(selected, r)
+	}
+	for name := range skip {
+		return nil, fmt.Errorf("unknown rule %q", name)
+	}
+	return selected, nil
+}
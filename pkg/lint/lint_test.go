@@ -0,0 +1,101 @@
+package lint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fastly/cli/pkg/definition"
+	"github.com/fastly/cli/pkg/lint"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRules(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		service definition.Service
+		want    []string
+	}{
+		{
+			name: "healthy backend",
+			service: definition.Service{
+				Backends: []definition.Resource{
+					{"name": "origin", "healthcheck": "check", "use_ssl": true, "ssl_check_cert": true, "min_tls_version": "1.2", "first_byte_timeout": int64(15000), "shield": "london-uk"},
+				},
+			},
+		},
+		{
+			name: "unhealthy backend",
+			service: definition.Service{
+				Backends: []definition.Resource{
+					{"name": "origin", "use_ssl": true, "min_tls_version": "1.0", "first_byte_timeout": int64(200)},
+				},
+			},
+			want: []string{
+				`error backend-ssl-check-cert backend "origin"`,
+				`warning backend-healthcheck backend "origin"`,
+				`warning backend-min-tls-version backend "origin"`,
+				`warning backend-first-byte-timeout backend "origin"`,
+				`info shielding `,
+			},
+		},
+		{
+			name: "logging",
+			service: definition.Service{
+				Logging: map[string][]definition.Resource{
+					"https": {{"name": "collector", "url": "http://example.com/logs"}},
+					"s3":    {{"name": "archive"}, {"name": "compressed", "compression_codec": "zstd"}, {"name": "gzipped", "gzip_level": int64(9)}},
+				},
+			},
+			want: []string{
+				`error logging-plain-http logging https "collector"`,
+				`info logging-compression logging s3 "archive"`,
+			},
+		},
+		{
+			name: "dictionaries",
+			service: definition.Service{
+				Dictionaries: []definition.Dictionary{
+					{Name: "small", Items: items(10)},
+					{Name: "large", Items: items(800)},
+					{Name: "full", Items: items(lint.DictionaryItemLimit)},
+				},
+			},
+			want: []string{
+				`error dictionary-item-limit dictionary "full"`,
+				`warning dictionary-item-limit dictionary "large"`,
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var have []string
+			for _, f := range lint.Run(&testcase.service, lint.Rules) {
+				have = append(have, fmt.Sprintf("%s %s %s", f.Severity, f.Rule, f.Resource))
+			}
+			testutil.AssertEqual(t, testcase.want, have)
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	rules, err := lint.Select(lint.Rules, []string{"shielding"})
+	testutil.AssertNoError(t, err)
+	if len(rules) != len(lint.Rules)-1 {
+		t.Fatalf("want %d rules, have %d", len(lint.Rules)-1, len(rules))
+	}
+	for _, r := range rules {
+		if r.Name == "shielding" {
+			t.Fatal("want shielding rule disabled")
+		}
+	}
+
+	_, err = lint.Select(lint.Rules, []string{"nonexistent"})
+	testutil.AssertErrorContains(t, err, `unknown rule "nonexistent"`)
+}
+
+func items(n int) map[string]string {
+	m := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		m[fmt.Sprintf("key%d", i)] = "value"
+	}
+	return m
+}
@@ -0,0 +1,165 @@
+package lint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/cli/pkg/definition"
+)
+
+// DictionaryItemLimit is the default maximum number of items in an edge
+// dictionary.
+const DictionaryItemLimit = 1000
+
+// MinFirstByteTimeout is the shortest first byte timeout, in milliseconds,
+// which isn't reported as suspiciously low.
+const MinFirstByteTimeout = 1000
+
+// compressibleLogging are the logging endpoint kinds which support
+// compressing the files they write.
+var compressibleLogging = []string{"azureblob", "cloudfiles", "digitalocean", "ftp", "gcs", "openstack", "s3", "sftp"}
+
+// Rules is the default rule set.
+var Rules = []Rule{
+	{
+		Name:        "backend-healthcheck",
+		Description: "Backends should have a healthcheck, so that failures can be detected",
+		Severity:    Warning,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, b := range s.Backends {
+				if str(b, "healthcheck") == "" {
+					findings = append(findings, Finding{Resource: resource("backend", b), Message: "backend has no healthcheck"})
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "backend-ssl-check-cert",
+		Description: "Backends using TLS should verify the certificate they present",
+		Severity:    Error,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, b := range s.Backends {
+				if b["use_ssl"] == true && b["ssl_check_cert"] != true {
+					findings = append(findings, Finding{Resource: resource("backend", b), Message: "backend uses TLS without checking the certificate"})
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "backend-min-tls-version",
+		Description: "Backends using TLS should require TLS 1.2 or later",
+		Severity:    Warning,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, b := range s.Backends {
+				v := str(b, "min_tls_version")
+				if b["use_ssl"] != true || v == "" {
+					continue
+				}
+				if n, err := strconv.ParseFloat(v, 64); err == nil && n < 1.2 {
+					findings = append(findings, Finding{Resource: resource("backend", b), Message: fmt.Sprintf("minimum TLS version is %s, below 1.2", v)})
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "backend-first-byte-timeout",
+		Description: "Backend first byte timeouts shouldn't be so low that slow responses fail",
+		Severity:    Warning,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, b := range s.Backends {
+				if t, ok := b["first_byte_timeout"].(int64); ok && t < MinFirstByteTimeout {
+					findings = append(findings, Finding{Resource: resource("backend", b), Message: fmt.Sprintf("first byte timeout is %dms, below %dms", t, MinFirstByteTimeout)})
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "shielding",
+		Description: "At least one backend should be shielded, to reduce the load on origins",
+		Severity:    Info,
+		Check: func(s *definition.Service) []Finding {
+			if len(s.Backends) == 0 {
+				return nil
+			}
+			for _, b := range s.Backends {
+				if str(b, "shield") != "" {
+					return nil
+				}
+			}
+			return []Finding{{Message: "no backend has shielding configured"}}
+		},
+	},
+	{
+		Name:        "logging-plain-http",
+		Description: "Logging endpoints shouldn't send logs over plain HTTP",
+		Severity:    Error,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, k := range definition.LoggingKinds {
+				for _, r := range s.Logging[k.Name] {
+					if strings.HasPrefix(strings.ToLower(str(r, "url")), "http://") {
+						findings = append(findings, Finding{Resource: resource("logging "+k.Name, r), Message: "logs are sent over plain HTTP"})
+					}
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "logging-compression",
+		Description: "Logging endpoints which write files should compress them",
+		Severity:    Info,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, k := range compressibleLogging {
+				for _, r := range s.Logging[k] {
+					if str(r, "compression_codec") == "" && r["gzip_level"] == nil {
+						findings = append(findings, Finding{Resource: resource("logging "+k, r), Message: "log files aren't compressed"})
+					}
+				}
+			}
+			return findings
+		},
+	},
+	{
+		Name:        "dictionary-item-limit",
+		Description: "Dictionaries shouldn't be close to the limit on the number of items",
+		Severity:    Warning,
+		Check: func(s *definition.Service) []Finding {
+			var findings []Finding
+			for _, d := range s.Dictionaries {
+				n := len(d.Items)
+				f := Finding{Resource: fmt.Sprintf("dictionary %q", d.Name), Message: fmt.Sprintf("dictionary has %d of %d items", n, DictionaryItemLimit)}
+				switch {
+				case n >= DictionaryItemLimit:
+					f.Severity = Error
+				case n*10 >= DictionaryItemLimit*8:
+					f.Severity = Warning
+				default:
+					continue
+				}
+				findings = append(findings, f)
+			}
+			return findings
+		},
+	},
+}
+
+// str returns the string value of a resource field.
+func str(r definition.Resource, key string) string {
+	s, _ := r[key].(string)
+	return s
+}
+
+func resource(kind string, r definition.Resource) string {
+	return fmt.Sprintf("%s %q", kind, r.Name())
+}
@@ -28,6 +28,7 @@ type API struct {
 	DeactivateVersionFn func(*fastly.DeactivateVersionInput) (*fastly.Version, error)
 	LockVersionFn       func(*fastly.LockVersionInput) (*fastly.Version, error)
 	LatestVersionFn     func(*fastly.LatestVersionInput) (*fastly.Version, error)
+	ValidateVersionFn   func(*fastly.ValidateVersionInput) (bool, string, error)
 
 	CreateDomainFn func(*fastly.CreateDomainInput) (*fastly.Domain, error)
 	ListDomainsFn  func(*fastly.ListDomainsInput) ([]*fastly.Domain, error)
@@ -47,6 +48,88 @@ type API struct {
 	UpdateHealthCheckFn func(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheckFn func(*fastly.DeleteHealthCheckInput) error
 
+	CreateConditionFn func(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditionsFn  func(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetConditionFn    func(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateConditionFn func(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteConditionFn func(*fastly.DeleteConditionInput) error
+
+	CreateHeaderFn func(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeadersFn  func(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeaderFn    func(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeaderFn func(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeaderFn func(*fastly.DeleteHeaderInput) error
+
+	CreateCacheSettingFn func(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettingsFn  func(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSettingFn    func(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSettingFn func(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSettingFn func(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSettingFn func(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettingsFn  func(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSettingFn    func(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSettingFn func(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSettingFn func(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObjectFn func(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjectsFn  func(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObjectFn    func(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObjectFn func(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObjectFn func(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzipFn func(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzipsFn  func(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzipFn    func(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzipFn func(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzipFn func(*fastly.DeleteGzipInput) error
+
+	CreateDirectorFn func(*fastly.CreateDirectorInput) (*fastly.Director, error)
+	ListDirectorsFn  func(*fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	GetDirectorFn    func(*fastly.GetDirectorInput) (*fastly.Director, error)
+	UpdateDirectorFn func(*fastly.UpdateDirectorInput) (*fastly.Director, error)
+	DeleteDirectorFn func(*fastly.DeleteDirectorInput) error
+
+	CreateDirectorBackendFn func(*fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDirectorBackendFn    func(*fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	DeleteDirectorBackendFn func(*fastly.DeleteDirectorBackendInput) error
+
+	CreatePoolFn func(*fastly.CreatePoolInput) (*fastly.Pool, error)
+	ListPoolsFn  func(*fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	GetPoolFn    func(*fastly.GetPoolInput) (*fastly.Pool, error)
+	UpdatePoolFn func(*fastly.UpdatePoolInput) (*fastly.Pool, error)
+	DeletePoolFn func(*fastly.DeletePoolInput) error
+
+	CreateServerFn func(*fastly.CreateServerInput) (*fastly.Server, error)
+	ListServersFn  func(*fastly.ListServersInput) ([]*fastly.Server, error)
+	GetServerFn    func(*fastly.GetServerInput) (*fastly.Server, error)
+	UpdateServerFn func(*fastly.UpdateServerInput) (*fastly.Server, error)
+	DeleteServerFn func(*fastly.DeleteServerInput) error
+
+	CreateCustomTLSCertificateFn func(*fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	ListCustomTLSCertificatesFn  func(*fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error)
+	GetCustomTLSCertificateFn    func(*fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	UpdateCustomTLSCertificateFn func(*fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	DeleteCustomTLSCertificateFn func(*fastly.DeleteCustomTLSCertificateInput) error
+
+	CreatePrivateKeyFn func(*fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error)
+	ListPrivateKeysFn  func(*fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error)
+	GetPrivateKeyFn    func(*fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error)
+	DeletePrivateKeyFn func(*fastly.DeletePrivateKeyInput) error
+
+	ListTLSDomainsFn func(*fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error)
+
+	CreateTLSActivationFn func(*fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error)
+	ListTLSActivationsFn  func(*fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error)
+	GetTLSActivationFn    func(*fastly.GetTLSActivationInput) (*fastly.TLSActivation, error)
+	UpdateTLSActivationFn func(*fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error)
+	DeleteTLSActivationFn func(*fastly.DeleteTLSActivationInput) error
+
+	CreateTLSSubscriptionFn func(*fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	ListTLSSubscriptionsFn  func(*fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error)
+	GetTLSSubscriptionFn    func(*fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	DeleteTLSSubscriptionFn func(*fastly.DeleteTLSSubscriptionInput) error
+
 	GetPackageFn    func(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackageFn func(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -215,7 +298,18 @@ type API struct {
 	UpdateOpenstackFn func(*fastly.UpdateOpenstackInput) (*fastly.Openstack, error)
 	DeleteOpenstackFn func(*fastly.DeleteOpenstackInput) error
 
-	GetUserFn func(*fastly.GetUserInput) (*fastly.User, error)
+	CreateUserFn        func(*fastly.CreateUserInput) (*fastly.User, error)
+	ListCustomerUsersFn func(*fastly.ListCustomerUsersInput) ([]*fastly.User, error)
+	GetUserFn           func(*fastly.GetUserInput) (*fastly.User, error)
+	GetCurrentUserFn    func() (*fastly.User, error)
+	UpdateUserFn        func(*fastly.UpdateUserInput) (*fastly.User, error)
+	DeleteUserFn        func(*fastly.DeleteUserInput) error
+
+	CreateTokenFn        func(*fastly.CreateTokenInput) (*fastly.Token, error)
+	ListTokensFn         func() ([]*fastly.Token, error)
+	ListCustomerTokensFn func(*fastly.ListCustomerTokensInput) ([]*fastly.Token, error)
+	DeleteTokenFn        func(*fastly.DeleteTokenInput) error
+	DeleteTokenSelfFn    func() error
 
 	GetRegionsFn   func() (*fastly.RegionsResponse, error)
 	GetStatsJSONFn func(i *fastly.GetStatsInput, dst interface{}) error
@@ -351,6 +445,11 @@ func (m API) LatestVersion(i *fastly.LatestVersionInput) (*fastly.Version, error
 	return m.LatestVersionFn(i)
 }
 
+// ValidateVersion implements Interface.
+func (m API) ValidateVersion(i *fastly.ValidateVersionInput) (bool, string, error) {
+	return m.ValidateVersionFn(i)
+}
+
 // CreateDomain implements Interface.
 func (m API) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
 	return m.CreateDomainFn(i)
@@ -426,6 +525,341 @@ func (m API) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
 	return m.DeleteHealthCheckFn(i)
 }
 
+// CreateCondition implements Interface.
+func (m API) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return m.CreateConditionFn(i)
+}
+
+// ListConditions implements Interface.
+func (m API) ListConditions(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return m.ListConditionsFn(i)
+}
+
+// GetCondition implements Interface.
+func (m API) GetCondition(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return m.GetConditionFn(i)
+}
+
+// UpdateCondition implements Interface.
+func (m API) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return m.UpdateConditionFn(i)
+}
+
+// DeleteCondition implements Interface.
+func (m API) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	return m.DeleteConditionFn(i)
+}
+
+// CreateHeader implements Interface.
+func (m API) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return m.CreateHeaderFn(i)
+}
+
+// ListHeaders implements Interface.
+func (m API) ListHeaders(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return m.ListHeadersFn(i)
+}
+
+// GetHeader implements Interface.
+func (m API) GetHeader(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return m.GetHeaderFn(i)
+}
+
+// UpdateHeader implements Interface.
+func (m API) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return m.UpdateHeaderFn(i)
+}
+
+// DeleteHeader implements Interface.
+func (m API) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	return m.DeleteHeaderFn(i)
+}
+
+// CreateCacheSetting implements Interface.
+func (m API) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.CreateCacheSettingFn(i)
+}
+
+// ListCacheSettings implements Interface.
+func (m API) ListCacheSettings(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return m.ListCacheSettingsFn(i)
+}
+
+// GetCacheSetting implements Interface.
+func (m API) GetCacheSetting(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.GetCacheSettingFn(i)
+}
+
+// UpdateCacheSetting implements Interface.
+func (m API) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.UpdateCacheSettingFn(i)
+}
+
+// DeleteCacheSetting implements Interface.
+func (m API) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	return m.DeleteCacheSettingFn(i)
+}
+
+// CreateRequestSetting implements Interface.
+func (m API) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.CreateRequestSettingFn(i)
+}
+
+// ListRequestSettings implements Interface.
+func (m API) ListRequestSettings(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return m.ListRequestSettingsFn(i)
+}
+
+// GetRequestSetting implements Interface.
+func (m API) GetRequestSetting(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.GetRequestSettingFn(i)
+}
+
+// UpdateRequestSetting implements Interface.
+func (m API) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.UpdateRequestSettingFn(i)
+}
+
+// DeleteRequestSetting implements Interface.
+func (m API) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	return m.DeleteRequestSettingFn(i)
+}
+
+// CreateResponseObject implements Interface.
+func (m API) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.CreateResponseObjectFn(i)
+}
+
+// ListResponseObjects implements Interface.
+func (m API) ListResponseObjects(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return m.ListResponseObjectsFn(i)
+}
+
+// GetResponseObject implements Interface.
+func (m API) GetResponseObject(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.GetResponseObjectFn(i)
+}
+
+// UpdateResponseObject implements Interface.
+func (m API) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.UpdateResponseObjectFn(i)
+}
+
+// DeleteResponseObject implements Interface.
+func (m API) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	return m.DeleteResponseObjectFn(i)
+}
+
+// CreateGzip implements Interface.
+func (m API) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return m.CreateGzipFn(i)
+}
+
+// ListGzips implements Interface.
+func (m API) ListGzips(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return m.ListGzipsFn(i)
+}
+
+// GetGzip implements Interface.
+func (m API) GetGzip(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return m.GetGzipFn(i)
+}
+
+// UpdateGzip implements Interface.
+func (m API) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return m.UpdateGzipFn(i)
+}
+
+// DeleteGzip implements Interface.
+func (m API) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	return m.DeleteGzipFn(i)
+}
+
+// CreateDirector implements Interface.
+func (m API) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return m.CreateDirectorFn(i)
+}
+
+// ListDirectors implements Interface.
+func (m API) ListDirectors(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return m.ListDirectorsFn(i)
+}
+
+// GetDirector implements Interface.
+func (m API) GetDirector(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	return m.GetDirectorFn(i)
+}
+
+// UpdateDirector implements Interface.
+func (m API) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	return m.UpdateDirectorFn(i)
+}
+
+// DeleteDirector implements Interface.
+func (m API) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	return m.DeleteDirectorFn(i)
+}
+
+// CreateDirectorBackend implements Interface.
+func (m API) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return m.CreateDirectorBackendFn(i)
+}
+
+// GetDirectorBackend implements Interface.
+func (m API) GetDirectorBackend(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return m.GetDirectorBackendFn(i)
+}
+
+// DeleteDirectorBackend implements Interface.
+func (m API) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	return m.DeleteDirectorBackendFn(i)
+}
+
+// CreatePool implements Interface.
+func (m API) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	return m.CreatePoolFn(i)
+}
+
+// ListPools implements Interface.
+func (m API) ListPools(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return m.ListPoolsFn(i)
+}
+
+// GetPool implements Interface.
+func (m API) GetPool(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return m.GetPoolFn(i)
+}
+
+// UpdatePool implements Interface.
+func (m API) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	return m.UpdatePoolFn(i)
+}
+
+// DeletePool implements Interface.
+func (m API) DeletePool(i *fastly.DeletePoolInput) error {
+	return m.DeletePoolFn(i)
+}
+
+// CreateServer implements Interface.
+func (m API) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	return m.CreateServerFn(i)
+}
+
+// ListServers implements Interface.
+func (m API) ListServers(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return m.ListServersFn(i)
+}
+
+// GetServer implements Interface.
+func (m API) GetServer(i *fastly.GetServerInput) (*fastly.Server, error) {
+	return m.GetServerFn(i)
+}
+
+// UpdateServer implements Interface.
+func (m API) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	return m.UpdateServerFn(i)
+}
+
+// DeleteServer implements Interface.
+func (m API) DeleteServer(i *fastly.DeleteServerInput) error {
+	return m.DeleteServerFn(i)
+}
+
+// CreateCustomTLSCertificate implements Interface.
+func (m API) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.CreateCustomTLSCertificateFn(i)
+}
+
+// ListCustomTLSCertificates implements Interface.
+func (m API) ListCustomTLSCertificates(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return m.ListCustomTLSCertificatesFn(i)
+}
+
+// GetCustomTLSCertificate implements Interface.
+func (m API) GetCustomTLSCertificate(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.GetCustomTLSCertificateFn(i)
+}
+
+// UpdateCustomTLSCertificate implements Interface.
+func (m API) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.UpdateCustomTLSCertificateFn(i)
+}
+
+// DeleteCustomTLSCertificate implements Interface.
+func (m API) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	return m.DeleteCustomTLSCertificateFn(i)
+}
+
+// CreatePrivateKey implements Interface.
+func (m API) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	return m.CreatePrivateKeyFn(i)
+}
+
+// ListPrivateKeys implements Interface.
+func (m API) ListPrivateKeys(i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error) {
+	return m.ListPrivateKeysFn(i)
+}
+
+// GetPrivateKey implements Interface.
+func (m API) GetPrivateKey(i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error) {
+	return m.GetPrivateKeyFn(i)
+}
+
+// DeletePrivateKey implements Interface.
+func (m API) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	return m.DeletePrivateKeyFn(i)
+}
+
+// ListTLSDomains implements Interface.
+func (m API) ListTLSDomains(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	return m.ListTLSDomainsFn(i)
+}
+
+// CreateTLSActivation implements Interface.
+func (m API) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.CreateTLSActivationFn(i)
+}
+
+// ListTLSActivations implements Interface.
+func (m API) ListTLSActivations(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return m.ListTLSActivationsFn(i)
+}
+
+// GetTLSActivation implements Interface.
+func (m API) GetTLSActivation(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.GetTLSActivationFn(i)
+}
+
+// UpdateTLSActivation implements Interface.
+func (m API) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.UpdateTLSActivationFn(i)
+}
+
+// DeleteTLSActivation implements Interface.
+func (m API) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	return m.DeleteTLSActivationFn(i)
+}
+
+// CreateTLSSubscription implements Interface.
+func (m API) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return m.CreateTLSSubscriptionFn(i)
+}
+
+// ListTLSSubscriptions implements Interface.
+func (m API) ListTLSSubscriptions(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return m.ListTLSSubscriptionsFn(i)
+}
+
+// GetTLSSubscription implements Interface.
+func (m API) GetTLSSubscription(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return m.GetTLSSubscriptionFn(i)
+}
+
+// DeleteTLSSubscription implements Interface.
+func (m API) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	return m.DeleteTLSSubscriptionFn(i)
+}
+
 // GetPackage implements Interface.
 func (m API) GetPackage(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	return m.GetPackageFn(i)
@@ -1121,11 +1555,61 @@ func (m API) DeleteOpenstack(i *fastly.DeleteOpenstackInput) error {
 	return m.DeleteOpenstackFn(i)
 }
 
+// CreateUser implements Interface.
+func (m API) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return m.CreateUserFn(i)
+}
+
+// ListCustomerUsers implements Interface.
+func (m API) ListCustomerUsers(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	return m.ListCustomerUsersFn(i)
+}
+
 // GetUser implements Interface.
 func (m API) GetUser(i *fastly.GetUserInput) (*fastly.User, error) {
 	return m.GetUserFn(i)
 }
 
+// GetCurrentUser implements Interface.
+func (m API) GetCurrentUser() (*fastly.User, error) {
+	return m.GetCurrentUserFn()
+}
+
+// UpdateUser implements Interface.
+func (m API) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	return m.UpdateUserFn(i)
+}
+
+// DeleteUser implements Interface.
+func (m API) DeleteUser(i *fastly.DeleteUserInput) error {
+	return m.DeleteUserFn(i)
+}
+
+// CreateToken implements Interface.
+func (m API) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	return m.CreateTokenFn(i)
+}
+
+// ListTokens implements Interface.
+func (m API) ListTokens() ([]*fastly.Token, error) {
+	return m.ListTokensFn()
+}
+
+// ListCustomerTokens implements Interface.
+func (m API) ListCustomerTokens(i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+	return m.ListCustomerTokensFn(i)
+}
+
+// DeleteToken implements Interface.
+func (m API) DeleteToken(i *fastly.DeleteTokenInput) error {
+	return m.DeleteTokenFn(i)
+}
+
+// DeleteTokenSelf implements Interface.
+func (m API) DeleteTokenSelf() error {
+	return m.DeleteTokenSelfFn()
+}
+
 // GetRegions implements Interface.
 func (m API) GetRegions() (*fastly.RegionsResponse, error) {
 	return m.GetRegionsFn()
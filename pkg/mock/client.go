@@ -11,3 +11,11 @@ func APIClient(a API) func(string, string) (api.Interface, error) {
 		return a, nil
 	}
 }
+
+// RTSClient takes a mock.RealtimeStats and returns an app.RTSClientFactory
+// that uses that mock, ignoring the token. It should only be used for tests.
+func RTSClient(r RealtimeStats) func(string) (api.RealtimeStatsInterface, error) {
+	return func(token string) (api.RealtimeStatsInterface, error) {
+		return r, nil
+	}
+}
@@ -0,0 +1,16 @@
+package mock
+
+import (
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// RealtimeStats is a mock implementation of api.RealtimeStatsInterface that's
+// used for testing.
+type RealtimeStats struct {
+	GetRealtimeStatsJSONFn func(*fastly.GetRealtimeStatsInput, interface{}) error
+}
+
+// GetRealtimeStatsJSON implements RealtimeStatsInterface.
+func (m RealtimeStats) GetRealtimeStatsJSON(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+	return m.GetRealtimeStatsJSONFn(i, dst)
+}
@@ -0,0 +1,3 @@
+// Package session stores the local state of an edit session, in which a
+// series of commands make changes to a single cloned service version.
+package session
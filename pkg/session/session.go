@@ -0,0 +1,72 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FilePath is the location of the edit session state.
+var FilePath = func() string {
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, "fastly", "session.json")
+	}
+	if dir, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(dir, ".fastly", "session.json")
+	}
+	panic("unable to deduce user config dir or user home dir")
+}()
+
+// State is an edit session in progress. Version is the draft cloned from
+// BaseVersion when the session began, and ActiveVersion is the version which
+// was active at the time, or 0 if none was.
+type State struct {
+	ServiceID     string    `json:"service_id"`
+	Version       int       `json:"version"`
+	BaseVersion   int       `json:"base_version"`
+	ActiveVersion int       `json:"active_version,omitempty"`
+	Started       time.Time `json:"started"`
+}
+
+// Read returns the edit session stored at path, or nil if there isn't one in
+// progress.
+func Read(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading edit session: %w", err)
+	}
+
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error parsing edit session: %w", err)
+	}
+	return &s, nil
+}
+
+// Write stores the edit session at path.
+func Write(path string, s *State) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding edit session: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("error creating edit session directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("error writing edit session: %w", err)
+	}
+	return nil
+}
+
+// Remove ends the edit session stored at path, if there is one.
+func Remove(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing edit session: %w", err)
+	}
+	return nil
+}
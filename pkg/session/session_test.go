@@ -0,0 +1,37 @@
+package session_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/session"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fastly", "session.json")
+
+	s, err := session.Read(path)
+	testutil.AssertNoError(t, err)
+	if s != nil {
+		t.Fatalf("want no session, have %+v", s)
+	}
+
+	want := &session.State{ServiceID: "123", Version: 4, BaseVersion: 1, Started: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)}
+	testutil.AssertNoError(t, session.Write(path, want))
+	s, err = session.Read(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, want, s)
+
+	testutil.AssertNoError(t, session.Remove(path))
+	testutil.AssertNoError(t, session.Remove(path))
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("want session removed, have %v", err)
+	}
+
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = session.Read(path)
+	testutil.AssertErrorContains(t, err, "error parsing edit session")
+}
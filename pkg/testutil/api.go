@@ -3,6 +3,7 @@ package testutil
 import (
 	"errors"
 
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/go-fastly/v3/fastly"
 )
 
@@ -61,3 +62,139 @@ func CloneVersionResult(version int) func(i *fastly.CloneVersionInput) (*fastly.
 func CloneVersionError(i *fastly.CloneVersionInput) (*fastly.Version, error) {
 	return nil, Err
 }
+
+// EmptyService returns a copy of api where every unset List function used to
+// fetch a service definition returns no resources, so that tests only need to
+// stub the resource types they care about.
+func EmptyService(api mock.API) mock.API {
+	if api.ListDomainsFn == nil {
+		api.ListDomainsFn = func(*fastly.ListDomainsInput) ([]*fastly.Domain, error) { return nil, nil }
+	}
+	if api.ListBackendsFn == nil {
+		api.ListBackendsFn = func(*fastly.ListBackendsInput) ([]*fastly.Backend, error) { return nil, nil }
+	}
+	if api.ListHealthChecksFn == nil {
+		api.ListHealthChecksFn = func(*fastly.ListHealthChecksInput) ([]*fastly.HealthCheck, error) { return nil, nil }
+	}
+	if api.ListVCLsFn == nil {
+		api.ListVCLsFn = func(*fastly.ListVCLsInput) ([]*fastly.VCL, error) { return nil, nil }
+	}
+	if api.ListSnippetsFn == nil {
+		api.ListSnippetsFn = func(*fastly.ListSnippetsInput) ([]*fastly.Snippet, error) { return nil, nil }
+	}
+	if api.ListConditionsFn == nil {
+		api.ListConditionsFn = func(*fastly.ListConditionsInput) ([]*fastly.Condition, error) { return nil, nil }
+	}
+	if api.ListDirectorsFn == nil {
+		api.ListDirectorsFn = func(*fastly.ListDirectorsInput) ([]*fastly.Director, error) { return nil, nil }
+	}
+	if api.ListPoolsFn == nil {
+		api.ListPoolsFn = func(*fastly.ListPoolsInput) ([]*fastly.Pool, error) { return nil, nil }
+	}
+	if api.ListHeadersFn == nil {
+		api.ListHeadersFn = func(*fastly.ListHeadersInput) ([]*fastly.Header, error) { return nil, nil }
+	}
+	if api.ListCacheSettingsFn == nil {
+		api.ListCacheSettingsFn = func(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) { return nil, nil }
+	}
+	if api.ListRequestSettingsFn == nil {
+		api.ListRequestSettingsFn = func(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) { return nil, nil }
+	}
+	if api.ListResponseObjectsFn == nil {
+		api.ListResponseObjectsFn = func(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) { return nil, nil }
+	}
+	if api.ListGzipsFn == nil {
+		api.ListGzipsFn = func(*fastly.ListGzipsInput) ([]*fastly.Gzip, error) { return nil, nil }
+	}
+	if api.ListDictionariesFn == nil {
+		api.ListDictionariesFn = func(*fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) { return nil, nil }
+	}
+	if api.ListDictionaryItemsFn == nil {
+		api.ListDictionaryItemsFn = func(*fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) { return nil, nil }
+	}
+	if api.ListACLsFn == nil {
+		api.ListACLsFn = func(*fastly.ListACLsInput) ([]*fastly.ACL, error) { return nil, nil }
+	}
+	if api.ListACLEntriesFn == nil {
+		api.ListACLEntriesFn = func(*fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) { return nil, nil }
+	}
+	if api.ListBlobStoragesFn == nil {
+		api.ListBlobStoragesFn = func(*fastly.ListBlobStoragesInput) ([]*fastly.BlobStorage, error) { return nil, nil }
+	}
+	if api.ListBigQueriesFn == nil {
+		api.ListBigQueriesFn = func(*fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error) { return nil, nil }
+	}
+	if api.ListCloudfilesFn == nil {
+		api.ListCloudfilesFn = func(*fastly.ListCloudfilesInput) ([]*fastly.Cloudfiles, error) { return nil, nil }
+	}
+	if api.ListDatadogFn == nil {
+		api.ListDatadogFn = func(*fastly.ListDatadogInput) ([]*fastly.Datadog, error) { return nil, nil }
+	}
+	if api.ListDigitalOceansFn == nil {
+		api.ListDigitalOceansFn = func(*fastly.ListDigitalOceansInput) ([]*fastly.DigitalOcean, error) { return nil, nil }
+	}
+	if api.ListElasticsearchFn == nil {
+		api.ListElasticsearchFn = func(*fastly.ListElasticsearchInput) ([]*fastly.Elasticsearch, error) { return nil, nil }
+	}
+	if api.ListFTPsFn == nil {
+		api.ListFTPsFn = func(*fastly.ListFTPsInput) ([]*fastly.FTP, error) { return nil, nil }
+	}
+	if api.ListGCSsFn == nil {
+		api.ListGCSsFn = func(*fastly.ListGCSsInput) ([]*fastly.GCS, error) { return nil, nil }
+	}
+	if api.ListPubsubsFn == nil {
+		api.ListPubsubsFn = func(*fastly.ListPubsubsInput) ([]*fastly.Pubsub, error) { return nil, nil }
+	}
+	if api.ListHerokusFn == nil {
+		api.ListHerokusFn = func(*fastly.ListHerokusInput) ([]*fastly.Heroku, error) { return nil, nil }
+	}
+	if api.ListHoneycombsFn == nil {
+		api.ListHoneycombsFn = func(*fastly.ListHoneycombsInput) ([]*fastly.Honeycomb, error) { return nil, nil }
+	}
+	if api.ListHTTPSFn == nil {
+		api.ListHTTPSFn = func(*fastly.ListHTTPSInput) ([]*fastly.HTTPS, error) { return nil, nil }
+	}
+	if api.ListKafkasFn == nil {
+		api.ListKafkasFn = func(*fastly.ListKafkasInput) ([]*fastly.Kafka, error) { return nil, nil }
+	}
+	if api.ListKinesisFn == nil {
+		api.ListKinesisFn = func(*fastly.ListKinesisInput) ([]*fastly.Kinesis, error) { return nil, nil }
+	}
+	if api.ListLogentriesFn == nil {
+		api.ListLogentriesFn = func(*fastly.ListLogentriesInput) ([]*fastly.Logentries, error) { return nil, nil }
+	}
+	if api.ListLogglyFn == nil {
+		api.ListLogglyFn = func(*fastly.ListLogglyInput) ([]*fastly.Loggly, error) { return nil, nil }
+	}
+	if api.ListLogshuttlesFn == nil {
+		api.ListLogshuttlesFn = func(*fastly.ListLogshuttlesInput) ([]*fastly.Logshuttle, error) { return nil, nil }
+	}
+	if api.ListNewRelicFn == nil {
+		api.ListNewRelicFn = func(*fastly.ListNewRelicInput) ([]*fastly.NewRelic, error) { return nil, nil }
+	}
+	if api.ListOpenstacksFn == nil {
+		api.ListOpenstacksFn = func(*fastly.ListOpenstackInput) ([]*fastly.Openstack, error) { return nil, nil }
+	}
+	if api.ListPapertrailsFn == nil {
+		api.ListPapertrailsFn = func(*fastly.ListPapertrailsInput) ([]*fastly.Papertrail, error) { return nil, nil }
+	}
+	if api.ListS3sFn == nil {
+		api.ListS3sFn = func(*fastly.ListS3sInput) ([]*fastly.S3, error) { return nil, nil }
+	}
+	if api.ListScalyrsFn == nil {
+		api.ListScalyrsFn = func(*fastly.ListScalyrsInput) ([]*fastly.Scalyr, error) { return nil, nil }
+	}
+	if api.ListSFTPsFn == nil {
+		api.ListSFTPsFn = func(*fastly.ListSFTPsInput) ([]*fastly.SFTP, error) { return nil, nil }
+	}
+	if api.ListSplunksFn == nil {
+		api.ListSplunksFn = func(*fastly.ListSplunksInput) ([]*fastly.Splunk, error) { return nil, nil }
+	}
+	if api.ListSumologicsFn == nil {
+		api.ListSumologicsFn = func(*fastly.ListSumologicsInput) ([]*fastly.Sumologic, error) { return nil, nil }
+	}
+	if api.ListSyslogsFn == nil {
+		api.ListSyslogsFn = func(*fastly.ListSyslogsInput) ([]*fastly.Syslog, error) { return nil, nil }
+	}
+	return api
+}
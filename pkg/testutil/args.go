@@ -58,6 +58,7 @@ func NewRunOpts(args []string, stdout io.Writer) app.RunOpts {
 		ConfigPath: "/dev/null",
 		Args:       args,
 		APIClient:  mock.APIClient(mock.API{}),
+		RTSClient:  mock.RTSClient(mock.RealtimeStats{}),
 		Env:        config.Environment{},
 		ErrLog:     errors.Log,
 		ConfigFile: config.File{},
@@ -0,0 +1,39 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintPool pretty prints a fastly.Pool structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will
+// be used as a prefix to each line, useful for indentation.
+func PrintPool(out io.Writer, prefix string, p *fastly.Pool) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", p.ID)
+	fmt.Fprintf(out, "Name: %s\n", p.Name)
+	fmt.Fprintf(out, "Comment: %s\n", p.Comment)
+	fmt.Fprintf(out, "Type: %s\n", p.Type)
+	fmt.Fprintf(out, "Quorum: %d\n", p.Quorum)
+	fmt.Fprintf(out, "Healthcheck: %s\n", p.Healthcheck)
+	fmt.Fprintf(out, "Shield: %s\n", p.Shield)
+	fmt.Fprintf(out, "Request condition: %s\n", p.RequestCondition)
+	fmt.Fprintf(out, "Override host: %s\n", p.OverrideHost)
+	fmt.Fprintf(out, "Max connections default: %d\n", p.MaxConnDefault)
+	fmt.Fprintf(out, "Connect timeout: %d\n", p.ConnectTimeout)
+	fmt.Fprintf(out, "First byte timeout: %d\n", p.FirstByteTimeout)
+	fmt.Fprintf(out, "Use TLS: %t\n", p.UseTLS)
+	fmt.Fprintf(out, "TLS check cert: %t\n", p.TLSCheckCert)
+	fmt.Fprintf(out, "TLS CA cert: %s\n", p.TLSCACert)
+	fmt.Fprintf(out, "TLS client cert: %s\n", p.TLSClientCert)
+	fmt.Fprintf(out, "TLS client key: %s\n", p.TLSClientKey)
+	fmt.Fprintf(out, "TLS cert hostname: %s\n", p.TLSCertHostname)
+	fmt.Fprintf(out, "TLS SNI hostname: %s\n", p.TLSSNIHostname)
+	fmt.Fprintf(out, "Min TLS version: %s\n", p.MinTLSVersion)
+	fmt.Fprintf(out, "Max TLS version: %s\n", p.MaxTLSVersion)
+	fmt.Fprintf(out, "TLS ciphers: %s\n", p.TLSCiphers)
+}
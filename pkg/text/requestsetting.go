@@ -0,0 +1,29 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintRequestSetting pretty prints a fastly.RequestSetting structure in
+// verbose format to a given io.Writer. Consumers can provide a prefix string
+// which will be used as a prefix to each line, useful for indentation.
+func PrintRequestSetting(out io.Writer, prefix string, rs *fastly.RequestSetting) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", rs.Name)
+	fmt.Fprintf(out, "Action: %s\n", rs.Action)
+	fmt.Fprintf(out, "Force miss: %t\n", rs.ForceMiss)
+	fmt.Fprintf(out, "Force SSL: %t\n", rs.ForceSSL)
+	fmt.Fprintf(out, "Bypass busy wait: %t\n", rs.BypassBusyWait)
+	fmt.Fprintf(out, "Max stale age: %d\n", rs.MaxStaleAge)
+	fmt.Fprintf(out, "Hash keys: %s\n", rs.HashKeys)
+	fmt.Fprintf(out, "X-Forwarded-For: %s\n", rs.XForwardedFor)
+	fmt.Fprintf(out, "Timer support: %t\n", rs.TimerSupport)
+	fmt.Fprintf(out, "Geo headers: %t\n", rs.GeoHeaders)
+	fmt.Fprintf(out, "Default host: %s\n", rs.DefaultHost)
+	fmt.Fprintf(out, "Request condition: %s\n", rs.RequestCondition)
+}
@@ -0,0 +1,35 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintToken pretty prints a fastly.Token structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will
+// be used as a prefix to each line, useful for indentation. The secret access
+// token itself is never printed.
+func PrintToken(out io.Writer, prefix string, t *fastly.Token) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", t.ID)
+	fmt.Fprintf(out, "Name: %s\n", t.Name)
+	fmt.Fprintf(out, "User ID: %s\n", t.UserID)
+	fmt.Fprintf(out, "Scope: %s\n", t.Scope)
+	fmt.Fprintf(out, "Services: %s\n", strings.Join(t.Services, ", "))
+	fmt.Fprintf(out, "IP: %s\n", t.IP)
+	if t.CreatedAt != nil {
+		fmt.Fprintf(out, "Created at: %s\n", t.CreatedAt.UTC().Format(time.RFC3339))
+	}
+	if t.LastUsedAt != nil {
+		fmt.Fprintf(out, "Last used at: %s\n", t.LastUsedAt.UTC().Format(time.RFC3339))
+	}
+	if t.ExpiresAt != nil {
+		fmt.Fprintf(out, "Expires at: %s\n", t.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,29 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintUser pretty prints a fastly.User structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will
+// be used as a prefix to each line, useful for indentation.
+func PrintUser(out io.Writer, prefix string, u *fastly.User) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", u.ID)
+	fmt.Fprintf(out, "Login: %s\n", u.Login)
+	fmt.Fprintf(out, "Name: %s\n", u.Name)
+	fmt.Fprintf(out, "Role: %s\n", u.Role)
+	fmt.Fprintf(out, "Customer ID: %s\n", u.CustomerID)
+	fmt.Fprintf(out, "Limit services: %t\n", u.LimitServices)
+	fmt.Fprintf(out, "Locked: %t\n", u.Locked)
+	fmt.Fprintf(out, "Two-factor auth enabled: %t\n", u.TwoFactorAuthEnabled)
+	if u.CreatedAt != nil {
+		fmt.Fprintf(out, "Created at: %s\n", u.CreatedAt.UTC().Format(time.RFC3339))
+	}
+}